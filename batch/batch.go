@@ -0,0 +1,286 @@
+// Package batch runs an endpoint method for many parameter sets with bounded
+// concurrency, for example to backfill box scores for every game of a season:
+//
+//	params := make([]boxscore.BoxScoreTraditionalV3Params, len(gameIDs))
+//	for i, id := range gameIDs {
+//		params[i] = boxscore.BoxScoreTraditionalV3Params{GameId: id}
+//	}
+//	report := batch.Collect(ctx, params, c.Boxscore.GetBoxScoreTraditionalV3,
+//		batch.WithConcurrency(4))
+//	if err := report.Err(); err != nil {
+//		// Retry later with report.Remaining().
+//	}
+//
+// Concurrency only bounds the number of calls in flight. Pacing is left to
+// the client's RateLimiter, on which workers wait, and to its retry policy,
+// which backs off on HTTP 429.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DefaultConcurrency is the number of concurrent calls used when
+// WithConcurrency is not given.
+const DefaultConcurrency = 4
+
+// Func is an endpoint method such as player.Client.GetPlayerGameLog.
+type Func[P, R any] func(ctx context.Context, params P) (R, error)
+
+// Result is the outcome of one call.
+type Result[P, R any] struct {
+	// Index is the position of Params in the input slice.
+	Index  int
+	Params P
+	Value  R
+	Err    error
+	// Skipped reports that the call was never started because the run was
+	// cancelled or stopped; Err is then the context error.
+	Skipped bool
+}
+
+// Order selects the order in which results are delivered.
+type Order int
+
+const (
+	// CompletionOrder delivers results as soon as they complete.
+	CompletionOrder Order = iota
+	// InputOrder delivers results in the order of the input slice.
+	InputOrder
+)
+
+// Option configures a batch run.
+type Option func(*options)
+
+// options collects the values set by Option functions.
+type options struct {
+	concurrency int
+	order       Order
+	stopOnError bool
+}
+
+// WithConcurrency sets the maximum number of concurrent calls.
+func WithConcurrency(n int) Option {
+	return func(o *options) {
+		o.concurrency = n
+	}
+}
+
+// WithOrder sets the order in which results are delivered.
+func WithOrder(order Order) Option {
+	return func(o *options) {
+		o.order = order
+	}
+}
+
+// WithStopOnError stops starting new calls after the first failure. Calls
+// already in flight are cancelled through their context.
+func WithStopOnError() Option {
+	return func(o *options) {
+		o.stopOnError = true
+	}
+}
+
+// newOptions applies opts to the defaults.
+func newOptions(opts []Option) options {
+	o := options{concurrency: DefaultConcurrency}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.concurrency < 1 {
+		o.concurrency = 1
+	}
+	return o
+}
+
+// Run calls fn for every element of params, at most WithConcurrency at a
+// time, and streams the results over the returned channel, which is closed
+// once every started call has completed. The channel must be drained.
+//
+// When ctx is cancelled, or after a failure with WithStopOnError, no new
+// calls are started. Parameters that were never started produce no result,
+// or a Skipped result if a worker had already picked them up.
+func Run[P, R any](ctx context.Context, params []P, fn Func[P, R], opts ...Option) <-chan Result[P, R] {
+	o := newOptions(opts)
+	out := make(chan Result[P, R], o.concurrency)
+
+	go func() {
+		defer close(out)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		indices := make(chan int)
+		go func() {
+			defer close(indices)
+			for i := range params {
+				if ctx.Err() != nil {
+					return
+				}
+				select {
+				case indices <- i:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		completed := make(chan Result[P, R])
+		var wg sync.WaitGroup
+		for w := 0; w < o.concurrency && w < len(params); w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range indices {
+					// The index may have been sent after ctx was cancelled,
+					// since select picks among ready cases at random.
+					if err := ctx.Err(); err != nil {
+						completed <- Result[P, R]{Index: i, Params: params[i], Err: err, Skipped: true}
+						continue
+					}
+					value, err := fn(ctx, params[i])
+					completed <- Result[P, R]{Index: i, Params: params[i], Value: value, Err: err}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(completed)
+		}()
+
+		pending := make(map[int]Result[P, R])
+		next := 0
+		for result := range completed {
+			if result.Err != nil && o.stopOnError {
+				cancel()
+			}
+			if o.order == CompletionOrder {
+				out <- result
+				continue
+			}
+
+			pending[result.Index] = result
+			for {
+				ready, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				out <- ready
+				next++
+			}
+		}
+
+		// Calls that were never started leave gaps; deliver the rest in order.
+		rest := make([]int, 0, len(pending))
+		for index := range pending {
+			rest = append(rest, index)
+		}
+		sort.Ints(rest)
+		for _, index := range rest {
+			out <- pending[index]
+		}
+	}()
+
+	return out
+}
+
+// Report summarises a completed batch run.
+type Report[P, R any] struct {
+	// Results holds every completed call in input order.
+	Results []Result[P, R]
+
+	params []P
+}
+
+// Collect runs the batch like Run and waits for it to complete.
+func Collect[P, R any](ctx context.Context, params []P, fn Func[P, R], opts ...Option) *Report[P, R] {
+	report := &Report[P, R]{params: params}
+	for result := range Run(ctx, params, fn, append(opts[:len(opts):len(opts)], WithOrder(InputOrder))...) {
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
+
+// Succeeded returns the results of successful calls in input order.
+func (r *Report[P, R]) Succeeded() []Result[P, R] {
+	var succeeded []Result[P, R]
+	for _, result := range r.Results {
+		if result.Err == nil {
+			succeeded = append(succeeded, result)
+		}
+	}
+	return succeeded
+}
+
+// Failed returns the results of failed calls in input order.
+func (r *Report[P, R]) Failed() []Result[P, R] {
+	var failed []Result[P, R]
+	for _, result := range r.Results {
+		if result.Err != nil && !result.Skipped {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// NotStarted returns the parameters whose call was never started because
+// the run was cancelled or stopped early.
+func (r *Report[P, R]) NotStarted() []P {
+	started := make([]bool, len(r.params))
+	for _, result := range r.Results {
+		started[result.Index] = !result.Skipped
+	}
+
+	var notStarted []P
+	for i, params := range r.params {
+		if !started[i] {
+			notStarted = append(notStarted, params)
+		}
+	}
+	return notStarted
+}
+
+// Remaining returns, in input order, the parameters that failed or were
+// never started. Passing them to a later Run or Collect resumes the batch.
+func (r *Report[P, R]) Remaining() []P {
+	done := make([]bool, len(r.params))
+	for _, result := range r.Results {
+		done[result.Index] = result.Err == nil
+	}
+
+	var remaining []P
+	for i, params := range r.params {
+		if !done[i] {
+			remaining = append(remaining, params)
+		}
+	}
+	return remaining
+}
+
+// Err returns nil if every call succeeded. Otherwise it returns an error
+// describing how many calls failed or were not started, wrapping the
+// individual errors.
+func (r *Report[P, R]) Err() error {
+	failed := r.Failed()
+	notStarted := len(r.NotStarted())
+	if len(failed) == 0 && notStarted == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("batch: %d of %d calls failed, %d not started",
+		len(failed), len(r.params), notStarted)
+	if len(failed) == 0 {
+		return errors.New(msg)
+	}
+
+	errs := make([]error, 0, len(failed))
+	for _, result := range failed {
+		errs = append(errs, fmt.Errorf("item %d: %w", result.Index, result.Err))
+	}
+	return fmt.Errorf("%s: %w", msg, errors.Join(errs...))
+}
@@ -0,0 +1,208 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/client"
+	"github.com/utkonoser/nba-api-go/endpoints/boxscore"
+)
+
+func ids(n int) []int {
+	ids := make([]int, n)
+	for i := range ids {
+		ids[i] = i
+	}
+	return ids
+}
+
+func TestRun_BoundsConcurrency(t *testing.T) {
+	var inFlight, peak int32
+	fn := func(ctx context.Context, id int) (string, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return fmt.Sprintf("game-%d", id), nil
+	}
+
+	var count int
+	for result := range Run(context.Background(), ids(30), fn, WithConcurrency(3)) {
+		require.NoError(t, result.Err)
+		assert.Equal(t, fmt.Sprintf("game-%d", result.Params), result.Value)
+		count++
+	}
+
+	assert.Equal(t, 30, count)
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+}
+
+func TestRun_InputOrder(t *testing.T) {
+	fn := func(ctx context.Context, id int) (int, error) {
+		// Later items finish first.
+		time.Sleep(time.Duration(10-id) * time.Millisecond)
+		return id * 10, nil
+	}
+
+	var indices []int
+	for result := range Run(context.Background(), ids(10), fn, WithConcurrency(10), WithOrder(InputOrder)) {
+		assert.Equal(t, result.Index*10, result.Value)
+		indices = append(indices, result.Index)
+	}
+
+	assert.Equal(t, ids(10), indices)
+}
+
+func TestRun_CompletionOrder(t *testing.T) {
+	fn := func(ctx context.Context, id int) (int, error) {
+		time.Sleep(time.Duration(3-id) * 20 * time.Millisecond)
+		return id, nil
+	}
+
+	var indices []int
+	for result := range Run(context.Background(), ids(3), fn, WithConcurrency(3)) {
+		indices = append(indices, result.Index)
+	}
+
+	assert.Equal(t, []int{2, 1, 0}, indices)
+}
+
+func TestCollect_PartialFailureAndResume(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	var attempts int32
+	fn := func(ctx context.Context, id int) (int, error) {
+		atomic.AddInt32(&attempts, 1)
+		if id%4 == 0 {
+			return 0, errFlaky
+		}
+		return id, nil
+	}
+
+	report := Collect(context.Background(), ids(10), fn)
+
+	require.Len(t, report.Results, 10)
+	assert.Len(t, report.Succeeded(), 7)
+	require.Len(t, report.Failed(), 3)
+	assert.Equal(t, []int{0, 4, 8}, report.Remaining())
+	assert.Empty(t, report.NotStarted())
+
+	err := report.Err()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errFlaky)
+	assert.Contains(t, err.Error(), "3 of 10 calls failed")
+
+	resumed := Collect(context.Background(), report.Remaining(), func(ctx context.Context, id int) (int, error) {
+		return id, nil
+	})
+	assert.NoError(t, resumed.Err())
+	assert.Len(t, resumed.Succeeded(), 3)
+}
+
+func TestCollect_StopOnError(t *testing.T) {
+	errBoom := errors.New("boom")
+	fn := func(ctx context.Context, id int) (int, error) {
+		if id == 2 {
+			return 0, errBoom
+		}
+		return id, nil
+	}
+
+	report := Collect(context.Background(), ids(100), fn, WithConcurrency(1), WithStopOnError())
+
+	require.Len(t, report.Failed(), 1)
+	assert.Equal(t, 2, report.Failed()[0].Index)
+	assert.NotEmpty(t, report.NotStarted())
+	assert.Equal(t, 2, report.Remaining()[0])
+	assert.Len(t, report.Remaining(), 100-len(report.Succeeded()))
+	assert.ErrorIs(t, report.Err(), errBoom)
+}
+
+func TestCollect_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fn := func(ctx context.Context, id int) (int, error) {
+		if id == 5 {
+			cancel()
+		}
+		return id, nil
+	}
+
+	report := Collect(ctx, ids(50), fn, WithConcurrency(1))
+
+	assert.Empty(t, report.Failed())
+	assert.NotEmpty(t, report.NotStarted())
+	assert.Equal(t, report.NotStarted(), report.Remaining())
+	assert.Error(t, report.Err())
+}
+
+func TestCollect_NoCallsAfterCancel(t *testing.T) {
+	// The sender and a waiting worker race with the cancellation, so repeat
+	// the run to give the worker a chance to receive an index after it.
+	for run := 0; run < 200; run++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		var calls int32
+		fn := func(ctx context.Context, id int) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			cancel()
+			return id, nil
+		}
+
+		report := Collect(ctx, ids(10), fn, WithConcurrency(1))
+
+		require.Equal(t, int32(1), atomic.LoadInt32(&calls), "run %d", run)
+		require.Len(t, report.Succeeded(), 1)
+		assert.Empty(t, report.Failed())
+		assert.Len(t, report.NotStarted(), 9)
+		for _, result := range report.Results[1:] {
+			assert.True(t, result.Skipped)
+			assert.ErrorIs(t, result.Err, context.Canceled)
+		}
+		assert.Contains(t, report.Err().Error(), "0 of 10 calls failed, 9 not started")
+	}
+}
+
+func TestCollect_EndpointMethod(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Query().Get("GameID") == "0022300003" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta": {"version": 1}, "boxScoreTraditional": {"gameId": "0022300001"}}`))
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	httpClient := client.NewHTTPClient(server.URL+"/%s", boxscore.DefaultHeaders(), logger)
+	httpClient.SetRetryPolicy(client.NoRetryPolicy())
+	c := boxscore.NewClientWithHTTPClient(httpClient, logger)
+
+	params := []boxscore.BoxScoreTraditionalV3Params{
+		{GameId: "0022300001"},
+		{GameId: "0022300002"},
+		{GameId: "0022300003"},
+	}
+	report := Collect(context.Background(), params, c.GetBoxScoreTraditionalV3, WithConcurrency(2))
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Len(t, report.Succeeded(), 2)
+	assert.Equal(t, "boxscoretraditionalv3", report.Succeeded()[0].Value.Resource)
+	assert.Equal(t, []boxscore.BoxScoreTraditionalV3Params{{GameId: "0022300003"}}, report.Remaining())
+	assert.ErrorIs(t, report.Err(), client.ErrNotFound)
+}
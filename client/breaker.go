@@ -0,0 +1,372 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is matched by errors returned while the circuit breaker of
+// the request host is open.
+var ErrCircuitOpen = errors.New("nba api: circuit breaker open")
+
+// BreakerState is the state of a host circuit.
+type BreakerState int
+
+const (
+	// BreakerClosed lets every request through.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen fails every request fast.
+	BreakerOpen
+	// BreakerHalfOpen lets a limited number of probe requests through.
+	BreakerHalfOpen
+)
+
+// String returns the name of the state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// BreakerConfig configures a CircuitBreaker. Zero values are replaced by the
+// values of DefaultBreakerConfig.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed attempts that
+	// opens the circuit.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before probing.
+	OpenTimeout time.Duration
+	// HalfOpenRequests is the number of concurrent probe requests allowed
+	// while half-open.
+	HalfOpenRequests int
+	// IsFailure reports whether an attempt counts as a failure. err is the
+	// error of the attempt, if any, and statusCode the HTTP status otherwise.
+	// Defaults to transport errors, timeouts and 5xx and 429 statuses.
+	IsFailure func(err error, statusCode int) bool
+	// OnStateChange is called after a host circuit changes state.
+	OnStateChange func(host string, from, to BreakerState)
+}
+
+// DefaultBreakerConfig returns a configuration that opens after five
+// consecutive failures and probes again after 30 seconds.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenRequests: 1,
+		IsFailure:        isBreakerFailure,
+	}
+}
+
+// isBreakerFailure is the default BreakerConfig.IsFailure. Responses that
+// cannot be decoded, other 4xx statuses and cancelled requests say nothing
+// about the health of the host and are not failures.
+func isBreakerFailure(err error, statusCode int) bool {
+	if err == nil {
+		return isHostFailureStatus(statusCode)
+	}
+
+	var apiErr *APIError
+	switch {
+	case errors.As(err, &apiErr):
+		return isHostFailureStatus(apiErr.StatusCode)
+	case errors.Is(err, context.Canceled),
+		errors.Is(err, ErrInvalidJSON),
+		errors.Is(err, ErrUnsupportedEncoding):
+		return false
+	}
+	// Transport errors and timeouts.
+	return true
+}
+
+// isHostFailureStatus reports whether statusCode says the host is failing
+// or overloaded.
+func isHostFailureStatus(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests
+}
+
+// CircuitOpenError is returned while the circuit of a host is open.
+type CircuitOpenError struct {
+	Host string
+	// RetryAfter is the time left before the circuit is probed again.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("nba api: circuit breaker open for %s, retry in %s",
+		e.Host, e.RetryAfter.Round(time.Millisecond))
+}
+
+// Is reports whether target is ErrCircuitOpen.
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreaker tracks the health of each host and fails requests fast
+// while a host is unhealthy. A single CircuitBreaker may be shared by any
+// number of HTTP clients.
+type CircuitBreaker struct {
+	mu       sync.Mutex
+	config   BreakerConfig
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+// circuit holds the state of a single host.
+type circuit struct {
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probes   int
+}
+
+// breakerOutcome is the result of an attempt as seen by the breaker.
+type breakerOutcome int
+
+const (
+	breakerSuccess breakerOutcome = iota
+	breakerFailure
+	// breakerIgnored is used for attempts aborted by the caller.
+	breakerIgnored
+)
+
+// NewCircuitBreaker creates a circuit breaker with the given configuration.
+func NewCircuitBreaker(config BreakerConfig) *CircuitBreaker {
+	defaults := DefaultBreakerConfig()
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaults.FailureThreshold
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = defaults.OpenTimeout
+	}
+	if config.HalfOpenRequests <= 0 {
+		config.HalfOpenRequests = defaults.HalfOpenRequests
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = defaults.IsFailure
+	}
+
+	return &CircuitBreaker{
+		config:   config,
+		circuits: make(map[string]*circuit),
+		now:      time.Now,
+	}
+}
+
+// State returns the current state of the circuit for host.
+func (b *CircuitBreaker) State(host string) BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[host]
+	if !ok {
+		return BreakerClosed
+	}
+	if c.state == BreakerOpen && !b.now().Before(c.openedAt.Add(b.config.OpenTimeout)) {
+		return BreakerHalfOpen
+	}
+	return c.state
+}
+
+// States returns the state of every host seen by the breaker, for example
+// to report it from a health check.
+func (b *CircuitBreaker) States() map[string]BreakerState {
+	b.mu.Lock()
+	hosts := make([]string, 0, len(b.circuits))
+	for host := range b.circuits {
+		hosts = append(hosts, host)
+	}
+	b.mu.Unlock()
+
+	states := make(map[string]BreakerState, len(hosts))
+	for _, host := range hosts {
+		states[host] = b.State(host)
+	}
+	return states
+}
+
+// Reset closes the circuit for host.
+func (b *CircuitBreaker) Reset(host string) {
+	b.mu.Lock()
+	c, ok := b.circuits[host]
+	if !ok {
+		b.mu.Unlock()
+		return
+	}
+	from := c.state
+	*c = circuit{}
+	b.mu.Unlock()
+
+	b.notify(host, from, BreakerClosed)
+}
+
+// allow reports whether a request to host may be sent. probe is true when
+// the request is a half-open probe whose outcome decides the circuit state.
+func (b *CircuitBreaker) allow(host string) (probe bool, err error) {
+	b.mu.Lock()
+
+	c := b.circuit(host)
+	from := c.state
+	if c.state == BreakerOpen {
+		reopenAt := c.openedAt.Add(b.config.OpenTimeout)
+		if now := b.now(); now.Before(reopenAt) {
+			b.mu.Unlock()
+			return false, &CircuitOpenError{Host: host, RetryAfter: reopenAt.Sub(now)}
+		}
+		c.state = BreakerHalfOpen
+		c.probes = 0
+	}
+
+	if c.state == BreakerHalfOpen {
+		if c.probes >= b.config.HalfOpenRequests {
+			b.mu.Unlock()
+			return false, &CircuitOpenError{Host: host}
+		}
+		c.probes++
+		probe = true
+	}
+	to := c.state
+	b.mu.Unlock()
+
+	b.notify(host, from, to)
+	return probe, nil
+}
+
+// record updates the circuit for host with the outcome of an allowed request.
+func (b *CircuitBreaker) record(host string, probe bool, outcome breakerOutcome) {
+	b.mu.Lock()
+
+	c := b.circuit(host)
+	from := c.state
+	switch outcome {
+	case breakerIgnored:
+		if probe && c.state == BreakerHalfOpen && c.probes > 0 {
+			c.probes--
+		}
+	case breakerSuccess:
+		if c.state == BreakerOpen || (c.state == BreakerHalfOpen && !probe) {
+			// Requests sent before the circuit opened do not close it.
+			break
+		}
+		c.state = BreakerClosed
+		c.failures = 0
+		c.probes = 0
+	case breakerFailure:
+		switch {
+		case c.state == BreakerHalfOpen && probe:
+			c.state = BreakerOpen
+			c.openedAt = b.now()
+			c.probes = 0
+		case c.state == BreakerClosed:
+			c.failures++
+			if c.failures >= b.config.FailureThreshold {
+				c.state = BreakerOpen
+				c.openedAt = b.now()
+			}
+		}
+	}
+	to := c.state
+	b.mu.Unlock()
+
+	b.notify(host, from, to)
+}
+
+// outcome classifies the result of an attempt.
+func (b *CircuitBreaker) outcome(ctx context.Context, err error, statusCode int) breakerOutcome {
+	var interceptorErr *interceptorError
+	switch {
+	case errors.As(err, &interceptorErr):
+		return breakerIgnored
+	case err != nil && ctx.Err() != nil:
+		// The caller gave up; this says nothing about the host.
+		return breakerIgnored
+	case b.config.IsFailure(err, statusCode):
+		return breakerFailure
+	}
+	return breakerSuccess
+}
+
+// allowRequest checks the circuit breaker before an attempt.
+func (c *HTTPClient) allowRequest(ctx context.Context, info *RequestInfo) (probe bool, err error) {
+	if c.breaker == nil {
+		return false, nil
+	}
+	probe, err = c.breaker.allow(info.Host)
+	if err != nil {
+		c.logger.WarnContext(ctx, "Circuit breaker open, failing fast",
+			slog.String("host", info.Host),
+			slog.String("url", info.URL))
+		return false, err
+	}
+	if probe {
+		c.logger.InfoContext(ctx, "Circuit breaker half-open, sending probe request",
+			slog.String("host", info.Host))
+	}
+	return probe, nil
+}
+
+// recordOutcome reports the result of an attempt to the circuit breaker.
+func (c *HTTPClient) recordOutcome(ctx context.Context, info *RequestInfo, probe bool, response *Response, err error) {
+	if c.breaker == nil {
+		return
+	}
+	statusCode := 0
+	if response != nil {
+		statusCode = response.statusCode
+	}
+	c.breaker.record(info.Host, probe, c.breaker.outcome(ctx, err, statusCode))
+}
+
+// releaseProbe tells the circuit breaker that an allowed attempt was not sent.
+func (c *HTTPClient) releaseProbe(info *RequestInfo, probe bool) {
+	if c.breaker != nil {
+		c.breaker.record(info.Host, probe, breakerIgnored)
+	}
+}
+
+// circuit returns the circuit for host, creating it if needed. The caller
+// must hold b.mu.
+func (b *CircuitBreaker) circuit(host string) *circuit {
+	c, ok := b.circuits[host]
+	if !ok {
+		c = &circuit{}
+		b.circuits[host] = c
+	}
+	return c
+}
+
+// notify calls OnStateChange if the state changed.
+func (b *CircuitBreaker) notify(host string, from, to BreakerState) {
+	if from != to && b.config.OnStateChange != nil {
+		b.config.OnStateChange(host, from, to)
+	}
+}
+
+// SetCircuitBreaker sets the circuit breaker consulted before every request
+// attempt. The same breaker may be shared by several clients; nil disables it.
+func (c *HTTPClient) SetCircuitBreaker(breaker *CircuitBreaker) {
+	c.breaker = breaker
+}
+
+// CircuitBreaker returns the circuit breaker used by the client, if any.
+func (c *HTTPClient) CircuitBreaker() *CircuitBreaker {
+	return c.breaker
+}
+
+// WithCircuitBreaker sets the circuit breaker of the HTTP client.
+func WithCircuitBreaker(breaker *CircuitBreaker) Option {
+	return func(o *options) {
+		o.breaker = breaker
+	}
+}
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type transition struct {
+	host     string
+	from, to BreakerState
+}
+
+func newTestBreaker(config BreakerConfig) (*CircuitBreaker, *time.Time, *[]transition) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var transitions []transition
+	config.OnStateChange = func(host string, from, to BreakerState) {
+		transitions = append(transitions, transition{host, from, to})
+	}
+	breaker := NewCircuitBreaker(config)
+	breaker.now = func() time.Time { return now }
+	return breaker, &now, &transitions
+}
+
+func TestCircuitBreaker_StateMachine(t *testing.T) {
+	breaker, now, transitions := newTestBreaker(BreakerConfig{FailureThreshold: 3, OpenTimeout: 10 * time.Second})
+
+	for i := 0; i < 3; i++ {
+		probe, err := breaker.allow(StatsHost)
+		require.NoError(t, err)
+		assert.False(t, probe)
+		breaker.record(StatsHost, probe, breakerFailure)
+	}
+	assert.Equal(t, BreakerOpen, breaker.State(StatsHost))
+
+	*now = now.Add(4 * time.Second)
+	_, err := breaker.allow(StatsHost)
+	var openErr *CircuitOpenError
+	require.ErrorAs(t, err, &openErr)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, StatsHost, openErr.Host)
+	assert.Equal(t, 6*time.Second, openErr.RetryAfter)
+
+	// Other hosts are unaffected.
+	_, err = breaker.allow(CDNHost)
+	assert.NoError(t, err)
+
+	*now = now.Add(6 * time.Second)
+	assert.Equal(t, BreakerHalfOpen, breaker.State(StatsHost))
+	probe, err := breaker.allow(StatsHost)
+	require.NoError(t, err)
+	assert.True(t, probe)
+
+	// Only one probe at a time.
+	_, err = breaker.allow(StatsHost)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+
+	// A failed probe reopens the circuit.
+	breaker.record(StatsHost, probe, breakerFailure)
+	assert.Equal(t, BreakerOpen, breaker.State(StatsHost))
+
+	*now = now.Add(10 * time.Second)
+	probe, err = breaker.allow(StatsHost)
+	require.NoError(t, err)
+	breaker.record(StatsHost, probe, breakerSuccess)
+	assert.Equal(t, BreakerClosed, breaker.State(StatsHost))
+
+	assert.Equal(t, []transition{
+		{StatsHost, BreakerClosed, BreakerOpen},
+		{StatsHost, BreakerOpen, BreakerHalfOpen},
+		{StatsHost, BreakerHalfOpen, BreakerOpen},
+		{StatsHost, BreakerOpen, BreakerHalfOpen},
+		{StatsHost, BreakerHalfOpen, BreakerClosed},
+	}, *transitions)
+	assert.Equal(t, map[string]BreakerState{StatsHost: BreakerClosed, CDNHost: BreakerClosed}, breaker.States())
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	breaker, _, _ := newTestBreaker(BreakerConfig{FailureThreshold: 2})
+
+	breaker.record(StatsHost, false, breakerFailure)
+	breaker.record(StatsHost, false, breakerSuccess)
+	breaker.record(StatsHost, false, breakerFailure)
+
+	assert.Equal(t, BreakerClosed, breaker.State(StatsHost))
+}
+
+func TestCircuitBreaker_IgnoredProbeIsReleased(t *testing.T) {
+	breaker, now, _ := newTestBreaker(BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Second})
+	breaker.record(StatsHost, false, breakerFailure)
+	*now = now.Add(time.Second)
+
+	probe, err := breaker.allow(StatsHost)
+	require.NoError(t, err)
+	breaker.record(StatsHost, probe, breakerIgnored)
+
+	probe, err = breaker.allow(StatsHost)
+	require.NoError(t, err)
+	assert.True(t, probe)
+}
+
+func TestCircuitBreaker_Reset(t *testing.T) {
+	breaker, _, _ := newTestBreaker(BreakerConfig{FailureThreshold: 1})
+	breaker.record(StatsHost, false, breakerFailure)
+	require.Equal(t, BreakerOpen, breaker.State(StatsHost))
+
+	breaker.Reset(StatsHost)
+
+	assert.Equal(t, BreakerClosed, breaker.State(StatsHost))
+}
+
+func TestHTTPClient_CircuitBreaker_FailsFast(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	breaker := NewCircuitBreaker(BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Minute})
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithCircuitBreaker(breaker))
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	_, err := client.SendRequest(context.Background(), "teamdetails", nil)
+	assert.ErrorIs(t, err, ErrCircuitOpen, "retries stop once the circuit opens")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	_, err = client.SendRequest(context.Background(), "teamdetails", nil)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, ErrorClassCircuitOpen, ClassifyError(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.Equal(t, BreakerOpen, breaker.State("127.0.0.1"))
+}
+
+func TestHTTPClient_CircuitBreaker_Timeouts(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	breaker := NewCircuitBreaker(BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Minute})
+	client := NewHTTPClient(server.URL+"/%s", nil, nil,
+		WithCircuitBreaker(breaker),
+		WithTimeout(20*time.Millisecond))
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	_, err := client.SendRequest(context.Background(), "teamdetails", nil)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrCircuitOpen)
+
+	start := time.Now()
+	_, err = client.SendRequest(context.Background(), "teamdetails", nil)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Less(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestHTTPClient_CircuitBreaker_IgnoresClientErrorsAndCancellation(t *testing.T) {
+	block := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("block") != "" {
+			select {
+			case <-block:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	defer close(block)
+
+	breaker := NewCircuitBreaker(BreakerConfig{FailureThreshold: 1})
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithCircuitBreaker(breaker))
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	_, err := client.SendRequest(context.Background(), "teamdetails", nil)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.SendRequest(ctx, "teamdetails", map[string]string{"block": "1"})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	assert.Equal(t, BreakerClosed, breaker.State("127.0.0.1"))
+}
+
+func TestIsBreakerFailure(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		statusCode int
+		want       bool
+	}{
+		{name: "success", statusCode: http.StatusOK},
+		{name: "server error", statusCode: http.StatusBadGateway, want: true},
+		{name: "rate limited", statusCode: http.StatusTooManyRequests, want: true},
+		{name: "bad parameter", statusCode: http.StatusBadRequest},
+		{name: "not found", statusCode: http.StatusNotFound},
+		{name: "transport error", err: errors.New("failed to send request: connection refused"), want: true},
+		{name: "timeout", err: &TimeoutError{Phase: "headers"}, want: true},
+		{name: "invalid JSON", err: fmt.Errorf("%w: unexpected EOF", ErrInvalidJSON), statusCode: http.StatusOK},
+		{name: "unsupported encoding", err: fmt.Errorf("%w: \"zstd\"", ErrUnsupportedEncoding)},
+		{name: "canceled", err: fmt.Errorf("failed to send request: %w", context.Canceled)},
+		{name: "API client error", err: &APIError{StatusCode: http.StatusBadRequest}},
+		{name: "API server error", err: &APIError{StatusCode: http.StatusServiceUnavailable}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isBreakerFailure(tt.err, tt.statusCode))
+		})
+	}
+}
+
+func TestHTTPClient_CircuitBreaker_IgnoresInvalidJSONAndCanceledRequests(t *testing.T) {
+	block := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("block") != "" {
+			select {
+			case <-block:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"resource": "teamdetails", "resultSets": [`))
+	}))
+	defer server.Close()
+	defer close(block)
+
+	breaker := NewCircuitBreaker(BreakerConfig{FailureThreshold: 1})
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithCircuitBreaker(breaker))
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	var payload map[string]interface{}
+	_, err := client.Get(context.Background(), "teamdetails", nil, &payload)
+	assert.ErrorIs(t, err, ErrInvalidJSON)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	_, err = client.SendRequest(ctx, "teamdetails", map[string]string{"block": "1"})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	assert.Equal(t, BreakerClosed, breaker.State("127.0.0.1"))
+}
@@ -0,0 +1,305 @@
+package client
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// NoExpiration is a TTL for entries that never expire, such as box scores
+// of games from completed seasons.
+const NoExpiration time.Duration = -1
+
+// ErrCacheMiss is returned by Cache.Get when no valid entry exists.
+var ErrCacheMiss = errors.New("cache miss")
+
+// Cache stores raw API responses keyed by the canonical request URL.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the entry stored under key, or ErrCacheMiss if it is
+	// missing or expired.
+	Get(key string) (*CacheEntry, error)
+	// Set stores entry under key.
+	Set(key string, entry *CacheEntry) error
+	// Delete removes the entry stored under key.
+	Delete(key string) error
+}
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	Body       []byte      `json:"body"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	StoredAt   time.Time   `json:"stored_at"`
+	// ExpiresAt is the zero time for entries that never expire.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Expired reports whether the entry is expired at the given time.
+func (e *CacheEntry) Expired(now time.Time) bool {
+	return !e.ExpiresAt.IsZero() && !now.Before(e.ExpiresAt)
+}
+
+// CacheRule assigns a TTL to the endpoints matching a pattern.
+type CacheRule struct {
+	// Endpoint is a path.Match pattern matched against the endpoint name,
+	// e.g. "drafthistory", "boxscore*" or "scoreboard/*".
+	Endpoint string
+	// TTL is how long responses are cached. Zero disables caching and
+	// NoExpiration caches responses forever.
+	TTL time.Duration
+	// When optionally restricts the rule to matching request parameters.
+	When func(params map[string]string) bool
+}
+
+// CachePolicy decides how long responses of each endpoint are cached.
+type CachePolicy struct {
+	// Rules are evaluated in order; the first matching rule wins.
+	Rules []CacheRule
+	// DefaultTTL applies to endpoints without a matching rule.
+	DefaultTTL time.Duration
+}
+
+// DefaultCachePolicy returns a policy that caches historical data forever,
+// slowly changing reference data for a day and live CDN data for a few
+// seconds. Everything else is not cached.
+func DefaultCachePolicy() CachePolicy {
+	return CachePolicy{
+		Rules: []CacheRule{
+			{Endpoint: "scoreboard/*", TTL: 5 * time.Second},
+			{Endpoint: "*", TTL: NoExpiration, When: IsCompletedSeasonGame},
+			{Endpoint: "*", TTL: NoExpiration, When: IsCompletedSeason},
+			{Endpoint: "drafthistory", TTL: 24 * time.Hour},
+			{Endpoint: "franchisehistory", TTL: 24 * time.Hour},
+			{Endpoint: "commonteamyears", TTL: 24 * time.Hour},
+		},
+	}
+}
+
+// TTL returns the cache TTL for a request to endpoint with params.
+func (p CachePolicy) TTL(endpoint string, params map[string]string) time.Duration {
+	for _, rule := range p.Rules {
+		matched, err := path.Match(rule.Endpoint, endpoint)
+		if err != nil || !matched {
+			continue
+		}
+		if rule.When != nil && !rule.When(params) {
+			continue
+		}
+		return rule.TTL
+	}
+	return p.DefaultTTL
+}
+
+// IsCompletedSeason reports whether the request targets a season, given in
+// the "Season" or "SeasonYear" parameter as "2022-23", that has finished.
+func IsCompletedSeason(params map[string]string) bool {
+	season := params["Season"]
+	if season == "" {
+		season = params["SeasonYear"]
+	}
+	if len(season) < 4 {
+		return false
+	}
+	startYear, err := strconv.Atoi(season[:4])
+	if err != nil {
+		return false
+	}
+	return seasonCompleted(startYear, time.Now())
+}
+
+// IsCompletedSeasonGame reports whether the "GameID" parameter refers to a
+// game of a finished season. NBA game IDs encode the season start year in
+// their fourth and fifth digits, e.g. "0022300001" is a 2023-24 game.
+func IsCompletedSeasonGame(params map[string]string) bool {
+	gameID := params["GameID"]
+	if len(gameID) != 10 {
+		return false
+	}
+	yy, err := strconv.Atoi(gameID[3:5])
+	if err != nil {
+		return false
+	}
+	return seasonCompleted(2000+yy, time.Now())
+}
+
+// seasonCompleted reports whether the season starting in startYear has
+// ended (including playoffs) at the given time.
+func seasonCompleted(startYear int, now time.Time) bool {
+	end := time.Date(startYear+1, time.July, 1, 0, 0, 0, 0, time.UTC)
+	return now.After(end)
+}
+
+// LRUCache is an in-memory Cache that evicts the least recently used
+// entries once it holds more than its capacity.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+// lruItem is a single LRUCache element.
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache creates an in-memory cache holding at most capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get implements Cache.
+func (c *LRUCache) Get(key string) (*CacheEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	item := elem.Value.(*lruItem)
+	if item.entry.Expired(c.now()) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return nil, ErrCacheMiss
+	}
+	c.order.MoveToFront(elem)
+	return item.entry, nil
+}
+
+// Set implements Cache.
+func (c *LRUCache) Set(key string, entry *CacheEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(elem)
+		return nil
+	}
+
+	c.items[key] = c.order.PushFront(&lruItem{key: key, entry: entry})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+	return nil
+}
+
+// Delete implements Cache.
+func (c *LRUCache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+	return nil
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// FileCache is a Cache that stores each entry as a JSON file in a directory.
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache creates a file cache in dir, creating the directory if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// Get implements Cache.
+func (c *FileCache) Get(key string) (*CacheEntry, error) {
+	data, err := os.ReadFile(c.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrCacheMiss
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to decode cache entry: %w", err)
+	}
+	if entry.Expired(c.now()) {
+		_ = c.Delete(key)
+		return nil, ErrCacheMiss
+	}
+	return &entry, nil
+}
+
+// Set implements Cache. Entries are written atomically.
+func (c *FileCache) Set(key string, entry *CacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(c.dir, "entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to close cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to store cache file: %w", err)
+	}
+	return nil
+}
+
+// Delete implements Cache.
+func (c *FileCache) Delete(key string) error {
+	if err := os.Remove(c.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete cache entry: %w", err)
+	}
+	return nil
+}
+
+// path returns the file path for key.
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
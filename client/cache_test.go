@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	require.NoError(t, cache.Set("a", &CacheEntry{Body: []byte("a")}))
+	require.NoError(t, cache.Set("b", &CacheEntry{Body: []byte("b")}))
+
+	// Touch "a" so that "b" becomes the least recently used entry.
+	_, err := cache.Get("a")
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Set("c", &CacheEntry{Body: []byte("c")}))
+
+	assert.Equal(t, 2, cache.Len())
+	_, err = cache.Get("b")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+
+	entry, err := cache.Get("a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("a"), entry.Body)
+
+	require.NoError(t, cache.Delete("a"))
+	_, err = cache.Get("a")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+}
+
+func TestLRUCache_Expiration(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewLRUCache(10)
+	cache.now = func() time.Time { return now }
+
+	require.NoError(t, cache.Set("short", &CacheEntry{ExpiresAt: now.Add(time.Second)}))
+	require.NoError(t, cache.Set("forever", &CacheEntry{}))
+
+	now = now.Add(time.Minute)
+
+	_, err := cache.Get("short")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+	_, err = cache.Get("forever")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestFileCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache, err := NewFileCache(t.TempDir())
+	require.NoError(t, err)
+	cache.now = func() time.Time { return now }
+
+	_, err = cache.Get("missing")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+
+	entry := &CacheEntry{
+		Body:       []byte(`{"resultSets": []}`),
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Etag": []string{`"abc"`}},
+		StoredAt:   now,
+		ExpiresAt:  now.Add(time.Hour),
+	}
+	require.NoError(t, cache.Set("https://stats.nba.com/stats/drafthistory?LeagueID=00", entry))
+
+	got, err := cache.Get("https://stats.nba.com/stats/drafthistory?LeagueID=00")
+	require.NoError(t, err)
+	assert.Equal(t, entry.Body, got.Body)
+	assert.Equal(t, entry.StatusCode, got.StatusCode)
+	assert.Equal(t, `"abc"`, got.Header.Get("ETag"))
+	assert.True(t, entry.ExpiresAt.Equal(got.ExpiresAt))
+
+	now = now.Add(2 * time.Hour)
+	_, err = cache.Get("https://stats.nba.com/stats/drafthistory?LeagueID=00")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+
+	files, err := os.ReadDir(cache.dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestCachePolicy_TTL(t *testing.T) {
+	policy := CachePolicy{
+		Rules: []CacheRule{
+			{Endpoint: "scoreboard/*", TTL: 5 * time.Second},
+			{Endpoint: "boxscore*", TTL: NoExpiration, When: func(params map[string]string) bool {
+				return params["GameID"] == "0022200001"
+			}},
+			{Endpoint: "drafthistory", TTL: time.Hour},
+		},
+		DefaultTTL: time.Minute,
+	}
+
+	assert.Equal(t, 5*time.Second, policy.TTL("scoreboard/todaysScoreboard_00.json", nil))
+	assert.Equal(t, NoExpiration, policy.TTL("boxscoretraditionalv3", map[string]string{"GameID": "0022200001"}))
+	assert.Equal(t, time.Minute, policy.TTL("boxscoretraditionalv3", map[string]string{"GameID": "0022400001"}))
+	assert.Equal(t, time.Hour, policy.TTL("drafthistory", nil))
+	assert.Equal(t, time.Minute, policy.TTL("playercareerstats", nil))
+}
+
+func TestDefaultCachePolicy(t *testing.T) {
+	policy := DefaultCachePolicy()
+
+	assert.Equal(t, 5*time.Second, policy.TTL("scoreboard/todaysScoreboard_00.json", nil))
+	assert.Equal(t, NoExpiration, policy.TTL("boxscoretraditionalv3", map[string]string{"GameID": "0021500001"}))
+	assert.Equal(t, NoExpiration, policy.TTL("leaguegamelog", map[string]string{"Season": "2015-16"}))
+	assert.Equal(t, 24*time.Hour, policy.TTL("drafthistory", map[string]string{"LeagueID": "00"}))
+	assert.Equal(t, time.Duration(0), policy.TTL("playercareerstats", map[string]string{"PlayerID": "2544"}))
+}
+
+func TestSeasonCompleted(t *testing.T) {
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, seasonCompleted(2022, now))
+	assert.True(t, seasonCompleted(2023, now))
+	assert.False(t, seasonCompleted(2024, now))
+	assert.False(t, seasonCompleted(2023, time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestHTTPClient_SendRequest_Cache(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"resource": "drafthistory"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.SetCache(NewLRUCache(10))
+	client.SetCachePolicy(CachePolicy{
+		Rules: []CacheRule{{Endpoint: "drafthistory", TTL: time.Hour}},
+	})
+
+	first, err := client.SendRequest(context.Background(), "drafthistory", map[string]string{"LeagueID": "00"})
+	require.NoError(t, err)
+	assert.False(t, first.FromCache())
+
+	second, err := client.SendRequest(context.Background(), "drafthistory", map[string]string{"LeagueID": "00"})
+	require.NoError(t, err)
+	assert.True(t, second.FromCache())
+	assert.Equal(t, first.GetRaw(), second.GetRaw())
+	assert.Equal(t, first.GetURL(), second.GetURL())
+
+	// Uncached endpoints always hit the server.
+	_, err = client.SendRequest(context.Background(), "playercareerstats", nil)
+	require.NoError(t, err)
+	_, err = client.SendRequest(context.Background(), "playercareerstats", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_SendRequest_CacheSkipsErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.SetCache(NewLRUCache(10))
+	client.SetCachePolicy(CachePolicy{DefaultTTL: time.Hour})
+
+	_, err := client.SendRequest(context.Background(), "drafthistory", nil)
+	require.Error(t, err)
+	_, err = client.SendRequest(context.Background(), "drafthistory", nil)
+	require.Error(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
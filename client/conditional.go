@@ -0,0 +1,66 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+)
+
+// validators holds the cache validators returned for a URL.
+type validators struct {
+	etag         string
+	lastModified string
+}
+
+// validatorStore remembers response validators per URL so that later
+// requests can be sent as conditional GETs.
+type validatorStore struct {
+	mu    sync.Mutex
+	byURL map[string]validators
+}
+
+// newValidatorStore creates an empty validator store.
+func newValidatorStore() *validatorStore {
+	return &validatorStore{byURL: make(map[string]validators)}
+}
+
+// apply adds If-None-Match and If-Modified-Since headers for url to req.
+func (s *validatorStore) apply(url string, req *http.Request) {
+	s.mu.Lock()
+	v, ok := s.byURL[url]
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	if v.etag != "" {
+		req.Header.Set("If-None-Match", v.etag)
+	}
+	if v.lastModified != "" {
+		req.Header.Set("If-Modified-Since", v.lastModified)
+	}
+}
+
+// update stores the validators found in header for url.
+func (s *validatorStore) update(url string, header http.Header) {
+	v := validators{
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v.etag == "" && v.lastModified == "" {
+		delete(s.byURL, url)
+		return
+	}
+	s.byURL[url] = v
+}
+
+// forget removes the validators stored for url.
+func (s *validatorStore) forget(url string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.byURL, url)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newConditionalServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` &&
+			r.Header.Get("If-Modified-Since") == "Mon, 01 Jan 2024 00:00:00 GMT" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"games": []}`))
+	}))
+}
+
+func TestHTTPClient_SendRequest_ConditionalRequests(t *testing.T) {
+	server := newConditionalServer(t)
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.SetConditionalRequests(true)
+
+	first, err := client.SendRequest(context.Background(), "scoreboard.json", nil)
+	require.NoError(t, err)
+	assert.False(t, first.NotModified())
+	assert.Equal(t, `{"games": []}`, first.GetRaw())
+
+	second, err := client.SendRequest(context.Background(), "scoreboard.json", nil)
+	require.NoError(t, err)
+	assert.True(t, second.NotModified())
+	assert.Equal(t, http.StatusNotModified, second.GetStatusCode())
+	assert.Empty(t, second.GetRaw())
+
+	client.ForgetValidators("scoreboard.json", nil)
+
+	third, err := client.SendRequest(context.Background(), "scoreboard.json", nil)
+	require.NoError(t, err)
+	assert.False(t, third.NotModified())
+}
+
+func TestHTTPClient_SendRequest_ConditionalRequestsDisabled(t *testing.T) {
+	server := newConditionalServer(t)
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.SendRequest(context.Background(), "scoreboard.json", nil)
+		require.NoError(t, err)
+		assert.False(t, resp.NotModified())
+		assert.Equal(t, http.StatusOK, resp.GetStatusCode())
+	}
+}
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type decodeResultSet struct {
+	Name    string          `json:"name"`
+	Headers []string        `json:"headers"`
+	RowSet  [][]interface{} `json:"rowSet"`
+}
+
+type decodeResponse struct {
+	Resource   string            `json:"resource"`
+	ResultSets []decodeResultSet `json:"resultSets"`
+}
+
+const decodePayload = `{"resource": "commonplayerinfo", "resultSets": [{"name": "CommonPlayerInfo", "headers": ["PERSON_ID"], "rowSet": [[2544]]}]}`
+
+func newDecodeServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHTTPClient_Get(t *testing.T) {
+	server := newDecodeServer(t, decodePayload)
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+
+	var result decodeResponse
+	resp, err := client.Get(context.Background(), "commonplayerinfo", map[string]string{"PlayerID": "2544"}, &result)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.GetStatusCode())
+	assert.Equal(t, "commonplayerinfo", result.Resource)
+	require.Len(t, result.ResultSets, 1)
+	assert.Equal(t, []interface{}{float64(2544)}, result.ResultSets[0].RowSet[0])
+	assert.Empty(t, resp.GetRaw(), "raw body should not be retained by default")
+}
+
+func TestHTTPClient_Get_RetainRawBody(t *testing.T) {
+	server := newDecodeServer(t, decodePayload)
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithRetainRawBody())
+
+	var result decodeResponse
+	resp, err := client.Get(context.Background(), "commonplayerinfo", nil, &result)
+
+	require.NoError(t, err)
+	assert.Equal(t, decodePayload, resp.GetRaw())
+	assert.Equal(t, "commonplayerinfo", result.Resource)
+}
+
+func TestHTTPClient_Get_InvalidJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "syntax error", body: `{"resource": `},
+		{name: "html", body: `<html>Access Denied</html>`},
+		{name: "trailing data", body: `{"resource": "a"} {"resource": "b"}`},
+		{name: "wrong type", body: `{"resultSets": "none"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newDecodeServer(t, tt.body)
+			client := NewHTTPClient(server.URL+"/%s", nil, nil)
+			client.SetRetryPolicy(NoRetryPolicy())
+
+			var result decodeResponse
+			_, err := client.Get(context.Background(), "commonplayerinfo", nil, &result)
+
+			assert.ErrorIs(t, err, ErrInvalidJSON)
+		})
+	}
+}
+
+func TestHTTPClient_Get_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("PlayerID is required"))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+
+	var result decodeResponse
+	_, err := client.Get(context.Background(), "commonplayerinfo", nil, &result)
+
+	assert.ErrorIs(t, err, ErrBadParameter)
+	assert.NotErrorIs(t, err, ErrInvalidJSON)
+}
+
+func TestHTTPClient_Get_Cached(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(decodePayload))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.SetCache(NewLRUCache(10))
+	client.SetCachePolicy(CachePolicy{DefaultTTL: time.Minute})
+
+	var first, second decodeResponse
+	_, err := client.Get(context.Background(), "commonplayerinfo", nil, &first)
+	require.NoError(t, err)
+	resp, err := client.Get(context.Background(), "commonplayerinfo", nil, &second)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls)
+	assert.True(t, resp.FromCache())
+	assert.Equal(t, first, second)
+}
+
+func TestHTTPClient_Get_AfterResponseSeesBody(t *testing.T) {
+	server := newDecodeServer(t, decodePayload)
+
+	var body []byte
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.Use(Interceptor{
+		AfterResponse: func(ctx context.Context, info *ResponseInfo) error {
+			body = info.Body
+			return nil
+		},
+	})
+
+	var result decodeResponse
+	_, err := client.Get(context.Background(), "commonplayerinfo", nil, &result)
+
+	require.NoError(t, err)
+	assert.Equal(t, decodePayload, string(body))
+}
+
+// largePayload builds a result set shaped like ShotChartLeagueWide or
+// LeagueGameLog with the given number of rows.
+func largePayload(rows int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`{"resource": "leaguegamelog", "parameters": {}, "resultSets": [{"name": "LeagueGameLog", "headers": [`)
+	const columns = 24
+	for i := 0; i < columns; i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `"COLUMN_%d"`, i)
+	}
+	buf.WriteString(`], "rowSet": [`)
+	for r := 0; r < rows; r++ {
+		if r > 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, `["22300%04d", "%s", %d, %.3f, null`, r, strings.Repeat("X", 12), r, float64(r)/7)
+		for i := 5; i < columns; i++ {
+			fmt.Fprintf(&buf, ", %d", i*r%97)
+		}
+		buf.WriteByte(']')
+	}
+	buf.WriteString(`]}]}`)
+	return buf.Bytes()
+}
+
+// newBenchmarkClient returns a client whose transport serves payload from
+// memory, so the benchmarks measure decoding rather than networking.
+func newBenchmarkClient(payload []byte) *HTTPClient {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(payload)),
+			Request:    req,
+		}, nil
+	})
+	return NewHTTPClient("http://stats.nba.test/stats/%s", nil, slog.New(slog.NewTextHandler(io.Discard, nil)), WithTransport(transport))
+}
+
+func TestLargePayloadIsValid(t *testing.T) {
+	assert.True(t, json.Valid(largePayload(10)))
+}
+
+// BenchmarkHTTPClient_SendRequest_GetJSON measures the buffered path:
+// ReadAll, a string copy, validation and a second unmarshal.
+func BenchmarkHTTPClient_SendRequest_GetJSON(b *testing.B) {
+	payload := largePayload(20000)
+	client := newBenchmarkClient(payload)
+	ctx := context.Background()
+
+	b.SetBytes(int64(len(payload)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp, err := client.SendRequest(ctx, "leaguegamelog", nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if !resp.IsValidJSON() {
+			b.Fatal("invalid JSON")
+		}
+		var result decodeResponse
+		if err := resp.GetJSON(&result); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkHTTPClient_Get measures the single-pass streaming path.
+func BenchmarkHTTPClient_Get(b *testing.B) {
+	payload := largePayload(20000)
+	client := newBenchmarkClient(payload)
+	ctx := context.Background()
+
+	b.SetBytes(int64(len(payload)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var result decodeResponse
+		if _, err := client.Get(ctx, "leaguegamelog", nil, &result); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkHTTPClient_Get_RetainRawBody measures streaming with the raw
+// body kept for debugging.
+func BenchmarkHTTPClient_Get_RetainRawBody(b *testing.B) {
+	payload := largePayload(20000)
+	client := newBenchmarkClient(payload)
+	client.SetRetainRawBody(true)
+	ctx := context.Background()
+
+	b.SetBytes(int64(len(payload)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var result decodeResponse
+		if _, err := client.Get(ctx, "leaguegamelog", nil, &result); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// ErrUnsupportedEncoding is returned when a response uses a content coding
+// the client cannot decode.
+var ErrUnsupportedEncoding = errors.New("unsupported content encoding")
+
+// decoder is a decompressing reader with the closers of every layer.
+type decoder struct {
+	io.Reader
+	closers []io.Closer
+}
+
+// Close closes every decoding layer, outermost first.
+func (d *decoder) Close() error {
+	var errs []error
+	for i := len(d.closers) - 1; i >= 0; i-- {
+		if err := d.closers[i].Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// decodeBody returns a reader that undoes the codings listed in
+// contentEncoding. Codings are listed in the order they were applied, so
+// they are removed in reverse. gzip, deflate, br and identity are supported.
+func decodeBody(body io.Reader, contentEncoding string) (io.ReadCloser, error) {
+	d := &decoder{Reader: body}
+
+	codings := strings.Split(contentEncoding, ",")
+	for i := len(codings) - 1; i >= 0; i-- {
+		coding := strings.ToLower(strings.TrimSpace(codings[i]))
+		switch coding {
+		case "", "identity":
+			continue
+		case "gzip", "x-gzip":
+			gzipReader, err := gzip.NewReader(d.Reader)
+			if err != nil {
+				_ = d.Close()
+				return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+			}
+			d.Reader = gzipReader
+			d.closers = append(d.closers, gzipReader)
+		case "deflate":
+			deflateReader, err := newDeflateReader(d.Reader)
+			if err != nil {
+				_ = d.Close()
+				return nil, fmt.Errorf("failed to create deflate reader: %w", err)
+			}
+			d.Reader = deflateReader
+			d.closers = append(d.closers, deflateReader)
+		case "br":
+			d.Reader = brotli.NewReader(d.Reader)
+		default:
+			_ = d.Close()
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedEncoding, coding)
+		}
+	}
+
+	return d, nil
+}
+
+// newDeflateReader returns a reader for the "deflate" coding. The coding is
+// defined as a zlib stream, but some servers send raw DEFLATE data, so the
+// zlib header is detected rather than assumed.
+func newDeflateReader(r io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(r)
+	header, err := buffered.Peek(2)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if isZlibHeader(header) {
+		return zlib.NewReader(buffered)
+	}
+	return flate.NewReader(buffered), nil
+}
+
+// isZlibHeader reports whether b starts with a valid zlib header (RFC 1950):
+// compression method 8 and a header checksum divisible by 31.
+func isZlibHeader(b []byte) bool {
+	if len(b) < 2 {
+		return false
+	}
+	return b[0]&0x0f == 8 && (uint16(b[0])<<8|uint16(b[1]))%31 == 0
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const encodedPayload = `{"resource": "scoreboard", "resultSets": [{"name": "GameHeader", "headers": ["GAME_ID"], "rowSet": [["0022300001"]]}]}`
+
+func compress(t *testing.T, coding string, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch coding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "deflate":
+		w = zlib.NewWriter(&buf)
+	case "raw-deflate":
+		fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		require.NoError(t, err)
+		w = fw
+	case "br":
+		w = brotli.NewWriter(&buf)
+	default:
+		t.Fatalf("unknown coding %q", coding)
+	}
+
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestHTTPClient_SendRequest_ContentEncoding(t *testing.T) {
+	payload := []byte(encodedPayload)
+
+	tests := []struct {
+		name            string
+		contentEncoding string
+		body            []byte
+	}{
+		{name: "identity", contentEncoding: "", body: payload},
+		{name: "gzip", contentEncoding: "gzip", body: compress(t, "gzip", payload)},
+		{name: "deflate", contentEncoding: "deflate", body: compress(t, "deflate", payload)},
+		{name: "raw deflate", contentEncoding: "deflate", body: compress(t, "raw-deflate", payload)},
+		{name: "brotli", contentEncoding: "br", body: compress(t, "br", payload)},
+		{name: "uppercase", contentEncoding: "BR", body: compress(t, "br", payload)},
+		{
+			name:            "stacked",
+			contentEncoding: "gzip, br",
+			body:            compress(t, "br", compress(t, "gzip", payload)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.contentEncoding != "" {
+					w.Header().Set("Content-Encoding", tt.contentEncoding)
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(tt.body)
+			}))
+			defer server.Close()
+
+			headers := map[string]string{"Accept-Encoding": "gzip, deflate, br"}
+			client := NewHTTPClient(server.URL+"/%s", headers, nil)
+
+			resp, err := client.SendRequest(context.Background(), "scoreboardv2", nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, encodedPayload, resp.GetRaw())
+			assert.True(t, resp.IsValidJSON())
+		})
+	}
+}
+
+func TestHTTPClient_SendRequest_UnsupportedEncoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "zstd")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("not zstd"))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	_, err := client.SendRequest(context.Background(), "scoreboardv2", nil)
+
+	assert.ErrorIs(t, err, ErrUnsupportedEncoding)
+}
+
+func TestDecodeBody_CorruptGzip(t *testing.T) {
+	_, err := decodeBody(bytes.NewReader([]byte("not gzip")), "gzip")
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	bodyPreviewLength = 500
+	maxMessageLength  = 300
+)
+
+var (
+	// ErrRateLimited is matched by API errors caused by throttling (HTTP 429).
+	ErrRateLimited = errors.New("nba api: rate limited")
+	// ErrNotFound is matched by API errors for unknown resources (HTTP 404).
+	ErrNotFound = errors.New("nba api: not found")
+	// ErrBadParameter is matched by API errors caused by missing or invalid
+	// request parameters (HTTP 400 or a parameter validation message).
+	ErrBadParameter = errors.New("nba api: bad parameter")
+	// ErrInvalidJSON is matched by errors for successful responses whose
+	// body is not valid JSON for the requested type.
+	ErrInvalidJSON = errors.New("invalid JSON response")
+)
+
+// APIError describes a non-2xx response returned by the NBA API.
+// Use errors.As to retrieve it and errors.Is to compare it with the
+// ErrRateLimited, ErrNotFound and ErrBadParameter sentinels.
+type APIError struct {
+	StatusCode  int
+	URL         string
+	Endpoint    string
+	Parameters  map[string]string
+	BodyPreview string
+	Message     string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("nba api: %s returned status %d", e.endpointName(), e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the package sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrBadParameter:
+		return e.StatusCode == http.StatusBadRequest || isParameterMessage(e.Message)
+	}
+	return false
+}
+
+// endpointName returns the endpoint name, falling back to the URL.
+func (e *APIError) endpointName() string {
+	if e.Endpoint != "" {
+		return e.Endpoint
+	}
+	return e.URL
+}
+
+// newAPIError builds an APIError from a non-2xx response.
+func newAPIError(resp *Response, endpoint string, params map[string]string) *APIError {
+	return &APIError{
+		StatusCode:  resp.statusCode,
+		URL:         resp.url,
+		Endpoint:    endpoint,
+		Parameters:  params,
+		BodyPreview: truncate(resp.raw, bodyPreviewLength),
+		Message:     parseErrorMessage(resp.raw),
+	}
+}
+
+// isSuccessStatus reports whether the status code is 2xx.
+func isSuccessStatus(statusCode int) bool {
+	return statusCode >= 200 && statusCode < 300
+}
+
+// parseErrorMessage extracts a human readable message from an error body.
+// The NBA API replies with either plain text ("The PlayerID property is
+// required.") or a JSON object with a message field; HTML pages are ignored.
+func parseErrorMessage(body string) string {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return ""
+	}
+
+	if strings.HasPrefix(body, "{") {
+		var payload map[string]interface{}
+		if err := json.Unmarshal([]byte(body), &payload); err == nil {
+			for _, key := range []string{"Message", "message", "error", "Error", "detail"} {
+				if value, ok := payload[key].(string); ok && value != "" {
+					return truncate(value, maxMessageLength)
+				}
+			}
+		}
+		return ""
+	}
+
+	if strings.HasPrefix(body, "<") {
+		return ""
+	}
+
+	if line, _, found := strings.Cut(body, "\n"); found {
+		body = strings.TrimSpace(line)
+	}
+	return truncate(body, maxMessageLength)
+}
+
+// isParameterMessage reports whether msg looks like a parameter validation error.
+func isParameterMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "property is required") ||
+		strings.Contains(msg, "is not valid") ||
+		strings.Contains(msg, "invalid parameter") ||
+		strings.Contains(msg, "parameter is required")
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClient_SendRequest_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("The PlayerID property is required."))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/stats/%s", nil, nil)
+	params := map[string]string{"PerMode": "PerGame"}
+
+	_, err := client.SendRequest(context.Background(), "playercareerstats", params)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, "playercareerstats", apiErr.Endpoint)
+	assert.Equal(t, params, apiErr.Parameters)
+	assert.Contains(t, apiErr.URL, "/stats/playercareerstats?PerMode=PerGame")
+	assert.Equal(t, "The PlayerID property is required.", apiErr.Message)
+	assert.Equal(t, "The PlayerID property is required.", apiErr.BodyPreview)
+	assert.Equal(t, "nba api: playercareerstats returned status 400: The PlayerID property is required.", apiErr.Error())
+	assert.ErrorIs(t, err, ErrBadParameter)
+	assert.NotErrorIs(t, err, ErrNotFound)
+}
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *APIError
+		target   error
+		expected bool
+	}{
+		{name: "rate limited", err: &APIError{StatusCode: 429}, target: ErrRateLimited, expected: true},
+		{name: "not found", err: &APIError{StatusCode: 404}, target: ErrNotFound, expected: true},
+		{name: "bad request", err: &APIError{StatusCode: 400}, target: ErrBadParameter, expected: true},
+		{
+			name:     "parameter message on 500",
+			err:      &APIError{StatusCode: 500, Message: "The value '2024' is not valid for Season."},
+			target:   ErrBadParameter,
+			expected: true,
+		},
+		{name: "server error", err: &APIError{StatusCode: 500}, target: ErrBadParameter, expected: false},
+		{name: "server error not rate limited", err: &APIError{StatusCode: 503}, target: ErrRateLimited, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, errors.Is(tt.err, tt.target))
+		})
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{name: "empty", body: "", expected: ""},
+		{name: "plain text", body: "  The Season property is required.\r\n", expected: "The Season property is required."},
+		{name: "multi line", body: "first line\nsecond line", expected: "first line"},
+		{name: "json message", body: `{"Message": "An error has occurred."}`, expected: "An error has occurred."},
+		{name: "json without message", body: `{"resultSets": []}`, expected: ""},
+		{name: "html", body: "<html><body>Access Denied</body></html>", expected: ""},
+		{name: "long", body: strings.Repeat("a", 1000), expected: strings.Repeat("a", maxMessageLength)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseErrorMessage(tt.body))
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 5))
+	assert.Equal(t, "ab", truncate("abc", 2))
+	assert.Equal(t, "a", truncate("aé", 2))
+}
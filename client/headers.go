@@ -0,0 +1,335 @@
+package client
+
+import (
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// HeaderProfile is a named set of browser fingerprint headers: the
+// User-Agent, Accept-Language and the client hint and fetch metadata headers
+// a real browser would send alongside it.
+type HeaderProfile struct {
+	Name    string
+	Headers map[string]string
+}
+
+// fingerprintHeaders are replaced as a whole when a profile is applied, so
+// that headers of different browsers are never mixed in one request.
+var fingerprintHeaders = []string{
+	"User-Agent",
+	"Accept-Language",
+	"Sec-Ch-Ua",
+	"Sec-Ch-Ua-Mobile",
+	"Sec-Ch-Ua-Platform",
+	"Sec-Fetch-Dest",
+	"Sec-Fetch-Mode",
+	"Sec-Fetch-Site",
+}
+
+// Built-in header profiles.
+var (
+	// ProfileChrome is desktop Chrome on Windows.
+	ProfileChrome = HeaderProfile{
+		Name: "chrome",
+		Headers: map[string]string{
+			"User-Agent":         "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Safari/537.36",
+			"Accept-Language":    "en-US,en;q=0.9",
+			"Sec-Ch-Ua":          `"Chromium";v="140", "Google Chrome";v="140", "Not;A=Brand";v="24"`,
+			"Sec-Ch-Ua-Mobile":   "?0",
+			"Sec-Ch-Ua-Platform": `"Windows"`,
+			"Sec-Fetch-Dest":     "empty",
+			"Sec-Fetch-Mode":     "cors",
+			"Sec-Fetch-Site":     "same-site",
+		},
+	}
+
+	// ProfileFirefox is desktop Firefox on Windows. Firefox sends no client
+	// hints.
+	ProfileFirefox = HeaderProfile{
+		Name: "firefox",
+		Headers: map[string]string{
+			"User-Agent":      "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:143.0) Gecko/20100101 Firefox/143.0",
+			"Accept-Language": "en-US,en;q=0.5",
+			"Sec-Fetch-Dest":  "empty",
+			"Sec-Fetch-Mode":  "cors",
+			"Sec-Fetch-Site":  "same-site",
+		},
+	}
+
+	// ProfileSafari is desktop Safari on macOS. Safari sends no client hints.
+	ProfileSafari = HeaderProfile{
+		Name: "safari",
+		Headers: map[string]string{
+			"User-Agent":      "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/18.6 Safari/605.1.15",
+			"Accept-Language": "en-US,en;q=0.9",
+			"Sec-Fetch-Dest":  "empty",
+			"Sec-Fetch-Mode":  "cors",
+			"Sec-Fetch-Site":  "same-site",
+		},
+	}
+
+	// ProfileMobile is Chrome on Android.
+	ProfileMobile = HeaderProfile{
+		Name: "mobile",
+		Headers: map[string]string{
+			"User-Agent":         "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Mobile Safari/537.36",
+			"Accept-Language":    "en-US,en;q=0.9",
+			"Sec-Ch-Ua":          `"Chromium";v="140", "Google Chrome";v="140", "Not;A=Brand";v="24"`,
+			"Sec-Ch-Ua-Mobile":   "?1",
+			"Sec-Ch-Ua-Platform": `"Android"`,
+			"Sec-Fetch-Dest":     "empty",
+			"Sec-Fetch-Mode":     "cors",
+			"Sec-Fetch-Site":     "same-site",
+		},
+	}
+)
+
+// ErrInvalidHeaderProfile is returned when registering a profile without a
+// name or headers.
+var ErrInvalidHeaderProfile = errors.New("invalid header profile")
+
+// profiles is the registry of named header profiles.
+var profiles = struct {
+	sync.RWMutex
+	byName map[string]HeaderProfile
+}{
+	byName: map[string]HeaderProfile{
+		ProfileChrome.Name:  ProfileChrome,
+		ProfileFirefox.Name: ProfileFirefox,
+		ProfileSafari.Name:  ProfileSafari,
+		ProfileMobile.Name:  ProfileMobile,
+	},
+}
+
+// RegisterHeaderProfile adds profile to the registry, replacing any profile
+// with the same name.
+func RegisterHeaderProfile(profile HeaderProfile) error {
+	if profile.Name == "" || len(profile.Headers) == 0 {
+		return ErrInvalidHeaderProfile
+	}
+
+	profiles.Lock()
+	defer profiles.Unlock()
+	profiles.byName[profile.Name] = profile
+	return nil
+}
+
+// LookupHeaderProfile returns the registered profile with the given name.
+func LookupHeaderProfile(name string) (HeaderProfile, bool) {
+	profiles.RLock()
+	defer profiles.RUnlock()
+	profile, ok := profiles.byName[name]
+	return profile, ok
+}
+
+// HeaderProfiles returns the names of all registered profiles, sorted.
+func HeaderProfiles() []string {
+	profiles.RLock()
+	defer profiles.RUnlock()
+	names := make([]string, 0, len(profiles.byName))
+	for name := range profiles.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// apply replaces the fingerprint headers of h with those of the profile.
+func (p HeaderProfile) apply(h http.Header) {
+	for _, key := range fingerprintHeaders {
+		h.Del(key)
+	}
+	for key, value := range p.Headers {
+		h.Set(key, value)
+	}
+}
+
+// withHeaders returns base merged with the profile headers.
+func (p HeaderProfile) withHeaders(base map[string]string) map[string]string {
+	headers := make(map[string]string, len(base)+len(p.Headers))
+	for key, value := range base {
+		headers[key] = value
+	}
+	for key, value := range p.Headers {
+		headers[key] = value
+	}
+	return headers
+}
+
+// DefaultStatsHeaders returns the default headers for stats.nba.com
+// requests. They predate the header profiles and are kept unchanged; use
+// StatsHeaders to send the headers of a profile instead.
+func DefaultStatsHeaders() map[string]string {
+	return map[string]string{
+		"Host":             StatsHost,
+		"User-Agent":       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Safari/537.36",
+		"Accept":           "application/json, text/plain, */*",
+		"Accept-Language":  "en-US,en;q=0.5",
+		"Accept-Encoding":  "gzip, deflate, br",
+		"Connection":       "keep-alive",
+		"Referer":          "https://stats.nba.com/",
+		"Pragma":           "no-cache",
+		"Cache-Control":    "no-cache",
+		"Sec-Ch-Ua":        `"Chromium";v="140", "Google Chrome";v="140", "Not;A=Brand";v="24"`,
+		"Sec-Ch-Ua-Mobile": "?0",
+		"Sec-Fetch-Dest":   "empty",
+	}
+}
+
+// DefaultLiveHeaders returns the default headers for cdn.nba.com requests.
+// They predate the header profiles and are kept unchanged; use LiveHeaders to
+// send the headers of a profile instead.
+func DefaultLiveHeaders() map[string]string {
+	return map[string]string{
+		"Accept":          "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.9",
+		"Accept-Encoding": "gzip, deflate, br",
+		"Accept-Language": "en-US,en;q=0.9",
+		"Cache-Control":   "max-age=0",
+		"Connection":      "keep-alive",
+		"Host":            CDNHost,
+		"User-Agent":      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36",
+	}
+}
+
+// StatsHeaders returns the headers for stats.nba.com requests sent with
+// the given profile.
+func StatsHeaders(profile HeaderProfile) map[string]string {
+	return profile.withHeaders(map[string]string{
+		"Host":            StatsHost,
+		"Accept":          "application/json, text/plain, */*",
+		"Accept-Encoding": "gzip, deflate, br",
+		"Connection":      "keep-alive",
+		"Referer":         "https://stats.nba.com/",
+		"Pragma":          "no-cache",
+		"Cache-Control":   "no-cache",
+	})
+}
+
+// LiveHeaders returns the headers for cdn.nba.com requests sent with the
+// given profile.
+func LiveHeaders(profile HeaderProfile) map[string]string {
+	return profile.withHeaders(map[string]string{
+		"Host":            CDNHost,
+		"Accept":          "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.9",
+		"Accept-Encoding": "gzip, deflate, br",
+		"Connection":      "keep-alive",
+		"Cache-Control":   "max-age=0",
+	})
+}
+
+// RotationStrategy selects how a HeaderRotator picks profiles.
+type RotationStrategy int
+
+const (
+	// RotatePerSession keeps one randomly chosen profile until Rotate is
+	// called, like a single browser session.
+	RotatePerSession RotationStrategy = iota
+	// RotateRoundRobin cycles through the profiles, one per request.
+	RotateRoundRobin
+	// RotateRandom picks a random profile for every request.
+	RotateRandom
+)
+
+// HeaderRotator chooses the header profile of each request. A single
+// HeaderRotator may be shared by several clients.
+type HeaderRotator struct {
+	mu       sync.Mutex
+	profiles []HeaderProfile
+	strategy RotationStrategy
+	next     int
+	intn     func(n int) int
+}
+
+// NewHeaderRotator creates a rotator over profiles. Without profiles it
+// rotates over all registered profiles.
+func NewHeaderRotator(strategy RotationStrategy, profiles ...HeaderProfile) *HeaderRotator {
+	if len(profiles) == 0 {
+		for _, name := range HeaderProfiles() {
+			profile, _ := LookupHeaderProfile(name)
+			profiles = append(profiles, profile)
+		}
+	}
+
+	r := &HeaderRotator{
+		profiles: profiles,
+		strategy: strategy,
+		intn:     rand.IntN,
+	}
+	r.Rotate()
+	return r
+}
+
+// Profile returns the profile for the next request.
+func (r *HeaderRotator) Profile() HeaderProfile {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch r.strategy {
+	case RotateRoundRobin:
+		profile := r.profiles[r.next]
+		r.next = (r.next + 1) % len(r.profiles)
+		return profile
+	case RotateRandom:
+		return r.profiles[r.intn(len(r.profiles))]
+	default:
+		return r.profiles[r.next]
+	}
+}
+
+// Rotate starts a new session with a randomly chosen profile, for example
+// after the current profile has been blocked. It has no effect on the
+// per-request strategies.
+func (r *HeaderRotator) Rotate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.strategy == RotatePerSession {
+		r.next = r.intn(len(r.profiles))
+	}
+}
+
+// SetHeaderRotator sets the rotator that chooses the fingerprint headers of
+// every request attempt. The profile replaces the User-Agent, client hint
+// and fetch metadata headers of the client; nil disables rotation. A
+// User-Agent set with WithUserAgent still wins over the profile's.
+func (c *HTTPClient) SetHeaderRotator(rotator *HeaderRotator) {
+	c.rotator = rotator
+}
+
+// HeaderRotator returns the header rotator used by the client, if any.
+func (c *HTTPClient) HeaderRotator() *HeaderRotator {
+	return c.rotator
+}
+
+// applyHeaderProfile sets the fingerprint headers of the rotator's next
+// profile on req.
+func (c *HTTPClient) applyHeaderProfile(req *http.Request) {
+	if c.rotator == nil {
+		return
+	}
+	profile := c.rotator.Profile()
+	profile.apply(req.Header)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	c.logger.DebugContext(req.Context(), "Applied header profile",
+		slog.String("profile", profile.Name))
+}
+
+// WithHeaderProfile sends every request with the fingerprint headers of
+// profile, except for a User-Agent set with WithUserAgent.
+func WithHeaderProfile(profile HeaderProfile) Option {
+	return func(o *options) {
+		o.rotator = NewHeaderRotator(RotatePerSession, profile)
+	}
+}
+
+// WithHeaderRotator sets the header rotator of the HTTP client.
+func WithHeaderRotator(rotator *HeaderRotator) Option {
+	return func(o *options) {
+		o.rotator = rotator
+	}
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderProfiles_Presets(t *testing.T) {
+	for _, name := range []string{"chrome", "firefox", "safari", "mobile"} {
+		t.Run(name, func(t *testing.T) {
+			profile, ok := LookupHeaderProfile(name)
+			require.True(t, ok)
+			assert.NotEmpty(t, profile.Headers["User-Agent"])
+			assert.NotEmpty(t, profile.Headers["Accept-Language"])
+
+			// Only Chromium browsers send client hints, and then all of them.
+			_, hasHints := profile.Headers["Sec-Ch-Ua"]
+			assert.Equal(t, hasHints, profile.Headers["Sec-Ch-Ua-Mobile"] != "")
+			assert.Equal(t, hasHints, profile.Headers["Sec-Ch-Ua-Platform"] != "")
+
+			for key := range profile.Headers {
+				assert.Contains(t, fingerprintHeaders, http.CanonicalHeaderKey(key))
+			}
+		})
+	}
+}
+
+func TestStatsHeaders(t *testing.T) {
+	headers := StatsHeaders(ProfileFirefox)
+
+	assert.Equal(t, StatsHost, headers["Host"])
+	assert.Equal(t, "https://stats.nba.com/", headers["Referer"])
+	assert.Equal(t, ProfileFirefox.Headers["User-Agent"], headers["User-Agent"])
+	assert.NotContains(t, headers, "Sec-Ch-Ua")
+}
+
+func TestRegisterHeaderProfile(t *testing.T) {
+	assert.ErrorIs(t, RegisterHeaderProfile(HeaderProfile{Name: "empty"}), ErrInvalidHeaderProfile)
+
+	custom := HeaderProfile{Name: "test-custom", Headers: map[string]string{"User-Agent": "custom/1.0"}}
+	require.NoError(t, RegisterHeaderProfile(custom))
+
+	profile, ok := LookupHeaderProfile("test-custom")
+	require.True(t, ok)
+	assert.Equal(t, custom, profile)
+	assert.Contains(t, HeaderProfiles(), "test-custom")
+}
+
+func TestHeaderRotator(t *testing.T) {
+	profiles := []HeaderProfile{ProfileChrome, ProfileFirefox, ProfileSafari}
+
+	t.Run("round robin", func(t *testing.T) {
+		rotator := NewHeaderRotator(RotateRoundRobin, profiles...)
+		var names []string
+		for i := 0; i < 4; i++ {
+			names = append(names, rotator.Profile().Name)
+		}
+		assert.Equal(t, []string{"chrome", "firefox", "safari", "chrome"}, names)
+	})
+
+	t.Run("per session", func(t *testing.T) {
+		rotator := NewHeaderRotator(RotatePerSession, profiles...)
+		rotator.intn = func(n int) int { return 2 }
+
+		first := rotator.Profile()
+		assert.Equal(t, first, rotator.Profile())
+
+		rotator.Rotate()
+		assert.Equal(t, "safari", rotator.Profile().Name)
+	})
+
+	t.Run("random", func(t *testing.T) {
+		rotator := NewHeaderRotator(RotateRandom, profiles...)
+		picks := []int{1, 0}
+		rotator.intn = func(n int) int {
+			pick := picks[0]
+			picks = picks[1:]
+			return pick
+		}
+
+		assert.Equal(t, "firefox", rotator.Profile().Name)
+		assert.Equal(t, "chrome", rotator.Profile().Name)
+	})
+}
+
+func TestHTTPClient_HeaderRotator(t *testing.T) {
+	var received []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Clone())
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	rotator := NewHeaderRotator(RotateRoundRobin, ProfileChrome, ProfileFirefox)
+	client := NewHTTPClient(server.URL+"/%s", StatsHeaders(ProfileChrome), nil, WithHeaderRotator(rotator))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.SendRequest(context.Background(), "teamdetails", nil)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, received, 2)
+	assert.Equal(t, ProfileChrome.Headers["User-Agent"], received[0].Get("User-Agent"))
+	assert.NotEmpty(t, received[0].Get("Sec-Ch-Ua"))
+	assert.Equal(t, ProfileFirefox.Headers["User-Agent"], received[1].Get("User-Agent"))
+	assert.Empty(t, received[1].Get("Sec-Ch-Ua"), "client hints of the base headers are dropped")
+	assert.Equal(t, "https://stats.nba.com/", received[1].Get("Referer"))
+}
+
+func TestDefaultHeaders(t *testing.T) {
+	stats := DefaultStatsHeaders()
+	assert.Equal(t, "en-US,en;q=0.5", stats["Accept-Language"])
+	assert.Equal(t, ProfileChrome.Headers["User-Agent"], stats["User-Agent"])
+	assert.NotContains(t, stats, "Sec-Ch-Ua-Platform")
+
+	live := DefaultLiveHeaders()
+	assert.Equal(t, "en-US,en;q=0.9", live["Accept-Language"])
+	assert.Contains(t, live["User-Agent"], "Chrome/87.0.4280.88")
+	assert.NotContains(t, live, "Sec-Fetch-Dest")
+}
+
+func TestHTTPClient_HeaderRotatorKeepsUserAgent(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", DefaultStatsHeaders(), nil,
+		WithHeaderProfile(ProfileFirefox), WithUserAgent("custom/1.0"))
+
+	_, err := client.SendRequest(context.Background(), "teamdetails", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "custom/1.0", received.Get("User-Agent"))
+	assert.Equal(t, ProfileFirefox.Headers["Accept-Language"], received.Get("Accept-Language"))
+}
@@ -2,9 +2,10 @@
 package client
 
 import (
-	"compress/gzip"
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -21,43 +22,237 @@
 
 // HTTPClient provides HTTP functionality for making NBA API requests.
 type HTTPClient struct {
-	baseURL    string
-	headers    map[string]string
-	httpClient *http.Client
-	logger     *slog.Logger
+	baseURL         string
+	headers         map[string]string
+	httpClient      *http.Client
+	logger          *slog.Logger
+	retryPolicy     RetryPolicy
+	rateLimiter     *RateLimiter
+	cache           Cache
+	cachePolicy     CachePolicy
+	validators      *validatorStore
+	interceptors    []Interceptor
+	retainBody      bool
+	instrumentation Instrumentation
+	flights         *flightGroup
+	breaker         *CircuitBreaker
+	rotator         *HeaderRotator
+	userAgent       string
+	timeoutPolicy   TimeoutPolicy
 }
 
 // Response represents an NBA API response.
 type Response struct {
-	raw        string
-	statusCode int
-	url        string
-	logger     *slog.Logger
+	raw         string
+	statusCode  int
+	url         string
+	header      http.Header
+	fromCache   bool
+	notModified bool
+	shared      bool
+	size        int64
+	logger      *slog.Logger
+}
+
+// decodeTarget describes how Get consumes a successful response body.
+type decodeTarget struct {
+	// v is the value the JSON body is decoded into.
+	v any
+	// keep buffers the body into the Response while decoding.
+	keep bool
 }
 
 // NewHTTPClient creates a new HTTP client with the specified base URL and headers.
-func NewHTTPClient(baseURL string, headers map[string]string, logger *slog.Logger) *HTTPClient {
+// Options override defaults such as the http.Client, transport or base URL.
+func NewHTTPClient(baseURL string, headers map[string]string, logger *slog.Logger, opts ...Option) *HTTPClient {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
-	return &HTTPClient{
-		baseURL: baseURL,
-		headers: headers,
-		httpClient: &http.Client{
-			Timeout: defaultTimeout,
-		},
-		logger: logger,
+	c := &HTTPClient{
+		baseURL:         baseURL,
+		headers:         headers,
+		httpClient:      &http.Client{},
+		logger:          logger,
+		retryPolicy:     DefaultRetryPolicy(),
+		cachePolicy:     DefaultCachePolicy(),
+		timeoutPolicy:   DefaultTimeoutPolicy(),
+		instrumentation: NopInstrumentation{},
+	}
+
+	if len(opts) > 0 {
+		var o options
+		for _, opt := range opts {
+			opt(&o)
+		}
+		o.apply(c)
 	}
+
+	return c
 }
 
-// SetTimeout sets the HTTP client timeout.
+// SetTimeout sets the timeout of the underlying http.Client, a hard limit
+// on every attempt in addition to the TimeoutPolicy. Prefer SetTimeoutPolicy,
+// whose errors report the phase that stalled.
 func (c *HTTPClient) SetTimeout(timeout time.Duration) {
 	c.httpClient.Timeout = timeout
 }
 
+// SetHTTPClient replaces the underlying http.Client. The same http.Client,
+// and therefore its transport and connection pool, may be shared by several
+// HTTP clients.
+func (c *HTTPClient) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// HTTPClient returns the underlying http.Client.
+func (c *HTTPClient) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// SetRetryPolicy sets the policy used to retry failed requests.
+func (c *HTTPClient) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// RetryPolicy returns the policy used to retry failed requests.
+func (c *HTTPClient) RetryPolicy() RetryPolicy {
+	return c.retryPolicy
+}
+
+// SetRateLimiter sets the rate limiter consulted before every request attempt.
+// The same limiter may be shared by several clients; nil disables limiting.
+func (c *HTTPClient) SetRateLimiter(limiter *RateLimiter) {
+	c.rateLimiter = limiter
+}
+
+// RateLimiter returns the rate limiter used by the client, if any.
+func (c *HTTPClient) RateLimiter() *RateLimiter {
+	return c.rateLimiter
+}
+
+// SetCache sets the cache consulted before sending requests; nil disables caching.
+func (c *HTTPClient) SetCache(cache Cache) {
+	c.cache = cache
+}
+
+// SetCachePolicy sets the policy deciding how long each endpoint is cached.
+func (c *HTTPClient) SetCachePolicy(policy CachePolicy) {
+	c.cachePolicy = policy
+}
+
+// SetConditionalRequests enables or disables conditional GETs. When enabled,
+// the client remembers the ETag and Last-Modified validators of each URL and
+// sends them with later requests; unchanged resources then produce a
+// Response for which NotModified reports true and whose body is empty.
+func (c *HTTPClient) SetConditionalRequests(enabled bool) {
+	if !enabled {
+		c.validators = nil
+		return
+	}
+	if c.validators == nil {
+		c.validators = newValidatorStore()
+	}
+}
+
+// SetRetainRawBody controls whether Get keeps the raw body of decoded
+// responses. Retained bodies are available from Response.GetRaw and are
+// previewed in invalid JSON logs, at the cost of buffering every response.
+func (c *HTTPClient) SetRetainRawBody(enabled bool) {
+	c.retainBody = enabled
+}
+
+// ForgetValidators drops the validators remembered for the request, so the
+// next call to SendRequest downloads the full response again.
+func (c *HTTPClient) ForgetValidators(endpoint string, params map[string]string) {
+	if c.validators != nil {
+		c.validators.forget(c.buildURL(endpoint, params))
+	}
+}
+
 // SendRequest sends an HTTP GET request to the specified endpoint with parameters.
+// The whole body is read into the Response; use Get to decode large
+// responses without buffering them.
 func (c *HTTPClient) SendRequest(ctx context.Context, endpoint string, params map[string]string) (*Response, error) {
+	return c.send(ctx, endpoint, params, nil)
+}
+
+// Get sends an HTTP GET request to the specified endpoint with parameters and
+// decodes the JSON response body into v in a single pass, streaming from the
+// decompressed body. The body is only buffered when it is needed for the
+// cache, AfterResponse interceptors or SetRetainRawBody. Invalid bodies
+// produce an error matching ErrInvalidJSON. When the server answers a
+// conditional request with 304 Not Modified, v is left untouched and
+// Response.NotModified reports true.
+func (c *HTTPClient) Get(ctx context.Context, endpoint string, params map[string]string, v any) (*Response, error) {
+	return c.send(ctx, endpoint, params, v)
+}
+
+// send implements SendRequest and Get. A nil v reads the body into the Response.
+func (c *HTTPClient) send(ctx context.Context, endpoint string, params map[string]string, v any) (*Response, error) {
+	info := &RequestInfo{
+		Endpoint: endpoint,
+		Params:   params,
+		URL:      c.buildURL(endpoint, params),
+	}
+	if parsed, err := url.Parse(info.URL); err == nil {
+		info.Host = parsed.Hostname()
+	}
+
+	start := time.Now()
+	ctx = c.instrumentation.StartRequest(ctx, info)
+	response, err := c.execute(ctx, info, v)
+	c.instrumentation.EndRequest(ctx, newRequestResult(info, response, err, time.Since(start)))
+	return response, err
+}
+
+// execute serves the request from the cache or sends it with retries.
+func (c *HTTPClient) execute(ctx context.Context, info *RequestInfo, v any) (*Response, error) {
+	endpoint, params := info.Endpoint, info.Params
+	cacheTTL := c.cacheTTL(endpoint, params)
+	if cacheTTL != 0 {
+		if response := c.cachedResponse(ctx, info.URL); response != nil {
+			if v == nil {
+				return response, nil
+			}
+			if err := c.decodeJSON(ctx, strings.NewReader(response.raw), v, response); err == nil {
+				return response, nil
+			}
+			// A corrupt entry is replaced by a fresh response.
+		}
+	}
+
+	if c.flights != nil {
+		return c.executeShared(ctx, info, v, cacheTTL)
+	}
+
+	var target *decodeTarget
+	if v != nil {
+		target = &decodeTarget{
+			v:    v,
+			keep: c.retainBody || cacheTTL != 0 || c.hasAfterResponse(),
+		}
+	}
+	return c.fetch(ctx, info, target, cacheTTL)
+}
+
+// fetch sends the request with retries and caches the response.
+func (c *HTTPClient) fetch(ctx context.Context, info *RequestInfo, target *decodeTarget, cacheTTL time.Duration) (*Response, error) {
+	start := time.Now()
+	response, err := c.sendWithRetry(ctx, info, target)
+	if err != nil {
+		c.onError(ctx, info, err, time.Since(start))
+		return nil, err
+	}
+
+	if cacheTTL != 0 && !response.notModified {
+		c.storeResponse(ctx, response, cacheTTL)
+	}
+	return response, nil
+}
+
+// buildURL builds the canonical request URL with sorted query parameters.
+func (c *HTTPClient) buildURL(endpoint string, params map[string]string) string {
 	// Build URL with parameters
 	fullURL := c.baseURL
 	if endpoint != "" {
@@ -71,10 +266,192 @@ func (c *HTTPClient) SendRequest(ctx context.Context, endpoint string, params ma
 	if queryParams != "" {
 		fullURL = fullURL + "?" + queryParams
 	}
+	return fullURL
+}
+
+// sendWithRetry sends the request, retrying according to the retry policy.
+func (c *HTTPClient) sendWithRetry(ctx context.Context, info *RequestInfo, target *decodeTarget) (*Response, error) {
+	fullURL, endpoint, params := info.URL, info.Endpoint, info.Params
+	policy := c.retryPolicy
+	maxAttempts := policy.attempts()
 
-	c.logger.DebugContext(ctx, "Sending NBA API request",
+	for attempt := 1; ; attempt++ {
+		info.Attempt = attempt
+		c.logger.DebugContext(ctx, "Sending NBA API request",
+			slog.String("url", fullURL),
+			slog.Any("params", params),
+			slog.Int("attempt", attempt))
+
+		probe, err := c.allowRequest(ctx, info)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.waitRateLimit(ctx, fullURL); err != nil {
+			c.releaseProbe(info, probe)
+			return nil, err
+		}
+
+		response, err := c.doRequest(ctx, info, target)
+		c.recordOutcome(ctx, info, probe, response, err)
+
+		var retryAfter time.Duration
+		var interceptorErr *interceptorError
+		switch {
+		case errors.As(err, &interceptorErr):
+			return nil, interceptorErr.err
+		case err != nil:
+			if attempt >= maxAttempts || ctx.Err() != nil || !policy.shouldRetryError(err) {
+				return nil, err
+			}
+		case policy.shouldRetryStatus(response.statusCode):
+			if attempt >= maxAttempts {
+				c.logger.WarnContext(ctx, "NBA API request attempts exhausted",
+					slog.String("url", fullURL),
+					slog.Int("status_code", response.statusCode),
+					slog.Int("attempts", attempt))
+				return nil, c.apiError(ctx, response, endpoint, params)
+			}
+			retryAfter = parseRetryAfter(response.header.Get("Retry-After"), time.Now())
+		case !isSuccessStatus(response.statusCode) && !response.notModified:
+			return nil, c.apiError(ctx, response, endpoint, params)
+		default:
+			return response, nil
+		}
+
+		delay := policy.backoff(attempt, retryAfter)
+		attrs := []any{
+			slog.String("url", fullURL),
+			slog.Int("attempt", attempt),
+			slog.Int("max_attempts", maxAttempts),
+			slog.Duration("delay", delay),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		} else {
+			attrs = append(attrs, slog.Int("status_code", response.statusCode))
+		}
+		c.logger.WarnContext(ctx, "Retrying NBA API request", attrs...)
+
+		if err := sleepContext(ctx, delay); err != nil {
+			c.logger.ErrorContext(ctx, "Request cancelled while waiting to retry",
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+	}
+}
+
+// cacheTTL returns the cache TTL for the request, or zero if it is not cached.
+func (c *HTTPClient) cacheTTL(endpoint string, params map[string]string) time.Duration {
+	if c.cache == nil {
+		return 0
+	}
+	return c.cachePolicy.TTL(endpoint, params)
+}
+
+// cachedResponse returns the cached response for fullURL, or nil on a miss.
+func (c *HTTPClient) cachedResponse(ctx context.Context, fullURL string) *Response {
+	entry, err := c.cache.Get(fullURL)
+	if err != nil {
+		if !errors.Is(err, ErrCacheMiss) {
+			c.logger.WarnContext(ctx, "Failed to read cached response",
+				slog.String("url", fullURL),
+				slog.String("error", err.Error()))
+		}
+		c.logger.DebugContext(ctx, "Cache miss",
+			slog.String("url", fullURL))
+		return nil
+	}
+
+	c.logger.DebugContext(ctx, "Cache hit",
 		slog.String("url", fullURL),
-		slog.Any("params", params))
+		slog.Time("stored_at", entry.StoredAt))
+
+	return &Response{
+		raw:        string(entry.Body),
+		statusCode: entry.StatusCode,
+		url:        fullURL,
+		header:     entry.Header,
+		fromCache:  true,
+		logger:     c.logger,
+	}
+}
+
+// storeResponse stores a successful response in the cache.
+func (c *HTTPClient) storeResponse(ctx context.Context, response *Response, ttl time.Duration) {
+	now := time.Now()
+	entry := &CacheEntry{
+		Body:       []byte(response.raw),
+		StatusCode: response.statusCode,
+		Header:     response.header,
+		StoredAt:   now,
+	}
+	if ttl != NoExpiration {
+		entry.ExpiresAt = now.Add(ttl)
+	}
+
+	if err := c.cache.Set(response.url, entry); err != nil {
+		c.logger.WarnContext(ctx, "Failed to cache response",
+			slog.String("url", response.url),
+			slog.String("error", err.Error()))
+	}
+}
+
+// apiError logs and returns an APIError for a non-2xx response.
+func (c *HTTPClient) apiError(ctx context.Context, resp *Response, endpoint string, params map[string]string) error {
+	apiErr := newAPIError(resp, endpoint, params)
+	c.logger.ErrorContext(ctx, "NBA API returned an error status",
+		slog.String("url", resp.url),
+		slog.Int("status_code", resp.statusCode),
+		slog.String("message", apiErr.Message))
+	return apiErr
+}
+
+// waitRateLimit blocks until the rate limiter allows a request to fullURL.
+func (c *HTTPClient) waitRateLimit(ctx context.Context, fullURL string) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+
+	parsed, err := url.Parse(fullURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse request URL: %w", err)
+	}
+
+	start := time.Now()
+	if err := c.rateLimiter.Wait(ctx, parsed.Hostname()); err != nil {
+		c.logger.ErrorContext(ctx, "Failed to wait for rate limiter",
+			slog.String("host", parsed.Hostname()),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("failed to wait for rate limiter: %w", err)
+	}
+	if waited := time.Since(start); waited > time.Millisecond {
+		c.logger.DebugContext(ctx, "Request delayed by rate limiter",
+			slog.String("host", parsed.Hostname()),
+			slog.Duration("delay", waited))
+	}
+	return nil
+}
+
+// doRequest performs a single HTTP GET attempt for the request, bounded by
+// the timeout of its endpoint. Successful responses are decoded into target
+// when it is not nil.
+func (c *HTTPClient) doRequest(ctx context.Context, info *RequestInfo, target *decodeTarget) (*Response, error) {
+	deadline, cancel := c.newAttemptDeadline(ctx, info.Endpoint)
+	defer cancel()
+
+	response, err := c.sendAttempt(deadline.ctx, deadline, info, target)
+	var interceptorErr *interceptorError
+	if err != nil && !errors.As(err, &interceptorErr) {
+		err = deadline.wrap(err)
+		c.logTimeout(ctx, info, err)
+	}
+	return response, err
+}
+
+// sendAttempt sends the request with the attempt context ctx.
+func (c *HTTPClient) sendAttempt(ctx context.Context, deadline *attemptDeadline, info *RequestInfo, target *decodeTarget) (*Response, error) {
+	fullURL := info.URL
 
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
@@ -88,14 +465,28 @@ func (c *HTTPClient) SendRequest(ctx context.Context, endpoint string, params ma
 	for key, value := range c.headers {
 		req.Header.Set(key, value)
 	}
+	c.applyHeaderProfile(req)
+	if c.validators != nil {
+		c.validators.apply(fullURL, req)
+	}
+
+	if err := c.beforeRequest(ctx, info, req); err != nil {
+		c.logger.ErrorContext(ctx, "Request interceptor failed",
+			slog.String("error", err.Error()))
+		return nil, &interceptorError{err: err}
+	}
 
 	// Send request
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		c.logger.ErrorContext(ctx, "Failed to send request",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
+	deadline.readingBody()
+	body := &countingBody{ReadCloser: resp.Body}
+	resp.Body = body
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
 			c.logger.WarnContext(ctx, "Failed to close response body",
@@ -103,24 +494,63 @@ func (c *HTTPClient) SendRequest(ctx context.Context, endpoint string, params ma
 		}
 	}()
 
-	// Read response body (handle gzip encoding)
-	var reader io.Reader = resp.Body
-	if strings.Contains(resp.Header.Get("Content-Encoding"), "gzip") {
-		gzipReader, err := gzip.NewReader(resp.Body)
+	var response *Response
+	if resp.StatusCode == http.StatusNotModified {
+		c.logger.DebugContext(ctx, "NBA API resource not modified",
+			slog.String("url", fullURL))
+		response = &Response{
+			statusCode:  resp.StatusCode,
+			url:         fullURL,
+			header:      resp.Header,
+			notModified: true,
+			logger:      c.logger,
+		}
+	} else if target != nil && isSuccessStatus(resp.StatusCode) {
+		response, err = c.streamResponse(ctx, resp, fullURL, target)
 		if err != nil {
-			c.logger.ErrorContext(ctx, "Failed to create gzip reader",
-				slog.String("error", err.Error()))
-			return nil, fmt.Errorf("failed to create gzip reader: %w", err)
+			return nil, err
 		}
-		defer func() {
-			if closeErr := gzipReader.Close(); closeErr != nil {
-				c.logger.WarnContext(ctx, "Failed to close gzip reader",
-					slog.String("error", closeErr.Error()))
-			}
-		}()
-		reader = gzipReader
+	} else {
+		response, err = c.readResponse(ctx, resp, fullURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response.size = body.n
+
+	if err := c.afterResponse(ctx, &ResponseInfo{
+		Request:    info,
+		StatusCode: response.statusCode,
+		Header:     response.header,
+		Body:       []byte(response.raw),
+		Latency:    time.Since(start),
+	}); err != nil {
+		c.logger.ErrorContext(ctx, "Response interceptor failed",
+			slog.String("error", err.Error()))
+		return nil, &interceptorError{err: err}
 	}
 
+	return response, nil
+}
+
+// readResponse reads and decodes the body of resp.
+func (c *HTTPClient) readResponse(ctx context.Context, resp *http.Response, fullURL string) (*Response, error) {
+	// Read response body, undoing any content encoding
+	reader, err := decodeBody(resp.Body, resp.Header.Get("Content-Encoding"))
+	if err != nil {
+		c.logger.ErrorContext(ctx, "Failed to decode response body",
+			slog.String("content_encoding", resp.Header.Get("Content-Encoding")),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			c.logger.WarnContext(ctx, "Failed to close response decoder",
+				slog.String("error", closeErr.Error()))
+		}
+	}()
+
 	body, err := io.ReadAll(reader)
 	if err != nil {
 		c.logger.ErrorContext(ctx, "Failed to read response body",
@@ -132,9 +562,14 @@ func (c *HTTPClient) SendRequest(ctx context.Context, endpoint string, params ma
 		raw:        string(body),
 		statusCode: resp.StatusCode,
 		url:        fullURL,
+		header:     resp.Header,
 		logger:     c.logger,
 	}
 
+	if c.validators != nil && isSuccessStatus(resp.StatusCode) {
+		c.validators.update(fullURL, resp.Header)
+	}
+
 	c.logger.DebugContext(ctx, "Received NBA API response",
 		slog.Int("status_code", resp.StatusCode),
 		slog.Int("body_length", len(body)))
@@ -142,6 +577,90 @@ func (c *HTTPClient) SendRequest(ctx context.Context, endpoint string, params ma
 	return response, nil
 }
 
+// streamResponse decodes the JSON body of a successful resp into target
+// without buffering it, unless the target asks to keep the body.
+func (c *HTTPClient) streamResponse(ctx context.Context, resp *http.Response, fullURL string, target *decodeTarget) (*Response, error) {
+	reader, err := decodeBody(resp.Body, resp.Header.Get("Content-Encoding"))
+	if err != nil {
+		c.logger.ErrorContext(ctx, "Failed to decode response body",
+			slog.String("content_encoding", resp.Header.Get("Content-Encoding")),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			c.logger.WarnContext(ctx, "Failed to close response decoder",
+				slog.String("error", closeErr.Error()))
+		}
+	}()
+
+	response := &Response{
+		statusCode: resp.StatusCode,
+		url:        fullURL,
+		header:     resp.Header,
+		logger:     c.logger,
+	}
+
+	var src io.Reader = reader
+	var body *bytes.Buffer
+	if target.keep {
+		body = &bytes.Buffer{}
+		src = io.TeeReader(reader, body)
+	}
+
+	decodeErr := c.decodeJSON(ctx, src, target.v, response)
+
+	// Drain the rest of the body so the connection can be reused and a
+	// kept body is complete.
+	if _, err := io.Copy(io.Discard, src); err != nil && decodeErr == nil {
+		c.logger.ErrorContext(ctx, "Failed to read response body",
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if body != nil {
+		response.raw = body.String()
+	}
+	if decodeErr != nil {
+		if body != nil {
+			c.logger.ErrorContext(ctx, "Invalid JSON response body",
+				slog.String("url", fullURL),
+				slog.Int("body_length", body.Len()),
+				slog.String("body_preview", truncate(response.raw, bodyPreviewLength)))
+		}
+		return nil, decodeErr
+	}
+
+	if c.validators != nil {
+		c.validators.update(fullURL, resp.Header)
+	}
+
+	c.logger.DebugContext(ctx, "Decoded NBA API response",
+		slog.Int("status_code", resp.StatusCode),
+		slog.Bool("body_retained", body != nil))
+
+	return response, nil
+}
+
+// decodeJSON decodes a single JSON value from r into v. Like json.Unmarshal
+// it rejects anything but whitespace after the value.
+func (c *HTTPClient) decodeJSON(ctx context.Context, r io.Reader, v any, response *Response) error {
+	decoder := json.NewDecoder(r)
+	err := decoder.Decode(v)
+	if err == nil {
+		if _, tokenErr := decoder.Token(); tokenErr != io.EOF {
+			err = errors.New("unexpected data after top-level value")
+		}
+	}
+	if err != nil {
+		c.logger.ErrorContext(ctx, "Invalid JSON response",
+			slog.Int("status_code", response.statusCode),
+			slog.String("url", response.url),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	return nil
+}
+
 // sortParameters sorts parameters by key.
 func (c *HTTPClient) sortParameters(params map[string]string) []struct {
 	key   string
@@ -180,7 +699,8 @@ func (c *HTTPClient) buildQueryParams(params []struct {
 	return values.Encode()
 }
 
-// GetRaw returns the raw response string.
+// GetRaw returns the raw response string. Responses decoded by Get only
+// carry their body when it was retained.
 func (r *Response) GetRaw() string {
 	return r.raw
 }
@@ -195,6 +715,23 @@ func (r *Response) GetURL() string {
 	return r.url
 }
 
+// FromCache reports whether the response was served from the cache.
+func (r *Response) FromCache() bool {
+	return r.fromCache
+}
+
+// Shared reports whether the response was produced by an identical
+// concurrent request made by another caller. See SetDeduplication.
+func (r *Response) Shared() bool {
+	return r.shared
+}
+
+// NotModified reports whether the server answered a conditional request with
+// 304 Not Modified. Such responses have an empty body.
+func (r *Response) NotModified() bool {
+	return r.notModified
+}
+
 // GetJSON unmarshals the response into the provided interface.
 func (r *Response) GetJSON(v interface{}) error {
 	if err := json.Unmarshal([]byte(r.raw), v); err != nil {
@@ -0,0 +1,363 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrorClass is a coarse, low-cardinality classification of request errors
+// suitable for use as a metric label.
+type ErrorClass string
+
+const (
+	// ErrorClassNone is reported for successful requests.
+	ErrorClassNone ErrorClass = ""
+	// ErrorClassCanceled is reported when the context was cancelled.
+	ErrorClassCanceled ErrorClass = "canceled"
+	// ErrorClassTimeout is reported for deadlines and network timeouts.
+	ErrorClassTimeout ErrorClass = "timeout"
+	// ErrorClassRateLimited is reported for HTTP 429 responses.
+	ErrorClassRateLimited ErrorClass = "rate_limited"
+	// ErrorClassNotFound is reported for HTTP 404 responses.
+	ErrorClassNotFound ErrorClass = "not_found"
+	// ErrorClassBadParameter is reported for parameter validation errors.
+	ErrorClassBadParameter ErrorClass = "bad_parameter"
+	// ErrorClassServer is reported for HTTP 5xx responses.
+	ErrorClassServer ErrorClass = "server_error"
+	// ErrorClassHTTP is reported for other non-2xx responses.
+	ErrorClassHTTP ErrorClass = "http_error"
+	// ErrorClassInvalidJSON is reported for undecodable response bodies.
+	ErrorClassInvalidJSON ErrorClass = "invalid_json"
+	// ErrorClassCircuitOpen is reported when the circuit breaker failed the
+	// request fast.
+	ErrorClassCircuitOpen ErrorClass = "circuit_open"
+	// ErrorClassNetwork is reported for connection and transport failures.
+	ErrorClassNetwork ErrorClass = "network"
+	// ErrorClassOther is reported for any other error.
+	ErrorClassOther ErrorClass = "other"
+)
+
+// ClassifyError returns the ErrorClass of an error returned by SendRequest or Get.
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassNone
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case errors.Is(apiErr, ErrRateLimited):
+			return ErrorClassRateLimited
+		case errors.Is(apiErr, ErrNotFound):
+			return ErrorClassNotFound
+		case errors.Is(apiErr, ErrBadParameter):
+			return ErrorClassBadParameter
+		case apiErr.StatusCode >= http.StatusInternalServerError:
+			return ErrorClassServer
+		}
+		return ErrorClassHTTP
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.Is(err, ErrInvalidJSON):
+		return ErrorClassInvalidJSON
+	case errors.Is(err, ErrCircuitOpen):
+		return ErrorClassCircuitOpen
+	case errors.As(err, &netErr):
+		if netErr.Timeout() {
+			return ErrorClassTimeout
+		}
+		return ErrorClassNetwork
+	case IsRetryableError(err):
+		return ErrorClassNetwork
+	}
+	return ErrorClassOther
+}
+
+// RequestResult describes a completed SendRequest or Get call.
+type RequestResult struct {
+	// Request is the request that was sent.
+	Request *RequestInfo
+	// StatusCode is the final HTTP status, or zero if no response was received.
+	StatusCode int
+	// Latency is the duration of the whole call including retries.
+	Latency time.Duration
+	// ResponseSize is the number of body bytes received, as sent on the wire.
+	ResponseSize int64
+	// Attempts is the number of HTTP attempts; zero for cache hits.
+	Attempts int
+	// CacheHit reports whether the response was served from the cache.
+	CacheHit bool
+	// NotModified reports whether the server answered 304 Not Modified.
+	NotModified bool
+	// Err is the error returned to the caller, if any.
+	Err error
+	// ErrorClass classifies Err.
+	ErrorClass ErrorClass
+}
+
+// Retries returns the number of attempts after the first one.
+func (r *RequestResult) Retries() int {
+	if r.Attempts <= 1 {
+		return 0
+	}
+	return r.Attempts - 1
+}
+
+// Instrumentation receives metrics and tracing events for every call to
+// SendRequest or Get. Implementations must be safe for concurrent use.
+type Instrumentation interface {
+	// StartRequest is called when a call begins. The returned context is
+	// used for the rest of the call, so a tracing bridge can start a span
+	// and store it in the context.
+	StartRequest(ctx context.Context, info *RequestInfo) context.Context
+	// EndRequest is called exactly once when the call completes, with the
+	// context returned by StartRequest.
+	EndRequest(ctx context.Context, result *RequestResult)
+}
+
+// NopInstrumentation is an Instrumentation that does nothing. It is the default.
+type NopInstrumentation struct{}
+
+// StartRequest implements Instrumentation.
+func (NopInstrumentation) StartRequest(ctx context.Context, _ *RequestInfo) context.Context {
+	return ctx
+}
+
+// EndRequest implements Instrumentation.
+func (NopInstrumentation) EndRequest(context.Context, *RequestResult) {}
+
+// MultiInstrumentation returns an Instrumentation that forwards events to
+// each of instrumentations in order.
+func MultiInstrumentation(instrumentations ...Instrumentation) Instrumentation {
+	return multiInstrumentation(instrumentations)
+}
+
+type multiInstrumentation []Instrumentation
+
+// StartRequest implements Instrumentation.
+func (m multiInstrumentation) StartRequest(ctx context.Context, info *RequestInfo) context.Context {
+	for _, instrumentation := range m {
+		ctx = instrumentation.StartRequest(ctx, info)
+	}
+	return ctx
+}
+
+// EndRequest implements Instrumentation. Events are delivered in reverse
+// order so nested spans end before their parents.
+func (m multiInstrumentation) EndRequest(ctx context.Context, result *RequestResult) {
+	for i := len(m) - 1; i >= 0; i-- {
+		m[i].EndRequest(ctx, result)
+	}
+}
+
+// WithInstrumentation sets the instrumentation of the HTTP client.
+func WithInstrumentation(instrumentation Instrumentation) Option {
+	return func(o *options) {
+		o.instrumentation = instrumentation
+	}
+}
+
+// SetInstrumentation sets the instrumentation notified of every request;
+// nil restores the no-op default.
+func (c *HTTPClient) SetInstrumentation(instrumentation Instrumentation) {
+	if instrumentation == nil {
+		instrumentation = NopInstrumentation{}
+	}
+	c.instrumentation = instrumentation
+}
+
+// newRequestResult builds the result reported to the instrumentation.
+func newRequestResult(info *RequestInfo, response *Response, err error, latency time.Duration) *RequestResult {
+	result := &RequestResult{
+		Request:    info,
+		Latency:    latency,
+		Attempts:   info.Attempt,
+		Err:        err,
+		ErrorClass: ClassifyError(err),
+	}
+	if response != nil {
+		result.StatusCode = response.statusCode
+		result.ResponseSize = response.size
+		result.CacheHit = response.fromCache
+		result.NotModified = response.notModified
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		result.StatusCode = apiErr.StatusCode
+	}
+	return result
+}
+
+// countingBody counts the bytes read from a response body.
+type countingBody struct {
+	io.ReadCloser
+	n int64
+}
+
+// Read implements io.Reader.
+func (c *countingBody) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// LatencyBuckets are the upper bounds of the latency histogram kept by
+// ExpvarInstrumentation.
+var LatencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+}
+
+// ExpvarInstrumentation publishes request metrics through the expvar
+// package, labelled by "host/endpoint". The published map contains:
+//
+//   - requests: number of calls
+//   - errors: number of failed calls per ErrorClass
+//   - cache_hits: number of calls served from the cache
+//   - retries: number of retried attempts
+//   - response_bytes: body bytes received
+//   - latency: cumulative latency histogram in milliseconds
+type ExpvarInstrumentation struct {
+	NopInstrumentation
+
+	requests      *expvar.Map
+	errors        *expvar.Map
+	cacheHits     *expvar.Map
+	retries       *expvar.Map
+	responseBytes *expvar.Map
+	latency       *expvar.Map
+
+	mu sync.Mutex
+}
+
+// NewExpvarInstrumentation creates an ExpvarInstrumentation and publishes
+// its metrics under name. Like expvar.Publish it panics if name is already
+// in use, so it should be called once per process and name.
+func NewExpvarInstrumentation(name string) *ExpvarInstrumentation {
+	e := &ExpvarInstrumentation{
+		requests:      new(expvar.Map),
+		errors:        new(expvar.Map),
+		cacheHits:     new(expvar.Map),
+		retries:       new(expvar.Map),
+		responseBytes: new(expvar.Map),
+		latency:       new(expvar.Map),
+	}
+
+	root := expvar.NewMap(name)
+	root.Set("requests", e.requests)
+	root.Set("errors", e.errors)
+	root.Set("cache_hits", e.cacheHits)
+	root.Set("retries", e.retries)
+	root.Set("response_bytes", e.responseBytes)
+	root.Set("latency", e.latency)
+	return e
+}
+
+// EndRequest implements Instrumentation.
+func (e *ExpvarInstrumentation) EndRequest(_ context.Context, result *RequestResult) {
+	key := metricKey(result.Request)
+
+	e.requests.Add(key, 1)
+	if result.CacheHit {
+		e.cacheHits.Add(key, 1)
+	}
+	if retries := result.Retries(); retries > 0 {
+		e.retries.Add(key, int64(retries))
+	}
+	if result.ResponseSize > 0 {
+		e.responseBytes.Add(key, result.ResponseSize)
+	}
+	if result.ErrorClass != ErrorClassNone {
+		e.mu.Lock()
+		classes, ok := e.errors.Get(key).(*expvar.Map)
+		if !ok {
+			classes = new(expvar.Map)
+			e.errors.Set(key, classes)
+		}
+		e.mu.Unlock()
+		classes.Add(string(result.ErrorClass), 1)
+	}
+
+	e.mu.Lock()
+	histogram, ok := e.latency.Get(key).(*latencyHistogram)
+	if !ok {
+		histogram = newLatencyHistogram(LatencyBuckets)
+		e.latency.Set(key, histogram)
+	}
+	e.mu.Unlock()
+	histogram.observe(result.Latency)
+}
+
+// metricKey returns the "host/endpoint" label of a request.
+func metricKey(info *RequestInfo) string {
+	return info.Host + "/" + info.Endpoint
+}
+
+// latencyHistogram is a cumulative histogram published as an expvar.Var.
+type latencyHistogram struct {
+	mu     sync.Mutex
+	bounds []time.Duration
+	counts []int64
+	count  int64
+	sumMs  float64
+}
+
+// newLatencyHistogram creates a histogram with the given upper bounds.
+func newLatencyHistogram(bounds []time.Duration) *latencyHistogram {
+	return &latencyHistogram{
+		bounds: bounds,
+		counts: make([]int64, len(bounds)),
+	}
+}
+
+// observe records a latency.
+func (h *latencyHistogram) observe(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.count++
+	h.sumMs += float64(latency) / float64(time.Millisecond)
+	for i, bound := range h.bounds {
+		if latency <= bound {
+			h.counts[i]++
+		}
+	}
+}
+
+// String implements expvar.Var. Buckets are keyed by their upper bound in
+// milliseconds; "+Inf" equals count.
+func (h *latencyHistogram) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString(`{"buckets": {`)
+	for i, bound := range h.bounds {
+		fmt.Fprintf(&b, `"%d": %d, `, bound.Milliseconds(), h.counts[i])
+	}
+	fmt.Fprintf(&b, `"+Inf": %d}, "count": %d, "sum": %s}`,
+		h.count, h.count, strconv.FormatFloat(h.sumMs, 'f', -1, 64))
+	return b.String()
+}
@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type spanKey struct{}
+
+// recordingInstrumentation stores every event it receives.
+type recordingInstrumentation struct {
+	mu      sync.Mutex
+	started []*RequestInfo
+	results []*RequestResult
+	spans   []any
+}
+
+func (r *recordingInstrumentation) StartRequest(ctx context.Context, info *RequestInfo) context.Context {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, info)
+	return context.WithValue(ctx, spanKey{}, fmt.Sprintf("span-%d", len(r.started)))
+}
+
+func (r *recordingInstrumentation) EndRequest(ctx context.Context, result *RequestResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result)
+	r.spans = append(r.spans, ctx.Value(spanKey{}))
+}
+
+func TestHTTPClient_Instrumentation_Success(t *testing.T) {
+	var calls int32
+	body := `{"resource": "playercareerstats"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	instrumentation := &recordingInstrumentation{}
+	var spanInRequest any
+	client := NewHTTPClient(server.URL+"/%s", nil, nil,
+		WithInstrumentation(instrumentation),
+		WithInterceptors(Interceptor{
+			BeforeRequest: func(ctx context.Context, info *RequestInfo, req *http.Request) error {
+				spanInRequest = ctx.Value(spanKey{})
+				return nil
+			},
+		}))
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	var result map[string]string
+	_, err := client.Get(context.Background(), "playercareerstats", map[string]string{"PlayerID": "2544"}, &result)
+	require.NoError(t, err)
+
+	require.Len(t, instrumentation.started, 1)
+	require.Len(t, instrumentation.results, 1)
+	res := instrumentation.results[0]
+	assert.Equal(t, "playercareerstats", res.Request.Endpoint)
+	assert.Equal(t, "127.0.0.1", res.Request.Host)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 2, res.Attempts)
+	assert.Equal(t, 1, res.Retries())
+	assert.Equal(t, int64(len(body)), res.ResponseSize)
+	assert.False(t, res.CacheHit)
+	assert.NoError(t, res.Err)
+	assert.Equal(t, ErrorClassNone, res.ErrorClass)
+	assert.Positive(t, res.Latency)
+
+	assert.Equal(t, "span-1", spanInRequest, "request context should carry the span")
+	assert.Equal(t, []any{"span-1"}, instrumentation.spans)
+}
+
+func TestHTTPClient_Instrumentation_CacheHit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	instrumentation := &recordingInstrumentation{}
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.SetInstrumentation(instrumentation)
+	client.SetCache(NewLRUCache(10))
+	client.SetCachePolicy(CachePolicy{DefaultTTL: time.Minute})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.SendRequest(context.Background(), "drafthistory", nil)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, instrumentation.results, 2)
+	assert.False(t, instrumentation.results[0].CacheHit)
+	assert.True(t, instrumentation.results[1].CacheHit)
+	assert.Equal(t, 0, instrumentation.results[1].Attempts)
+}
+
+func TestHTTPClient_Instrumentation_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	instrumentation := &recordingInstrumentation{}
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithInstrumentation(instrumentation))
+
+	_, err := client.SendRequest(context.Background(), "teamdetails", nil)
+	require.Error(t, err)
+
+	require.Len(t, instrumentation.results, 1)
+	res := instrumentation.results[0]
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	assert.Equal(t, ErrorClassNotFound, res.ErrorClass)
+	assert.ErrorIs(t, res.Err, ErrNotFound)
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected ErrorClass
+	}{
+		{name: "nil", err: nil, expected: ErrorClassNone},
+		{name: "rate limited", err: &APIError{StatusCode: http.StatusTooManyRequests}, expected: ErrorClassRateLimited},
+		{name: "not found", err: &APIError{StatusCode: http.StatusNotFound}, expected: ErrorClassNotFound},
+		{name: "bad parameter", err: &APIError{StatusCode: http.StatusBadRequest}, expected: ErrorClassBadParameter},
+		{name: "server error", err: &APIError{StatusCode: http.StatusBadGateway}, expected: ErrorClassServer},
+		{name: "forbidden", err: &APIError{StatusCode: http.StatusForbidden}, expected: ErrorClassHTTP},
+		{name: "canceled", err: fmt.Errorf("failed to send request: %w", context.Canceled), expected: ErrorClassCanceled},
+		{name: "deadline", err: context.DeadlineExceeded, expected: ErrorClassTimeout},
+		{name: "net timeout", err: timeoutError{}, expected: ErrorClassTimeout},
+		{name: "invalid json", err: fmt.Errorf("%w: EOF", ErrInvalidJSON), expected: ErrorClassInvalidJSON},
+		{name: "unexpected eof", err: io.ErrUnexpectedEOF, expected: ErrorClassNetwork},
+		{name: "other", err: errors.New("boom"), expected: ErrorClassOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifyError(tt.err))
+		})
+	}
+}
+
+func TestMultiInstrumentation(t *testing.T) {
+	first := &recordingInstrumentation{}
+	second := &recordingInstrumentation{}
+	multi := MultiInstrumentation(first, second)
+
+	ctx := multi.StartRequest(context.Background(), &RequestInfo{Endpoint: "teamdetails"})
+	multi.EndRequest(ctx, &RequestResult{})
+
+	assert.Len(t, first.started, 1)
+	assert.Len(t, second.started, 1)
+	assert.Len(t, first.results, 1)
+	assert.Len(t, second.results, 1)
+	assert.Equal(t, "span-1", ctx.Value(spanKey{}))
+}
+
+var expvarTestRuns atomic.Int32
+
+func TestExpvarInstrumentation(t *testing.T) {
+	// expvar names can only be published once per process.
+	name := fmt.Sprintf("nba_client_test_%d", expvarTestRuns.Add(1))
+	instrumentation := NewExpvarInstrumentation(name)
+	info := &RequestInfo{Endpoint: "playercareerstats", Host: "stats.nba.com"}
+
+	instrumentation.EndRequest(context.Background(), &RequestResult{
+		Request:      info,
+		StatusCode:   http.StatusOK,
+		Latency:      80 * time.Millisecond,
+		ResponseSize: 1024,
+		Attempts:     3,
+	})
+	instrumentation.EndRequest(context.Background(), &RequestResult{
+		Request:  info,
+		Latency:  time.Millisecond,
+		CacheHit: true,
+	})
+	instrumentation.EndRequest(context.Background(), &RequestResult{
+		Request:    info,
+		Latency:    2 * time.Second,
+		Attempts:   1,
+		Err:        context.DeadlineExceeded,
+		ErrorClass: ErrorClassTimeout,
+	})
+
+	var published struct {
+		Requests      map[string]int64            `json:"requests"`
+		Errors        map[string]map[string]int64 `json:"errors"`
+		CacheHits     map[string]int64            `json:"cache_hits"`
+		Retries       map[string]int64            `json:"retries"`
+		ResponseBytes map[string]int64            `json:"response_bytes"`
+		Latency       map[string]struct {
+			Buckets map[string]int64 `json:"buckets"`
+			Count   int64            `json:"count"`
+			Sum     float64          `json:"sum"`
+		} `json:"latency"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get(name).String()), &published))
+
+	key := "stats.nba.com/playercareerstats"
+	assert.Equal(t, int64(3), published.Requests[key])
+	assert.Equal(t, int64(1), published.Errors[key]["timeout"])
+	assert.Equal(t, int64(1), published.CacheHits[key])
+	assert.Equal(t, int64(2), published.Retries[key])
+	assert.Equal(t, int64(1024), published.ResponseBytes[key])
+
+	latency := published.Latency[key]
+	assert.Equal(t, int64(3), latency.Count)
+	assert.Equal(t, int64(2), latency.Buckets["100"])
+	assert.Equal(t, int64(3), latency.Buckets["2500"])
+	assert.Equal(t, int64(3), latency.Buckets["+Inf"])
+	assert.InDelta(t, 2081.0, latency.Sum, 0.001)
+}
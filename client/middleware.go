@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RequestInfo describes a request sent by SendRequest.
+type RequestInfo struct {
+	// Endpoint is the endpoint name, e.g. "playercareerstats".
+	Endpoint string
+	// Params are the request parameters as passed to SendRequest.
+	Params map[string]string
+	// URL is the canonical request URL with sorted query parameters.
+	URL string
+	// Host is the host name of URL.
+	Host string
+	// Attempt is the 1-based attempt number.
+	Attempt int
+}
+
+// ResponseInfo describes a response received for a request attempt.
+type ResponseInfo struct {
+	Request    *RequestInfo
+	StatusCode int
+	Header     http.Header
+	// Body is the decoded response body. It must not be modified.
+	Body    []byte
+	Latency time.Duration
+}
+
+// Interceptor hooks into every request sent by an HTTPClient. Any hook may
+// be nil. Interceptors run in the order they were added.
+//
+// Cache hits are served without sending a request and do not invoke
+// BeforeRequest or AfterResponse.
+type Interceptor struct {
+	// BeforeRequest is called before each attempt and may modify req, for
+	// example to rewrite or sign headers. Returning an error aborts the
+	// request without retrying.
+	BeforeRequest func(ctx context.Context, info *RequestInfo, req *http.Request) error
+	// AfterResponse is called for every HTTP response, including error
+	// statuses. Returning an error aborts the request without retrying.
+	AfterResponse func(ctx context.Context, info *ResponseInfo) error
+	// OnError is called once when SendRequest returns an error, with the
+	// latency of the whole call including retries.
+	OnError func(ctx context.Context, info *RequestInfo, err error, latency time.Duration)
+}
+
+// WithInterceptors adds interceptors to the HTTP client.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(o *options) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// Use adds interceptors to the HTTP client.
+func (c *HTTPClient) Use(interceptors ...Interceptor) {
+	c.interceptors = append(c.interceptors, interceptors...)
+}
+
+// hasAfterResponse reports whether any interceptor inspects responses.
+func (c *HTTPClient) hasAfterResponse() bool {
+	for _, interceptor := range c.interceptors {
+		if interceptor.AfterResponse != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// beforeRequest runs the BeforeRequest hooks.
+func (c *HTTPClient) beforeRequest(ctx context.Context, info *RequestInfo, req *http.Request) error {
+	for _, interceptor := range c.interceptors {
+		if interceptor.BeforeRequest == nil {
+			continue
+		}
+		if err := interceptor.BeforeRequest(ctx, info, req); err != nil {
+			return fmt.Errorf("request interceptor failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// afterResponse runs the AfterResponse hooks.
+func (c *HTTPClient) afterResponse(ctx context.Context, info *ResponseInfo) error {
+	for _, interceptor := range c.interceptors {
+		if interceptor.AfterResponse == nil {
+			continue
+		}
+		if err := interceptor.AfterResponse(ctx, info); err != nil {
+			return fmt.Errorf("response interceptor failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// onError runs the OnError hooks.
+func (c *HTTPClient) onError(ctx context.Context, info *RequestInfo, err error, latency time.Duration) {
+	for _, interceptor := range c.interceptors {
+		if interceptor.OnError != nil {
+			interceptor.OnError(ctx, info, err, latency)
+		}
+	}
+}
+
+// interceptorError marks errors returned by interceptors so that they are
+// never retried.
+type interceptorError struct {
+	err error
+}
+
+func (e *interceptorError) Error() string { return e.err.Error() }
+func (e *interceptorError) Unwrap() error { return e.err }
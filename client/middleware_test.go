@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClient_Use_Hooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "signed", r.Header.Get("X-Signature"))
+		assert.Equal(t, "rewritten", r.Header.Get("User-Agent"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"resource": "commonplayerinfo"}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	var responses []*ResponseInfo
+	client := NewHTTPClient(server.URL+"/%s", map[string]string{"User-Agent": "original"}, nil)
+	client.Use(
+		Interceptor{
+			BeforeRequest: func(ctx context.Context, info *RequestInfo, req *http.Request) error {
+				order = append(order, "first")
+				assert.Equal(t, "commonplayerinfo", info.Endpoint)
+				assert.Equal(t, "2544", info.Params["PlayerID"])
+				assert.Equal(t, 1, info.Attempt)
+				req.Header.Set("User-Agent", "rewritten")
+				return nil
+			},
+		},
+		Interceptor{
+			BeforeRequest: func(ctx context.Context, info *RequestInfo, req *http.Request) error {
+				order = append(order, "second")
+				req.Header.Set("X-Signature", "signed")
+				return nil
+			},
+			AfterResponse: func(ctx context.Context, info *ResponseInfo) error {
+				responses = append(responses, info)
+				return nil
+			},
+			OnError: func(ctx context.Context, info *RequestInfo, err error, latency time.Duration) {
+				t.Errorf("unexpected error: %v", err)
+			},
+		},
+	)
+
+	_, err := client.SendRequest(context.Background(), "commonplayerinfo", map[string]string{"PlayerID": "2544"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, order)
+	require.Len(t, responses, 1)
+	assert.Equal(t, http.StatusOK, responses[0].StatusCode)
+	assert.Equal(t, `{"resource": "commonplayerinfo"}`, string(responses[0].Body))
+	assert.Equal(t, "commonplayerinfo", responses[0].Request.Endpoint)
+	assert.Positive(t, responses[0].Latency)
+}
+
+func TestHTTPClient_Use_AfterResponseEveryAttempt(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var statuses []int
+	var attempts []int
+	client := NewHTTPClient(server.URL, nil, nil,
+		WithInterceptors(Interceptor{
+			AfterResponse: func(ctx context.Context, info *ResponseInfo) error {
+				statuses = append(statuses, info.StatusCode)
+				attempts = append(attempts, info.Request.Attempt)
+				return nil
+			},
+		}))
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	_, err := client.SendRequest(context.Background(), "", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{http.StatusServiceUnavailable, http.StatusOK}, statuses)
+	assert.Equal(t, []int{1, 2}, attempts)
+}
+
+func TestHTTPClient_Use_BeforeRequestErrorAborts(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer server.Close()
+
+	errDenied := errors.New("egress denied")
+	var onErr error
+	client := NewHTTPClient(server.URL, nil, nil)
+	client.SetRetryPolicy(fastRetryPolicy(3))
+	client.Use(Interceptor{
+		BeforeRequest: func(ctx context.Context, info *RequestInfo, req *http.Request) error {
+			return errDenied
+		},
+		OnError: func(ctx context.Context, info *RequestInfo, err error, latency time.Duration) {
+			onErr = err
+		},
+	})
+
+	_, err := client.SendRequest(context.Background(), "", nil)
+
+	assert.ErrorIs(t, err, errDenied)
+	assert.ErrorIs(t, onErr, errDenied)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_Use_OnErrorForAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	var onErr error
+	var onInfo *RequestInfo
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.Use(Interceptor{
+		OnError: func(ctx context.Context, info *RequestInfo, err error, latency time.Duration) {
+			onErr = err
+			onInfo = info
+		},
+	})
+
+	_, err := client.SendRequest(context.Background(), "teamdetails", map[string]string{"TeamID": "1"})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, onErr, ErrNotFound)
+	require.NotNil(t, onInfo)
+	assert.Equal(t, "teamdetails", onInfo.Endpoint)
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures an HTTPClient at construction time.
+type Option func(*options)
+
+// options collects the values set by Option functions.
+type options struct {
+	httpClient      *http.Client
+	transport       http.RoundTripper
+	baseURL         string
+	proxy           *url.URL
+	userAgent       string
+	timeout         time.Duration
+	interceptors    []Interceptor
+	retainBody      bool
+	instrumentation Instrumentation
+	deduplicate     bool
+	breaker         *CircuitBreaker
+	rotator         *HeaderRotator
+	timeoutPolicy   *TimeoutPolicy
+}
+
+// WithHTTPClient uses httpClient instead of a new http.Client. The given
+// client is never modified; other options are applied to a copy of it.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the transport used to send requests, for example one
+// with a custom TLS configuration or a test double.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.transport = transport
+	}
+}
+
+// WithBaseURL overrides the base URL, e.g. to target a mirror host or an
+// httptest server. Like the baseURL argument of NewHTTPClient it is a format
+// string in which %s is replaced by the endpoint name.
+func WithBaseURL(baseURL string) Option {
+	return func(o *options) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithProxy sends requests through the given proxy. It requires the
+// transport to be an *http.Transport (the default), which is cloned.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(o *options) {
+		o.proxy = proxyURL
+	}
+}
+
+// WithUserAgent overrides the User-Agent header, including that of the
+// profiles chosen by a HeaderRotator.
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the http.Client timeout, a hard limit on every attempt in
+// addition to the TimeoutPolicy. Prefer WithTimeoutPolicy.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetainRawBody keeps the raw body of responses decoded by Get for
+// debugging. See HTTPClient.SetRetainRawBody.
+func WithRetainRawBody() Option {
+	return func(o *options) {
+		o.retainBody = true
+	}
+}
+
+// apply applies the collected options to c.
+func (o *options) apply(c *HTTPClient) {
+	if o.baseURL != "" {
+		c.baseURL = o.baseURL
+	}
+
+	c.interceptors = append(c.interceptors, o.interceptors...)
+	if o.retainBody {
+		c.retainBody = true
+	}
+	if o.instrumentation != nil {
+		c.instrumentation = o.instrumentation
+	}
+	if o.deduplicate {
+		c.SetDeduplication(true)
+	}
+	if o.breaker != nil {
+		c.breaker = o.breaker
+	}
+	if o.rotator != nil {
+		c.rotator = o.rotator
+	}
+	if o.timeoutPolicy != nil {
+		c.timeoutPolicy = *o.timeoutPolicy
+	}
+
+	if o.userAgent != "" {
+		headers := make(map[string]string, len(c.headers)+1)
+		for key, value := range c.headers {
+			headers[key] = value
+		}
+		headers["User-Agent"] = o.userAgent
+		c.headers = headers
+		c.userAgent = o.userAgent
+	}
+
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		if o.transport == nil && o.proxy == nil && o.timeout == 0 {
+			c.httpClient = o.httpClient
+			return
+		}
+		copied := *o.httpClient
+		httpClient = &copied
+	}
+
+	if o.transport != nil {
+		httpClient.Transport = o.transport
+	}
+
+	if o.proxy != nil {
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		if base, ok := transport.(*http.Transport); ok {
+			cloned := base.Clone()
+			cloned.Proxy = http.ProxyURL(o.proxy)
+			httpClient.Transport = cloned
+		} else {
+			c.logger.Warn("Ignoring proxy option: transport is not an *http.Transport",
+				"proxy", o.proxy.Redacted())
+		}
+	}
+
+	if o.timeout != 0 {
+		httpClient.Timeout = o.timeout
+	}
+
+	c.httpClient = httpClient
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewHTTPClient_WithHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+
+	client := NewHTTPClient("https://api.example.com", nil, nil, WithHTTPClient(httpClient))
+
+	assert.Same(t, httpClient, client.httpClient)
+}
+
+func TestNewHTTPClient_WithHTTPClientCopiedWhenModified(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Second}
+
+	client := NewHTTPClient("https://api.example.com", nil, nil,
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second))
+
+	assert.NotSame(t, httpClient, client.httpClient)
+	assert.Equal(t, 5*time.Second, client.httpClient.Timeout)
+	assert.Equal(t, time.Second, httpClient.Timeout)
+}
+
+func TestNewHTTPClient_WithBaseURLAndUserAgent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mirror/playercareerstats", r.URL.Path)
+		assert.Equal(t, "custom-agent", r.Header.Get("User-Agent"))
+		assert.Equal(t, "https://stats.nba.com/", r.Header.Get("Referer"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	headers := map[string]string{
+		"User-Agent": "default-agent",
+		"Referer":    "https://stats.nba.com/",
+	}
+	client := NewHTTPClient("https://stats.nba.com/stats/%s", headers, nil,
+		WithBaseURL(server.URL+"/mirror/%s"),
+		WithUserAgent("custom-agent"))
+
+	_, err := client.SendRequest(context.Background(), "playercareerstats", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "default-agent", headers["User-Agent"])
+}
+
+func TestNewHTTPClient_WithTransport(t *testing.T) {
+	var called bool
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return httptest.NewRecorder().Result(), nil
+	})
+
+	client := NewHTTPClient("https://api.example.com", nil, nil, WithTransport(transport))
+
+	_, err := client.SendRequest(context.Background(), "", nil)
+
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestNewHTTPClient_WithProxy(t *testing.T) {
+	proxyURL, err := url.Parse("http://proxy.example.com:8080")
+	require.NoError(t, err)
+
+	client := NewHTTPClient("https://api.example.com", nil, nil, WithProxy(proxyURL))
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	req, err := http.NewRequest(http.MethodGet, "https://stats.nba.com/stats/", nil)
+	require.NoError(t, err)
+	got, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, proxyURL, got)
+	assert.NotSame(t, http.DefaultTransport, client.httpClient.Transport)
+}
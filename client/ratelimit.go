@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// StatsHost is the host serving the NBA Stats API.
+	StatsHost = "stats.nba.com"
+	// CDNHost is the host serving the NBA Live Data API.
+	CDNHost = "cdn.nba.com"
+)
+
+// Limit describes the rate allowed for a single host.
+type Limit struct {
+	// RequestsPerSecond is the sustained request rate. Zero or negative
+	// values disable limiting.
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent back to back.
+	// Values below 1 are treated as 1.
+	Burst int
+}
+
+// RateLimiter is a token-bucket rate limiter keyed by host.
+// A single RateLimiter may be shared by any number of HTTP clients.
+type RateLimiter struct {
+	mu           sync.Mutex
+	defaultLimit Limit
+	hostLimits   map[string]Limit
+	buckets      map[string]*tokenBucket
+	now          func() time.Time
+}
+
+// tokenBucket holds the state of a single host bucket.
+type tokenBucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a rate limiter that applies defaultLimit to every
+// host without a specific limit.
+func NewRateLimiter(defaultLimit Limit) *RateLimiter {
+	return &RateLimiter{
+		defaultLimit: defaultLimit,
+		hostLimits:   make(map[string]Limit),
+		buckets:      make(map[string]*tokenBucket),
+		now:          time.Now,
+	}
+}
+
+// NewDefaultRateLimiter creates a rate limiter with conservative limits for
+// stats.nba.com and cdn.nba.com. Other hosts are not limited.
+func NewDefaultRateLimiter() *RateLimiter {
+	limiter := NewRateLimiter(Limit{})
+	limiter.SetHostLimit(StatsHost, Limit{RequestsPerSecond: 1, Burst: 3})
+	limiter.SetHostLimit(CDNHost, Limit{RequestsPerSecond: 5, Burst: 10})
+	return limiter
+}
+
+// SetHostLimit sets the limit for a specific host, resetting its bucket.
+func (l *RateLimiter) SetHostLimit(host string, limit Limit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.hostLimits[host] = limit
+	delete(l.buckets, host)
+}
+
+// HostLimit returns the limit applied to the given host.
+func (l *RateLimiter) HostLimit(host string) Limit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.limitFor(host)
+}
+
+// Wait blocks until a request to host is allowed or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, host string) error {
+	delay, ok := l.reserve(host)
+	if !ok || delay <= 0 {
+		return ctx.Err()
+	}
+
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline && time.Until(deadline) < delay {
+		l.cancel(host)
+		return fmt.Errorf("rate limit wait of %s for %s exceeds context deadline: %w",
+			delay, host, context.DeadlineExceeded)
+	}
+
+	if err := sleepContext(ctx, delay); err != nil {
+		l.cancel(host)
+		return err
+	}
+	return nil
+}
+
+// limitFor returns the limit for host. The caller must hold l.mu.
+func (l *RateLimiter) limitFor(host string) Limit {
+	if limit, ok := l.hostLimits[host]; ok {
+		return limit
+	}
+	return l.defaultLimit
+}
+
+// reserve takes a token for host and returns how long the caller must wait
+// before using it. It reports false if the host is not limited.
+func (l *RateLimiter) reserve(host string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := l.limitFor(host)
+	if limit.RequestsPerSecond <= 0 {
+		return 0, false
+	}
+	burst := float64(max(limit.Burst, 1))
+
+	now := l.now()
+	bucket, ok := l.buckets[host]
+	if !ok {
+		bucket = &tokenBucket{limit: limit, tokens: burst, last: now}
+		l.buckets[host] = bucket
+	}
+
+	elapsed := now.Sub(bucket.last).Seconds()
+	if elapsed > 0 {
+		bucket.tokens = math.Min(burst, bucket.tokens+elapsed*limit.RequestsPerSecond)
+		bucket.last = now
+	}
+
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0, true
+	}
+	return time.Duration(-bucket.tokens / limit.RequestsPerSecond * float64(time.Second)), true
+}
+
+// cancel returns a reserved token for host that was not used.
+func (l *RateLimiter) cancel(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if bucket, ok := l.buckets[host]; ok {
+		bucket.tokens = math.Min(float64(max(bucket.limit.Burst, 1)), bucket.tokens+1)
+	}
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_reserve_Burst(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(Limit{RequestsPerSecond: 2, Burst: 3})
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		delay, limited := limiter.reserve(StatsHost)
+		assert.True(t, limited)
+		assert.Equal(t, time.Duration(0), delay)
+	}
+
+	delay, _ := limiter.reserve(StatsHost)
+	assert.Equal(t, 500*time.Millisecond, delay)
+
+	delay, _ = limiter.reserve(StatsHost)
+	assert.Equal(t, time.Second, delay)
+
+	// Tokens refill over time.
+	now = now.Add(2 * time.Second)
+	delay, _ = limiter.reserve(StatsHost)
+	assert.Equal(t, time.Duration(0), delay)
+}
+
+func TestRateLimiter_reserve_PerHost(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(Limit{})
+	limiter.now = func() time.Time { return now }
+	limiter.SetHostLimit(StatsHost, Limit{RequestsPerSecond: 1, Burst: 1})
+	limiter.SetHostLimit(CDNHost, Limit{RequestsPerSecond: 10, Burst: 1})
+
+	_, _ = limiter.reserve(StatsHost)
+	delay, _ := limiter.reserve(StatsHost)
+	assert.Equal(t, time.Second, delay)
+
+	_, _ = limiter.reserve(CDNHost)
+	delay, _ = limiter.reserve(CDNHost)
+	assert.Equal(t, 100*time.Millisecond, delay)
+
+	_, limited := limiter.reserve("example.com")
+	assert.False(t, limited)
+}
+
+func TestRateLimiter_Wait_ContextCancelled(t *testing.T) {
+	limiter := NewRateLimiter(Limit{RequestsPerSecond: 0.1, Burst: 1})
+	require.NoError(t, limiter.Wait(context.Background(), StatsHost))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := limiter.Wait(ctx, StatsHost)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The cancelled reservation is returned to the bucket.
+	delay, _ := limiter.reserve(StatsHost)
+	assert.LessOrEqual(t, delay, 10*time.Second)
+	assert.Greater(t, delay, 9*time.Second)
+}
+
+func TestNewDefaultRateLimiter(t *testing.T) {
+	limiter := NewDefaultRateLimiter()
+
+	assert.Equal(t, Limit{RequestsPerSecond: 1, Burst: 3}, limiter.HostLimit(StatsHost))
+	assert.Equal(t, Limit{RequestsPerSecond: 5, Burst: 10}, limiter.HostLimit(CDNHost))
+	assert.Equal(t, Limit{}, limiter.HostLimit("example.com"))
+}
+
+func TestHTTPClient_SendRequest_SharedRateLimiter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	limiter := NewRateLimiter(Limit{RequestsPerSecond: 50, Burst: 2})
+	first := NewHTTPClient(server.URL, nil, nil)
+	second := NewHTTPClient(server.URL, nil, nil)
+	first.SetRateLimiter(limiter)
+	second.SetRateLimiter(limiter)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		c := first
+		if i%2 == 1 {
+			c = second
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.SendRequest(context.Background(), "", nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// Two requests fit in the burst, the remaining four wait 20ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond)
+	assert.Equal(t, int32(6), atomic.LoadInt32(&calls))
+}
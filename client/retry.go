@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultBaseDelay   = 500 * time.Millisecond
+	defaultMaxDelay    = 10 * time.Second
+	defaultJitter      = 0.2
+)
+
+// RetryPolicy configures how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 1 are treated as 1 (no retries).
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. Each following retry
+	// doubles it until MaxDelay is reached.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts, including Retry-After values.
+	MaxDelay time.Duration
+	// Jitter is the fraction (0..1) of the delay that is randomized.
+	Jitter float64
+	// RetryableStatusCodes lists HTTP status codes that trigger a retry.
+	RetryableStatusCodes []int
+	// RetryableError reports whether a transport error should be retried.
+	// If nil, IsRetryableError is used.
+	RetryableError func(err error) bool
+}
+
+// DefaultRetryPolicy returns the retry policy used by new HTTP clients.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultMaxAttempts,
+		BaseDelay:   defaultBaseDelay,
+		MaxDelay:    defaultMaxDelay,
+		Jitter:      defaultJitter,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// NoRetryPolicy returns a policy that makes exactly one attempt.
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// IsRetryableError reports whether err is a transient network error:
+// timeouts, connection resets and refusals, or a prematurely closed connection.
+// An attempt exceeding its endpoint timeout is retryable; cancellation or
+// expiry of the caller's context and DNS lookup failures are not.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return true
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// attempts returns the number of attempts allowed by the policy.
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetryStatus reports whether the status code is retryable.
+func (p RetryPolicy) shouldRetryStatus(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldRetryError reports whether the transport error is retryable.
+func (p RetryPolicy) shouldRetryError(err error) bool {
+	if p.RetryableError != nil {
+		return p.RetryableError(err)
+	}
+	return IsRetryableError(err)
+}
+
+// backoff returns the delay before the given retry (1-based), honoring
+// retryAfter when the server provided one.
+func (p RetryPolicy) backoff(retry int, retryAfter time.Duration) time.Duration {
+	delay := retryAfter
+	if delay <= 0 {
+		delay = time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(retry-1)))
+		if p.Jitter > 0 {
+			jitter := math.Min(p.Jitter, 1)
+			delay = time.Duration(float64(delay) * (1 - jitter + 2*jitter*rand.Float64()))
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return delay
+}
+
+// parseRetryAfter parses a Retry-After header value given either in seconds
+// or as an HTTP date. It returns zero if the value is missing or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// sleepContext waits for the given duration or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fastRetryPolicy(maxAttempts int) RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = maxAttempts
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+	return policy
+}
+
+func TestHTTPClient_SendRequest_RetriesRetryableStatus(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, nil, nil)
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	resp, err := client.SendRequest(context.Background(), "", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.GetStatusCode())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_SendRequest_RetryAttemptsExhausted(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, nil, nil)
+	client.SetRetryPolicy(fastRetryPolicy(2))
+
+	_, err := client.SendRequest(context.Background(), "", nil)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_SendRequest_NonRetryableStatus(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, nil, nil)
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	_, err := client.SendRequest(context.Background(), "", nil)
+
+	assert.ErrorIs(t, err, ErrBadParameter)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_SendRequest_NoRetryPolicy(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, nil, nil)
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	_, err := client.SendRequest(context.Background(), "", nil)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_SendRequest_ContextCancelledBetweenAttempts(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL, nil, nil)
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = time.Minute
+	policy.MaxDelay = time.Minute
+	client.SetRetryPolicy(policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.SendRequest(ctx, "", nil)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "connection reset", err: fmt.Errorf("read: %w", syscall.ECONNRESET), expected: true},
+		{name: "connection refused", err: fmt.Errorf("dial: %w", syscall.ECONNREFUSED), expected: true},
+		{name: "unexpected EOF", err: io.ErrUnexpectedEOF, expected: true},
+		{name: "timeout", err: &net.OpError{Op: "dial", Err: timeoutError{}}, expected: true},
+		{name: "context canceled", err: fmt.Errorf("wrapped: %w", context.Canceled), expected: false},
+		{name: "dns not found", err: &net.DNSError{Err: "no such host", IsNotFound: true}, expected: false},
+		{name: "dns timeout", err: &net.DNSError{Err: "timeout", IsTimeout: true}, expected: true},
+		{name: "other", err: errors.New("boom"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsRetryableError(tt.err))
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1, 0))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2, 0))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3, 0))
+	assert.Equal(t, time.Second, policy.backoff(10, 0))
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(1, 300*time.Millisecond))
+	assert.Equal(t, time.Second, policy.backoff(1, time.Minute))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(2, 0)
+		assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		assert.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, 5*time.Second, parseRetryAfter("5", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("-1", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, 30*time.Second,
+		parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0),
+		parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
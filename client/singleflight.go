@@ -0,0 +1,240 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// flightGroup coalesces concurrent identical requests into one in-flight
+// HTTP request.
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is an in-flight request shared by one or more callers.
+type flight struct {
+	done    chan struct{}
+	waiters int
+	ctx     *flightContext
+
+	// info, response and err are written before done is closed.
+	info     *RequestInfo
+	response *Response
+	err      error
+}
+
+// newFlightGroup creates an empty flight group.
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: make(map[string]*flight)}
+}
+
+// flightContext is the context of a shared request. It keeps the values of
+// the first caller's context, and its deadline is the latest deadline among
+// the callers waiting for the request: it has none as soon as one of them
+// has none. It is cancelled when that deadline expires or when every caller
+// has given up.
+type flightContext struct {
+	context.Context
+	cancel context.CancelCauseFunc
+
+	mu        sync.Mutex
+	deadline  time.Time
+	unbounded bool
+	expired   bool
+	timer     *time.Timer
+}
+
+// newFlightContext creates the context of a request started by a caller
+// with context ctx.
+func newFlightContext(ctx context.Context) *flightContext {
+	base, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+	fc := &flightContext{Context: base, cancel: cancel, unbounded: true}
+	if deadline, ok := ctx.Deadline(); ok {
+		fc.unbounded = false
+		fc.deadline = deadline
+		fc.timer = time.AfterFunc(time.Until(deadline), fc.expire)
+	}
+	return fc
+}
+
+// join extends the deadline of the flight to cover a caller with context
+// ctx.
+func (fc *flightContext) join(ctx context.Context) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	deadline, ok := ctx.Deadline()
+	switch {
+	case !ok:
+		fc.unbounded = true
+		fc.deadline = time.Time{}
+		if fc.timer != nil {
+			fc.timer.Stop()
+		}
+	case !fc.unbounded && deadline.After(fc.deadline):
+		fc.deadline = deadline
+		fc.timer.Reset(time.Until(deadline))
+	}
+}
+
+// expire cancels the flight once its deadline has passed.
+func (fc *flightContext) expire() {
+	fc.mu.Lock()
+	if fc.unbounded || time.Now().Before(fc.deadline) {
+		// The deadline was extended after the timer fired.
+		fc.mu.Unlock()
+		return
+	}
+	fc.expired = true
+	fc.mu.Unlock()
+	fc.cancel(context.DeadlineExceeded)
+}
+
+// stop cancels the flight and releases its timer.
+func (fc *flightContext) stop() {
+	fc.mu.Lock()
+	if fc.timer != nil {
+		fc.timer.Stop()
+	}
+	fc.mu.Unlock()
+	fc.cancel(context.Canceled)
+}
+
+// Deadline returns the latest deadline among the callers of the flight.
+func (fc *flightContext) Deadline() (time.Time, bool) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.unbounded {
+		return time.Time{}, false
+	}
+	return fc.deadline, true
+}
+
+// Err returns context.DeadlineExceeded once the deadline of the flight has
+// expired.
+func (fc *flightContext) Err() error {
+	fc.mu.Lock()
+	expired := fc.expired
+	fc.mu.Unlock()
+	if expired {
+		return context.DeadlineExceeded
+	}
+	return fc.Context.Err()
+}
+
+// do calls fn once for all concurrent callers with the same key and returns
+// its result to each of them. fn runs with a context that keeps the values
+// of the first caller's context and is bounded by the latest deadline among
+// the callers; it is cancelled once every caller has given up, so one
+// caller's cancellation does not fail the others. shared reports whether
+// the caller joined a request started by another caller.
+func (g *flightGroup) do(ctx context.Context, key string, info *RequestInfo, fn func(context.Context, *RequestInfo) (*Response, error)) (response *Response, shared bool, err error) {
+	g.mu.Lock()
+	f, shared := g.flights[key]
+	if shared && f.ctx.Err() != nil {
+		// The flight has expired and is only finishing: start a new one.
+		shared = false
+	}
+	if shared {
+		f.waiters++
+		f.ctx.join(ctx)
+	} else {
+		flightInfo := *info
+		f = &flight{
+			done:    make(chan struct{}),
+			waiters: 1,
+			ctx:     newFlightContext(ctx),
+			info:    &flightInfo,
+		}
+		g.flights[key] = f
+
+		go func() {
+			f.response, f.err = fn(f.ctx, f.info)
+
+			g.mu.Lock()
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+			g.mu.Unlock()
+
+			f.ctx.stop()
+			close(f.done)
+		}()
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		info.Attempt = f.info.Attempt
+		return f.response, shared, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// Nobody is waiting any more: abandon the request so a later
+			// caller starts a fresh one.
+			f.ctx.stop()
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, shared, fmt.Errorf("failed to send request: %w", ctx.Err())
+	}
+}
+
+// WithDeduplication coalesces concurrent identical requests. See
+// HTTPClient.SetDeduplication.
+func WithDeduplication() Option {
+	return func(o *options) {
+		o.deduplicate = true
+	}
+}
+
+// SetDeduplication enables or disables request coalescing. When enabled,
+// concurrent calls for the same endpoint and parameters share a single
+// in-flight HTTP request and each receives its own decoded copy of the
+// result. Shared responses are buffered in memory before decoding. A caller
+// whose context is cancelled returns immediately; the shared request is only
+// cancelled once every caller waiting for it has returned, and it never runs
+// past the latest deadline among those callers.
+func (c *HTTPClient) SetDeduplication(enabled bool) {
+	if !enabled {
+		c.flights = nil
+		return
+	}
+	if c.flights == nil {
+		c.flights = newFlightGroup()
+	}
+}
+
+// executeShared sends the request through the flight group and decodes the
+// shared body into v.
+func (c *HTTPClient) executeShared(ctx context.Context, info *RequestInfo, v any, cacheTTL time.Duration) (*Response, error) {
+	response, shared, err := c.flights.do(ctx, info.URL, info, func(ctx context.Context, info *RequestInfo) (*Response, error) {
+		return c.fetch(ctx, info, nil, cacheTTL)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if shared {
+		c.logger.DebugContext(ctx, "Shared in-flight NBA API request",
+			slog.String("url", info.URL))
+		copied := *response
+		copied.shared = true
+		response = &copied
+	}
+
+	if v != nil && !response.notModified {
+		if err := c.decodeJSON(ctx, strings.NewReader(response.raw), v, response); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBlockingServer returns a server that counts requests and answers them
+// only once release is closed.
+func newBlockingServer(t *testing.T, release <-chan struct{}, calls *int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"resource": "commonplayerinfo", "parameters": {"PlayerID": 2544}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// waitForCalls waits until the server has received n requests.
+func waitForCalls(t *testing.T, calls *int32, n int32) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(calls) >= n
+	}, time.Second, time.Millisecond)
+}
+
+type dedupResponse struct {
+	Resource   string         `json:"resource"`
+	Parameters map[string]int `json:"parameters"`
+}
+
+func TestHTTPClient_Deduplication_SharesInFlightRequest(t *testing.T) {
+	release := make(chan struct{})
+	var calls int32
+	server := newBlockingServer(t, release, &calls)
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithDeduplication())
+	params := map[string]string{"PlayerID": "2544"}
+
+	const callers = 10
+	results := make([]dedupResponse, callers)
+	responses := make([]*Response, callers)
+	errs := make([]error, callers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = client.Get(context.Background(), "commonplayerinfo", params, &results[i])
+		}(i)
+	}
+
+	waitForCalls(t, &calls, 1)
+	// Give the remaining callers time to join the in-flight request.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	var shared int
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "commonplayerinfo", results[i].Resource)
+		assert.Equal(t, 2544, results[i].Parameters["PlayerID"])
+		if responses[i].Shared() {
+			shared++
+		}
+	}
+	assert.Equal(t, callers-1, shared)
+
+	// Each caller decodes its own copy.
+	results[0].Parameters["PlayerID"] = 0
+	assert.Equal(t, 2544, results[1].Parameters["PlayerID"])
+}
+
+func TestHTTPClient_Deduplication_DifferentParams(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil)
+	client.SetDeduplication(true)
+
+	for _, id := range []string{"2544", "201939", "2544"} {
+		resp, err := client.SendRequest(context.Background(), "commonplayerinfo", map[string]string{"PlayerID": id})
+		require.NoError(t, err)
+		assert.False(t, resp.Shared())
+	}
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls), "sequential requests are not coalesced")
+}
+
+func TestHTTPClient_Deduplication_CallerCancelled(t *testing.T) {
+	release := make(chan struct{})
+	var calls int32
+	server := newBlockingServer(t, release, &calls)
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithDeduplication())
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		var result dedupResponse
+		_, err := client.Get(leaderCtx, "commonplayerinfo", nil, &result)
+		leaderErr <- err
+	}()
+	waitForCalls(t, &calls, 1)
+
+	type outcome struct {
+		result dedupResponse
+		resp   *Response
+		err    error
+	}
+	followerDone := make(chan outcome, 1)
+	go func() {
+		var o outcome
+		o.resp, o.err = client.Get(context.Background(), "commonplayerinfo", nil, &o.result)
+		followerDone <- o
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	close(release)
+	follower := <-followerDone
+	require.NoError(t, follower.err)
+	assert.True(t, follower.resp.Shared())
+	assert.Equal(t, "commonplayerinfo", follower.result.Resource)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_Deduplication_AllCallersCancelled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	var calls int32
+	aborted := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			close(aborted)
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithDeduplication())
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := client.SendRequest(ctx, "commonplayerinfo", nil)
+			errs <- err
+		}()
+	}
+	waitForCalls(t, &calls, 1)
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	for i := 0; i < 2; i++ {
+		assert.True(t, errors.Is(<-errs, context.Canceled))
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Fatal("shared request was not cancelled after every caller gave up")
+	}
+}
+
+func TestFlightGroup_LatestDeadline(t *testing.T) {
+	group := newFlightGroup()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	deadlines := make(chan time.Time, 1)
+
+	leaderCtx, cancelLeader := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelLeader()
+	leaderDeadline, _ := leaderCtx.Deadline()
+	followerCtx, cancelFollower := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelFollower()
+	followerDeadline, _ := followerCtx.Deadline()
+
+	fn := func(ctx context.Context, info *RequestInfo) (*Response, error) {
+		close(started)
+		<-release
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		deadlines <- deadline
+		return &Response{}, nil
+	}
+
+	done := make(chan error, 2)
+	go func() {
+		_, _, err := group.do(leaderCtx, "key", &RequestInfo{}, fn)
+		done <- err
+	}()
+	<-started
+	go func() {
+		_, shared, err := group.do(followerCtx, "key", &RequestInfo{}, fn)
+		assert.True(t, shared)
+		done <- err
+	}()
+	require.Eventually(t, func() bool {
+		group.mu.Lock()
+		defer group.mu.Unlock()
+		return group.flights["key"].waiters == 2
+	}, time.Second, time.Millisecond)
+
+	close(release)
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+	deadline := <-deadlines
+	assert.True(t, deadline.Equal(followerDeadline))
+	assert.True(t, deadline.After(leaderDeadline))
+}
+
+func TestFlightContext_Deadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	expiring := newFlightContext(ctx)
+	defer expiring.stop()
+
+	select {
+	case <-expiring.Done():
+	case <-time.After(time.Second):
+		t.Fatal("flight outlived the deadline of its only caller")
+	}
+	assert.ErrorIs(t, expiring.Err(), context.DeadlineExceeded)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	unbounded := newFlightContext(ctx)
+	defer unbounded.stop()
+	unbounded.join(context.Background())
+
+	_, ok := unbounded.Deadline()
+	assert.False(t, ok, "a caller without a deadline lifts the bound")
+	time.Sleep(40 * time.Millisecond)
+	assert.NoError(t, unbounded.Err())
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http/httptrace"
+	"path"
+	"sync/atomic"
+	"time"
+)
+
+// ErrTimeout is matched by errors returned when a request attempt exceeds
+// the timeout of its endpoint.
+var ErrTimeout = errors.New("nba api: request timed out")
+
+// TimeoutPhase is the phase of a request attempt that stalled.
+type TimeoutPhase string
+
+const (
+	// PhaseConnect covers DNS resolution, dialing and the TLS handshake.
+	PhaseConnect TimeoutPhase = "connect"
+	// PhaseHeaders covers writing the request and waiting for the
+	// response headers.
+	PhaseHeaders TimeoutPhase = "headers"
+	// PhaseBody covers reading and decoding the response body.
+	PhaseBody TimeoutPhase = "body"
+)
+
+// TimeoutError is returned when a request attempt exceeds the timeout of its
+// endpoint. It matches ErrTimeout and context.DeadlineExceeded.
+type TimeoutError struct {
+	Endpoint string
+	// Phase is the phase the attempt was in when the deadline expired.
+	Phase TimeoutPhase
+	// Timeout is the timeout that was exceeded.
+	Timeout time.Duration
+	// Err is the error returned by the transport or the decoder.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("nba api: %s timed out after %s in %s phase: %v",
+		e.Endpoint, e.Timeout, e.Phase, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrTimeout or context.DeadlineExceeded.
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout || target == context.DeadlineExceeded
+}
+
+// TimeoutRule assigns a timeout to the endpoints matching a pattern.
+type TimeoutRule struct {
+	// Endpoint is a path.Match pattern matched against the endpoint name,
+	// e.g. "leaguegamefinder", "shotchart*" or "scoreboard/*".
+	Endpoint string
+	// Timeout limits each attempt. Zero disables the timeout.
+	Timeout time.Duration
+}
+
+// TimeoutPolicy decides how long each attempt of a request to an endpoint
+// may take. Timeouts are applied as context deadlines, so they compose with
+// the deadline of the caller's context: whichever expires first wins.
+type TimeoutPolicy struct {
+	// Rules are evaluated in order; the first matching rule wins.
+	Rules []TimeoutRule
+	// DefaultTimeout applies to endpoints without a matching rule.
+	DefaultTimeout time.Duration
+}
+
+// DefaultTimeoutPolicy returns a policy with a 30 second default, short
+// timeouts for the live CDN and long ones for league-wide queries.
+func DefaultTimeoutPolicy() TimeoutPolicy {
+	return TimeoutPolicy{
+		Rules: []TimeoutRule{
+			{Endpoint: "scoreboard/*", Timeout: 5 * time.Second},
+			{Endpoint: "leaguegamefinder", Timeout: 90 * time.Second},
+			{Endpoint: "shotchartleaguewide", Timeout: 90 * time.Second},
+			{Endpoint: "shotchartdetail", Timeout: 60 * time.Second},
+			{Endpoint: "playergamelogs", Timeout: 60 * time.Second},
+			{Endpoint: "leaguedash*", Timeout: 60 * time.Second},
+		},
+		DefaultTimeout: defaultTimeout,
+	}
+}
+
+// Timeout returns the timeout for an attempt of a request to endpoint.
+func (p TimeoutPolicy) Timeout(endpoint string) time.Duration {
+	for _, rule := range p.Rules {
+		if matched, err := path.Match(rule.Endpoint, endpoint); err == nil && matched {
+			return rule.Timeout
+		}
+	}
+	return p.DefaultTimeout
+}
+
+// requestTimeoutKey is the context key of WithRequestTimeout.
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout returns a context that overrides the timeout policy of
+// the client for requests made with it. Zero disables the timeout.
+//
+//	ctx := client.WithRequestTimeout(ctx, 2*time.Minute)
+//	games, err := c.League.GetLeagueGameFinder(ctx, params)
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// SetTimeoutPolicy sets the policy deciding the timeout of each endpoint.
+func (c *HTTPClient) SetTimeoutPolicy(policy TimeoutPolicy) {
+	c.timeoutPolicy = policy
+}
+
+// TimeoutPolicy returns the policy deciding the timeout of each endpoint.
+func (c *HTTPClient) TimeoutPolicy() TimeoutPolicy {
+	return c.timeoutPolicy
+}
+
+// WithTimeoutPolicy sets the timeout policy of the HTTP client.
+func WithTimeoutPolicy(policy TimeoutPolicy) Option {
+	return func(o *options) {
+		o.timeoutPolicy = &policy
+	}
+}
+
+// requestTimeout returns the timeout for an attempt of a request to endpoint.
+func (c *HTTPClient) requestTimeout(ctx context.Context, endpoint string) time.Duration {
+	if timeout, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok {
+		return timeout
+	}
+	return c.timeoutPolicy.Timeout(endpoint)
+}
+
+// attemptDeadline bounds a single attempt by its timeout and tracks its
+// phase so that an expired deadline can be reported precisely.
+type attemptDeadline struct {
+	parent   context.Context
+	ctx      context.Context
+	endpoint string
+	timeout  time.Duration
+	phase    atomic.Value
+}
+
+// newAttemptDeadline derives the context of an attempt from ctx. The
+// returned cancel function must be called once the body has been consumed.
+func (c *HTTPClient) newAttemptDeadline(ctx context.Context, endpoint string) (*attemptDeadline, context.CancelFunc) {
+	d := &attemptDeadline{
+		parent:   ctx,
+		ctx:      ctx,
+		endpoint: endpoint,
+		timeout:  c.requestTimeout(ctx, endpoint),
+	}
+	d.phase.Store(PhaseConnect)
+	if d.timeout <= 0 {
+		return d, func() {}
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, d.timeout)
+	d.ctx = httptrace.WithClientTrace(attemptCtx, &httptrace.ClientTrace{
+		GotConn: func(httptrace.GotConnInfo) {
+			d.phase.Store(PhaseHeaders)
+		},
+	})
+	return d, cancel
+}
+
+// readingBody marks the start of the body phase.
+func (d *attemptDeadline) readingBody() {
+	d.phase.Store(PhaseBody)
+}
+
+// wrap turns err into a TimeoutError when it was caused by the attempt
+// deadline rather than by the caller's context.
+func (d *attemptDeadline) wrap(err error) error {
+	if err == nil || d.ctx == d.parent || d.parent.Err() != nil ||
+		!errors.Is(d.ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	return &TimeoutError{
+		Endpoint: d.endpoint,
+		Phase:    d.phase.Load().(TimeoutPhase),
+		Timeout:  d.timeout,
+		Err:      err,
+	}
+}
+
+// logTimeout logs err if it is a TimeoutError.
+func (c *HTTPClient) logTimeout(ctx context.Context, info *RequestInfo, err error) {
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		c.logger.WarnContext(ctx, "NBA API request timed out",
+			slog.String("url", info.URL),
+			slog.String("phase", string(timeoutErr.Phase)),
+			slog.Duration("timeout", timeoutErr.Timeout),
+			slog.Int("attempt", info.Attempt))
+	}
+}
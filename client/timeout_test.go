@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shortTimeouts is a timeout policy for tests.
+func shortTimeouts(timeout time.Duration) TimeoutPolicy {
+	return TimeoutPolicy{DefaultTimeout: timeout}
+}
+
+func TestTimeoutPolicy_Timeout(t *testing.T) {
+	policy := DefaultTimeoutPolicy()
+
+	assert.Equal(t, 90*time.Second, policy.Timeout("leaguegamefinder"))
+	assert.Equal(t, 60*time.Second, policy.Timeout("leaguedashplayerstats"))
+	assert.Equal(t, 5*time.Second, policy.Timeout("scoreboard/todaysScoreboard_00.json"))
+	assert.Equal(t, 30*time.Second, policy.Timeout("commonplayerinfo"))
+}
+
+func TestHTTPClient_Timeout_Phases(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/body" {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"resource": `))
+			w.(http.Flusher).Flush()
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	// A listener that never completes a TLS handshake stalls the connection.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				<-release
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	tests := []struct {
+		name     string
+		baseURL  string
+		endpoint string
+		expected TimeoutPhase
+	}{
+		{name: "connect", baseURL: "https://" + listener.Addr().String() + "/%s", endpoint: "connect", expected: PhaseConnect},
+		{name: "headers", baseURL: server.URL + "/%s", endpoint: "headers", expected: PhaseHeaders},
+		{name: "body", baseURL: server.URL + "/%s", endpoint: "body", expected: PhaseBody},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewHTTPClient(tt.baseURL, nil, nil, WithTimeoutPolicy(shortTimeouts(50*time.Millisecond)))
+			client.SetRetryPolicy(NoRetryPolicy())
+
+			var result map[string]any
+			_, err := client.Get(context.Background(), tt.endpoint, nil, &result)
+
+			var timeoutErr *TimeoutError
+			require.ErrorAs(t, err, &timeoutErr)
+			assert.Equal(t, tt.expected, timeoutErr.Phase)
+			assert.Equal(t, tt.endpoint, timeoutErr.Endpoint)
+			assert.Equal(t, 50*time.Millisecond, timeoutErr.Timeout)
+			assert.ErrorIs(t, err, ErrTimeout)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.Equal(t, ErrorClassTimeout, ClassifyError(err))
+		})
+	}
+}
+
+func TestHTTPClient_Timeout_PerCallOverride(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithTimeoutPolicy(shortTimeouts(time.Minute)))
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	ctx := WithRequestTimeout(context.Background(), 20*time.Millisecond)
+	start := time.Now()
+	_, err := client.SendRequest(ctx, "leaguegamefinder", nil)
+
+	var timeoutErr *TimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, 20*time.Millisecond, timeoutErr.Timeout)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestHTTPClient_Timeout_CallerDeadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithTimeoutPolicy(shortTimeouts(time.Minute)))
+	client.SetRetryPolicy(fastRetryPolicy(3))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.SendRequest(ctx, "teamdetails", nil)
+
+	var timeoutErr *TimeoutError
+	assert.False(t, errors.As(err, &timeoutErr), "the caller's deadline is not an endpoint timeout")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPClient_Timeout_Retried(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"resource": "teamdetails"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(server.URL+"/%s", nil, nil, WithTimeoutPolicy(shortTimeouts(50*time.Millisecond)))
+	client.SetRetryPolicy(fastRetryPolicy(2))
+
+	var result map[string]any
+	_, err := client.Get(context.Background(), "teamdetails", nil, &result)
+
+	require.NoError(t, err)
+	assert.Equal(t, "teamdetails", result["resource"])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
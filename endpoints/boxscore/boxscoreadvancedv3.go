@@ -6,6 +6,52 @@
 	"log/slog"
 )
 
+// AdvancedStatistics holds the advanced box score statistics of a
+// player or team.
+type AdvancedStatistics struct {
+	Minutes                      string  `json:"minutes"`
+	EstimatedOffensiveRating     float64 `json:"estimatedOffensiveRating"`
+	OffensiveRating              float64 `json:"offensiveRating"`
+	EstimatedDefensiveRating     float64 `json:"estimatedDefensiveRating"`
+	DefensiveRating              float64 `json:"defensiveRating"`
+	EstimatedNetRating           float64 `json:"estimatedNetRating"`
+	NetRating                    float64 `json:"netRating"`
+	AssistPercentage             float64 `json:"assistPercentage"`
+	AssistToTurnover             float64 `json:"assistToTurnover"`
+	AssistRatio                  float64 `json:"assistRatio"`
+	OffensiveReboundPercentage   float64 `json:"offensiveReboundPercentage"`
+	DefensiveReboundPercentage   float64 `json:"defensiveReboundPercentage"`
+	ReboundPercentage            float64 `json:"reboundPercentage"`
+	TurnoverRatio                float64 `json:"turnoverRatio"`
+	EffectiveFieldGoalPercentage float64 `json:"effectiveFieldGoalPercentage"`
+	TrueShootingPercentage       float64 `json:"trueShootingPercentage"`
+	UsagePercentage              float64 `json:"usagePercentage"`
+	EstimatedUsagePercentage     float64 `json:"estimatedUsagePercentage"`
+	EstimatedPace                float64 `json:"estimatedPace"`
+	Pace                         float64 `json:"pace"`
+	PacePer40                    float64 `json:"pacePer40"`
+	Possessions                  float64 `json:"possessions"`
+	PIE                          float64 `json:"PIE"`
+}
+
+// BoxScoreAdvancedV3Response is the response of the boxscoreadvancedv3 endpoint.
+type BoxScoreAdvancedV3Response struct {
+	Meta             Meta                           `json:"meta"`
+	BoxScoreAdvanced BoxScoreV3[AdvancedStatistics] `json:"boxScoreAdvanced"`
+}
+
+// StatsResponse converts the box score to the PlayerStats and TeamStats
+// result sets.
+func (r *BoxScoreAdvancedV3Response) StatsResponse() *StatsResponse {
+	return &StatsResponse{
+		Resource: "boxscoreadvancedv3",
+		ResultSets: []ResultSet{
+			r.BoxScoreAdvanced.playerStats(),
+			r.BoxScoreAdvanced.teamStats(),
+		},
+	}
+}
+
 // BoxScoreAdvancedV3Params holds parameters for the BoxScoreAdvancedV3 endpoint.
 type BoxScoreAdvancedV3Params struct {
 	GameId string
@@ -16,8 +62,19 @@ type BoxScoreAdvancedV3Params struct {
 	StartRange string
 }
 
-// GetBoxScoreAdvancedV3 fetches data from the boxscoreadvancedv3 endpoint.
+// GetBoxScoreAdvancedV3 fetches data from the boxscoreadvancedv3 endpoint and converts
+// it to result sets. Use GetBoxScoreAdvancedV3Typed for the nested box score.
 func (c *Client) GetBoxScoreAdvancedV3(ctx context.Context, params BoxScoreAdvancedV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreAdvancedV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreAdvancedV3Typed fetches the nested box score from the
+// boxscoreadvancedv3 endpoint.
+func (c *Client) GetBoxScoreAdvancedV3Typed(ctx context.Context, params BoxScoreAdvancedV3Params) (*BoxScoreAdvancedV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscoreadvancedv3")
 
 	reqParams := map[string]string{
@@ -41,27 +98,15 @@ func (c *Client) GetBoxScoreAdvancedV3(ctx context.Context, params BoxScoreAdvan
 		reqParams["StartRange"] = params.StartRange
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscoreadvancedv3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreAdvancedV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscoreadvancedv3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscoreadvancedv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscoreadvancedv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscoreadvancedv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscoreadvancedv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscoreadvancedv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", boxScoreResp.BoxScoreAdvanced.playerCount()))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
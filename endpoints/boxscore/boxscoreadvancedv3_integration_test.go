@@ -9,6 +9,7 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/vcr"
 )
 
 func TestGetBoxScoreAdvancedV3_Integration(t *testing.T) {
@@ -30,6 +31,7 @@ func TestGetBoxScoreAdvancedV3_Integration(t *testing.T) {
 	response, err := client.GetBoxScoreAdvancedV3(ctx, params)
 
 	if err != nil {
+		require.NotErrorIs(t, err, vcr.ErrFixtureNotFound, "no replay fixture, run make test-record or make test-seed")
 		t.Logf("BoxScoreAdvancedV3 endpoint error: %v", err)
 		t.Skip("Endpoint may be unavailable or parameters incorrect")
 		return
@@ -6,40 +6,117 @@
 	"log/slog"
 )
 
+// DefensiveStatistics holds the defensive statistics of a player against
+// every offensive player they guarded. MatchupMinutes is formatted as
+// "MM:SS".
+type DefensiveStatistics struct {
+	MatchupMinutes                string  `json:"matchupMinutes"`
+	PartialPossessions            float64 `json:"partialPossessions"`
+	SwitchesOn                    int     `json:"switchesOn"`
+	PlayerPoints                  int     `json:"playerPoints"`
+	DefensiveRebounds             int     `json:"defensiveRebounds"`
+	MatchupAssists                int     `json:"matchupAssists"`
+	MatchupTurnovers              int     `json:"matchupTurnovers"`
+	Steals                        int     `json:"steals"`
+	Blocks                        int     `json:"blocks"`
+	MatchupFieldGoalsMade         int     `json:"matchupFieldGoalsMade"`
+	MatchupFieldGoalsAttempted    int     `json:"matchupFieldGoalsAttempted"`
+	MatchupFieldGoalPercentage    float64 `json:"matchupFieldGoalPercentage"`
+	MatchupThreePointersMade      int     `json:"matchupThreePointersMade"`
+	MatchupThreePointersAttempted int     `json:"matchupThreePointersAttempted"`
+	MatchupThreePointerPercentage float64 `json:"matchupThreePointerPercentage"`
+}
+
+// TeamDefensiveStatistics holds the only team total of boxscoredefensivev2,
+// the minutes played formatted as "MMM:SS".
+type TeamDefensiveStatistics struct {
+	Minutes string `json:"minutes"`
+}
+
+// PlayerDefensiveV2 holds a player's defensive statistics.
+type PlayerDefensiveV2 struct {
+	PlayerInfoV3
+	Statistics DefensiveStatistics `json:"statistics"`
+}
+
+// TeamDefensiveV2 holds the defensive statistics of a team's players.
+type TeamDefensiveV2 struct {
+	TeamInfoV3
+	Players    []PlayerDefensiveV2     `json:"players"`
+	Statistics TeamDefensiveStatistics `json:"statistics"`
+}
+
+// BoxScoreDefensiveV2 holds the defensive box score of a game.
+type BoxScoreDefensiveV2 struct {
+	GameID     string          `json:"gameId"`
+	AwayTeamID int             `json:"awayTeamId"`
+	HomeTeamID int             `json:"homeTeamId"`
+	HomeTeam   TeamDefensiveV2 `json:"homeTeam"`
+	AwayTeam   TeamDefensiveV2 `json:"awayTeam"`
+}
+
+// BoxScoreDefensiveV2Response is the response of the boxscoredefensivev2
+// endpoint, which is nested like the V3 box scores.
+type BoxScoreDefensiveV2Response struct {
+	Meta              Meta                `json:"meta"`
+	BoxScoreDefensive BoxScoreDefensiveV2 `json:"boxScoreDefensive"`
+}
+
+// StatsResponse converts the box score to the PlayerStats and TeamStats
+// result sets.
+func (r *BoxScoreDefensiveV2Response) StatsResponse() *StatsResponse {
+	b := r.BoxScoreDefensive
+	teams := []TeamDefensiveV2{b.HomeTeam, b.AwayTeam}
+
+	players := ResultSet{Name: "PlayerStats", Headers: columns(gameKey{}, TeamInfoV3{}, PlayerInfoV3{}, DefensiveStatistics{})}
+	totals := ResultSet{Name: "TeamStats", Headers: columns(gameKey{}, TeamInfoV3{}, TeamDefensiveStatistics{})}
+	for _, team := range teams {
+		for _, player := range team.Players {
+			players.RowSet = append(players.RowSet, row(gameKey{b.GameID}, team.TeamInfoV3, player.PlayerInfoV3, player.Statistics))
+		}
+		totals.RowSet = append(totals.RowSet, row(gameKey{b.GameID}, team.TeamInfoV3, team.Statistics))
+	}
+
+	return &StatsResponse{
+		Resource:   "boxscoredefensivev2",
+		ResultSets: []ResultSet{players, totals},
+	}
+}
+
 // BoxScoreDefensiveV2Params holds parameters for the BoxScoreDefensiveV2 endpoint.
 type BoxScoreDefensiveV2Params struct {
 	GameId string
 }
 
-// GetBoxScoreDefensiveV2 fetches data from the boxscoredefensivev2 endpoint.
+// GetBoxScoreDefensiveV2 fetches data from the boxscoredefensivev2 endpoint
+// and converts it to result sets. Use GetBoxScoreDefensiveV2Typed for the
+// nested box score.
 func (c *Client) GetBoxScoreDefensiveV2(ctx context.Context, params BoxScoreDefensiveV2Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreDefensiveV2Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreDefensiveV2Typed fetches the nested box score from the
+// boxscoredefensivev2 endpoint.
+func (c *Client) GetBoxScoreDefensiveV2Typed(ctx context.Context, params BoxScoreDefensiveV2Params) (*BoxScoreDefensiveV2Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscoredefensivev2")
 
 	reqParams := map[string]string{
 		"GameID": params.GameId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscoredefensivev2", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreDefensiveV2Response
+	if _, err := c.httpClient.Get(ctx, "boxscoredefensivev2", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscoredefensivev2",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscoredefensivev2: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscoredefensivev2 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscoredefensivev2 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscoredefensivev2",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", len(boxScoreResp.BoxScoreDefensive.HomeTeam.Players)+len(boxScoreResp.BoxScoreDefensive.AwayTeam.Players)))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
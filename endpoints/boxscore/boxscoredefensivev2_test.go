@@ -15,20 +15,32 @@
 
 func TestGetBoxScoreDefensiveV2(t *testing.T) {
 	mockResponse := `{
-		"resource": "boxscoredefensivev2",
-		"parameters": {},
-		"resultSets": [
-{
-				"name": "PlayerStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+		"meta": {"version": 1, "request": "http://nba.cloud/games/0022300061/boxscoredefensivev2", "time": "2023-10-25 01:12:34.5634"},
+		"boxScoreDefensive": {
+			"gameId": "0022300061",
+			"awayTeamId": 1610612747,
+			"homeTeamId": 1610612743,
+			"homeTeam": {
+				"teamId": 1610612743, "teamCity": "Denver", "teamName": "Nuggets", "teamTricode": "DEN", "teamSlug": "nuggets",
+				"players": [{
+					"personId": 203999, "firstName": "Nikola", "familyName": "Jokic", "nameI": "N. Jokic",
+					"playerSlug": "nikola-jokic", "position": "C", "comment": "", "jerseyNum": "15",
+					"statistics": {"matchupMinutes": "18:22", "partialPossessions": 74.8, "playerPoints": 12, "defensiveRebounds": 9,
+						"matchupFieldGoalsMade": 5, "matchupFieldGoalsAttempted": 9, "matchupFieldGoalPercentage": 0.556}
+				}],
+				"statistics": {"minutes": "240:00"}
 			},
-{
-				"name": "TeamStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+			"awayTeam": {
+				"teamId": 1610612747, "teamCity": "Los Angeles", "teamName": "Lakers", "teamTricode": "LAL", "teamSlug": "lakers",
+				"players": [{
+					"personId": 2544, "firstName": "LeBron", "familyName": "James", "nameI": "L. James",
+					"playerSlug": "lebron-james", "position": "F", "comment": "", "jerseyNum": "23",
+					"statistics": {"matchupMinutes": "13:40", "partialPossessions": 57.3, "playerPoints": 11, "defensiveRebounds": 7,
+						"matchupFieldGoalsMade": 5, "matchupFieldGoalsAttempted": 8, "matchupFieldGoalPercentage": 0.625}
+				}],
+				"statistics": {"minutes": "240:00"}
 			}
-		]
+		}
 	}`
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -47,11 +59,35 @@ func TestGetBoxScoreDefensiveV2(t *testing.T) {
 
 	params := BoxScoreDefensiveV2Params{}
 
+	resp, err := c.GetBoxScoreDefensiveV2Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	box := resp.BoxScoreDefensive
+	assert.Equal(t, "0022300061", box.GameID)
+	assert.Equal(t, "DEN", box.HomeTeam.TeamTricode)
+	require.Len(t, box.AwayTeam.Players, 1)
+	assert.Equal(t, "13:40", box.AwayTeam.Players[0].Statistics.MatchupMinutes)
+	assert.Equal(t, 0.625, box.AwayTeam.Players[0].Statistics.MatchupFieldGoalPercentage)
+	assert.Equal(t, "240:00", box.HomeTeam.Statistics.Minutes)
+
 	response, err := c.GetBoxScoreDefensiveV2(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "boxscoredefensivev2", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+
+	players, err := response.GetDataSet("PlayerStats")
+	require.NoError(t, err)
+	require.Equal(t, 2, players.RowCount())
+	row, err := players.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, float64(203999), row["personId"])
+	assert.Equal(t, "Nuggets", row["teamName"])
+	assert.Equal(t, float64(9), row["defensiveRebounds"])
+
+	teams, err := response.GetDataSet("TeamStats")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gameId", "teamId", "teamCity", "teamName", "teamTricode", "teamSlug", "minutes"}, teams.Headers)
+	assert.Equal(t, 2, teams.RowCount())
 }
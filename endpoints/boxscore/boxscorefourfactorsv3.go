@@ -6,6 +6,38 @@
 	"log/slog"
 )
 
+// FourFactorsStatistics holds the four factors box score statistics of a
+// player or team.
+type FourFactorsStatistics struct {
+	Minutes                         string  `json:"minutes"`
+	EffectiveFieldGoalPercentage    float64 `json:"effectiveFieldGoalPercentage"`
+	FreeThrowAttemptRate            float64 `json:"freeThrowAttemptRate"`
+	TeamTurnoverPercentage          float64 `json:"teamTurnoverPercentage"`
+	OffensiveReboundPercentage      float64 `json:"offensiveReboundPercentage"`
+	OppEffectiveFieldGoalPercentage float64 `json:"oppEffectiveFieldGoalPercentage"`
+	OppFreeThrowAttemptRate         float64 `json:"oppFreeThrowAttemptRate"`
+	OppTeamTurnoverPercentage       float64 `json:"oppTeamTurnoverPercentage"`
+	OppOffensiveReboundPercentage   float64 `json:"oppOffensiveReboundPercentage"`
+}
+
+// BoxScoreFourFactorsV3Response is the response of the boxscorefourfactorsv3 endpoint.
+type BoxScoreFourFactorsV3Response struct {
+	Meta                Meta                              `json:"meta"`
+	BoxScoreFourFactors BoxScoreV3[FourFactorsStatistics] `json:"boxScoreFourFactors"`
+}
+
+// StatsResponse converts the box score to the PlayerStats and TeamStats
+// result sets.
+func (r *BoxScoreFourFactorsV3Response) StatsResponse() *StatsResponse {
+	return &StatsResponse{
+		Resource: "boxscorefourfactorsv3",
+		ResultSets: []ResultSet{
+			r.BoxScoreFourFactors.playerStats(),
+			r.BoxScoreFourFactors.teamStats(),
+		},
+	}
+}
+
 // BoxScoreFourFactorsV3Params holds parameters for the BoxScoreFourFactorsV3 endpoint.
 type BoxScoreFourFactorsV3Params struct {
 	GameId string
@@ -16,8 +48,19 @@ type BoxScoreFourFactorsV3Params struct {
 	StartRange string
 }
 
-// GetBoxScoreFourFactorsV3 fetches data from the boxscorefourfactorsv3 endpoint.
+// GetBoxScoreFourFactorsV3 fetches data from the boxscorefourfactorsv3 endpoint and converts
+// it to result sets. Use GetBoxScoreFourFactorsV3Typed for the nested box score.
 func (c *Client) GetBoxScoreFourFactorsV3(ctx context.Context, params BoxScoreFourFactorsV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreFourFactorsV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreFourFactorsV3Typed fetches the nested box score from the
+// boxscorefourfactorsv3 endpoint.
+func (c *Client) GetBoxScoreFourFactorsV3Typed(ctx context.Context, params BoxScoreFourFactorsV3Params) (*BoxScoreFourFactorsV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscorefourfactorsv3")
 
 	reqParams := map[string]string{
@@ -29,27 +72,15 @@ func (c *Client) GetBoxScoreFourFactorsV3(ctx context.Context, params BoxScoreFo
 		"StartRange": params.StartRange,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscorefourfactorsv3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreFourFactorsV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscorefourfactorsv3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscorefourfactorsv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscorefourfactorsv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscorefourfactorsv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscorefourfactorsv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscorefourfactorsv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", boxScoreResp.BoxScoreFourFactors.playerCount()))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
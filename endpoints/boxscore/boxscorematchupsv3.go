@@ -6,40 +6,148 @@
 	"log/slog"
 )
 
+// MatchupStatistics holds the statistics of an offensive player while
+// guarded by one defender. MatchupMinutes is formatted as "MM:SS".
+type MatchupStatistics struct {
+	MatchupMinutes                 string  `json:"matchupMinutes"`
+	MatchupMinutesSort             float64 `json:"matchupMinutesSort"`
+	PartialPossessions             float64 `json:"partialPossessions"`
+	PercentageDefenderTotalTime    float64 `json:"percentageDefenderTotalTime"`
+	PercentageOffensiveTotalTime   float64 `json:"percentageOffensiveTotalTime"`
+	PercentageTotalTimeBothOn      float64 `json:"percentageTotalTimeBothOn"`
+	SwitchesOn                     int     `json:"switchesOn"`
+	PlayerPoints                   int     `json:"playerPoints"`
+	TeamPoints                     int     `json:"teamPoints"`
+	MatchupAssists                 int     `json:"matchupAssists"`
+	MatchupPotentialAssists        int     `json:"matchupPotentialAssists"`
+	MatchupTurnovers               int     `json:"matchupTurnovers"`
+	MatchupBlocks                  int     `json:"matchupBlocks"`
+	MatchupFieldGoalsMade          int     `json:"matchupFieldGoalsMade"`
+	MatchupFieldGoalsAttempted     int     `json:"matchupFieldGoalsAttempted"`
+	MatchupFieldGoalsPercentage    float64 `json:"matchupFieldGoalsPercentage"`
+	MatchupThreePointersMade       int     `json:"matchupThreePointersMade"`
+	MatchupThreePointersAttempted  int     `json:"matchupThreePointersAttempted"`
+	MatchupThreePointersPercentage float64 `json:"matchupThreePointersPercentage"`
+	HelpBlocks                     int     `json:"helpBlocks"`
+	HelpFieldGoalsMade             int     `json:"helpFieldGoalsMade"`
+	HelpFieldGoalsAttempted        int     `json:"helpFieldGoalsAttempted"`
+	HelpFieldGoalsPercentage       float64 `json:"helpFieldGoalsPercentage"`
+	MatchupFreeThrowsMade          int     `json:"matchupFreeThrowsMade"`
+	MatchupFreeThrowsAttempted     int     `json:"matchupFreeThrowsAttempted"`
+	ShootingFouls                  int     `json:"shootingFouls"`
+}
+
+// Matchup holds the statistics of a player against one defender.
+type Matchup struct {
+	PersonID   int               `json:"personId"`
+	FirstName  string            `json:"firstName"`
+	FamilyName string            `json:"familyName"`
+	NameI      string            `json:"nameI"`
+	PlayerSlug string            `json:"playerSlug"`
+	JerseyNum  string            `json:"jerseyNum"`
+	Statistics MatchupStatistics `json:"statistics"`
+}
+
+// PlayerMatchupsV3 holds a player's matchups against the defenders who
+// guarded them.
+type PlayerMatchupsV3 struct {
+	PlayerInfoV3
+	Matchups []Matchup `json:"matchups"`
+}
+
+// TeamMatchupsV3 holds the matchups of a team's players.
+type TeamMatchupsV3 struct {
+	TeamInfoV3
+	Players []PlayerMatchupsV3 `json:"players"`
+}
+
+// BoxScoreMatchupsV3 holds the matchups of a game.
+type BoxScoreMatchupsV3 struct {
+	GameID     string         `json:"gameId"`
+	AwayTeamID int            `json:"awayTeamId"`
+	HomeTeamID int            `json:"homeTeamId"`
+	HomeTeam   TeamMatchupsV3 `json:"homeTeam"`
+	AwayTeam   TeamMatchupsV3 `json:"awayTeam"`
+}
+
+// BoxScoreMatchupsV3Response is the response of the boxscorematchupsv3 endpoint.
+type BoxScoreMatchupsV3Response struct {
+	Meta             Meta               `json:"meta"`
+	BoxScoreMatchups BoxScoreMatchupsV3 `json:"boxScoreMatchups"`
+}
+
+// StatsResponse converts the matchups to the PlayerStats result set, with
+// one row per offensive player and defender. The columns of the offensive
+// player end in "Off" and those of the defender in "Def".
+func (r *BoxScoreMatchupsV3Response) StatsResponse() *StatsResponse {
+	type defender struct {
+		PersonID   int    `json:"personIdDef"`
+		FirstName  string `json:"firstNameDef"`
+		FamilyName string `json:"familyNameDef"`
+		NameI      string `json:"nameIDef"`
+		PlayerSlug string `json:"playerSlugDef"`
+		JerseyNum  string `json:"jerseyNumDef"`
+	}
+
+	b := r.BoxScoreMatchups
+	offense := columns(PlayerInfoV3{})
+	for i, name := range offense {
+		offense[i] = name + "Off"
+	}
+	headers := columns(gameKey{}, TeamInfoV3{})
+	headers = append(headers, offense...)
+	headers = append(headers, columns(defender{}, MatchupStatistics{})...)
+
+	rs := ResultSet{Name: "PlayerStats", Headers: headers}
+	for _, team := range []TeamMatchupsV3{b.HomeTeam, b.AwayTeam} {
+		for _, player := range team.Players {
+			for _, m := range player.Matchups {
+				def := defender{m.PersonID, m.FirstName, m.FamilyName, m.NameI, m.PlayerSlug, m.JerseyNum}
+				rs.RowSet = append(rs.RowSet, row(gameKey{b.GameID}, team.TeamInfoV3, player.PlayerInfoV3, def, m.Statistics))
+			}
+		}
+	}
+
+	return &StatsResponse{
+		Resource:   "boxscorematchupsv3",
+		ResultSets: []ResultSet{rs},
+	}
+}
+
 // BoxScoreMatchupsV3Params holds parameters for the BoxScoreMatchupsV3 endpoint.
 type BoxScoreMatchupsV3Params struct {
 	GameId string
 }
 
-// GetBoxScoreMatchupsV3 fetches data from the boxscorematchupsv3 endpoint.
+// GetBoxScoreMatchupsV3 fetches data from the boxscorematchupsv3 endpoint and
+// converts it to result sets. Use GetBoxScoreMatchupsV3Typed for the nested
+// matchups.
 func (c *Client) GetBoxScoreMatchupsV3(ctx context.Context, params BoxScoreMatchupsV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreMatchupsV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreMatchupsV3Typed fetches the nested matchups from the
+// boxscorematchupsv3 endpoint.
+func (c *Client) GetBoxScoreMatchupsV3Typed(ctx context.Context, params BoxScoreMatchupsV3Params) (*BoxScoreMatchupsV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscorematchupsv3")
 
 	reqParams := map[string]string{
 		"GameID": params.GameId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscorematchupsv3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreMatchupsV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscorematchupsv3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscorematchupsv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscorematchupsv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscorematchupsv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscorematchupsv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscorematchupsv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", len(boxScoreResp.BoxScoreMatchups.HomeTeam.Players)+len(boxScoreResp.BoxScoreMatchups.AwayTeam.Players)))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
@@ -15,15 +15,29 @@
 
 func TestGetBoxScoreMatchupsV3(t *testing.T) {
 	mockResponse := `{
-		"resource": "boxscorematchupsv3",
-		"parameters": {},
-		"resultSets": [
-{
-				"name": "PlayerStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+		"meta": {"version": 1, "request": "http://nba.cloud/games/0022300061/boxscorematchupsv3", "time": "2023-10-25 01:12:34.5634"},
+		"boxScoreMatchups": {
+			"gameId": "0022300061",
+			"awayTeamId": 1610612747,
+			"homeTeamId": 1610612743,
+			"homeTeam": {
+				"teamId": 1610612743, "teamCity": "Denver", "teamName": "Nuggets", "teamTricode": "DEN", "teamSlug": "nuggets",
+				"players": [{
+					"personId": 203999, "firstName": "Nikola", "familyName": "Jokic", "nameI": "N. Jokic",
+					"playerSlug": "nikola-jokic", "position": "C", "comment": "", "jerseyNum": "15",
+					"matchups": [
+						{"personId": 203076, "firstName": "Anthony", "familyName": "Davis", "nameI": "A. Davis", "playerSlug": "anthony-davis", "jerseyNum": "3",
+						 "statistics": {"matchupMinutes": "14:05", "matchupMinutesSort": 14.08, "partialPossessions": 28.4, "playerPoints": 12, "matchupFieldGoalsMade": 5, "matchupFieldGoalsAttempted": 8}},
+						{"personId": 2544, "firstName": "LeBron", "familyName": "James", "nameI": "L. James", "playerSlug": "lebron-james", "jerseyNum": "23",
+						 "statistics": {"matchupMinutes": "3:10", "matchupMinutesSort": 3.17, "partialPossessions": 6.1, "playerPoints": 2}}
+					]
+				}]
+			},
+			"awayTeam": {
+				"teamId": 1610612747, "teamCity": "Los Angeles", "teamName": "Lakers", "teamTricode": "LAL", "teamSlug": "lakers",
+				"players": []
 			}
-		]
+		}
 	}`
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -42,11 +56,31 @@ func TestGetBoxScoreMatchupsV3(t *testing.T) {
 
 	params := BoxScoreMatchupsV3Params{}
 
+	resp, err := c.GetBoxScoreMatchupsV3Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, resp.BoxScoreMatchups.HomeTeam.Players, 1)
+	jokic := resp.BoxScoreMatchups.HomeTeam.Players[0]
+	assert.Equal(t, "Jokic", jokic.FamilyName)
+	require.Len(t, jokic.Matchups, 2)
+	assert.Equal(t, "anthony-davis", jokic.Matchups[0].PlayerSlug)
+	assert.Equal(t, 12, jokic.Matchups[0].Statistics.PlayerPoints)
+	assert.Equal(t, "14:05", jokic.Matchups[0].Statistics.MatchupMinutes)
+
 	response, err := c.GetBoxScoreMatchupsV3(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "boxscorematchupsv3", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+	players, err := response.GetDataSet("PlayerStats")
+	require.NoError(t, err)
+	require.Equal(t, 2, players.RowCount())
+	row, err := players.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, float64(203999), row["personIdOff"])
+	assert.Equal(t, "C", row["positionOff"])
+	assert.Equal(t, float64(203076), row["personIdDef"])
+	assert.Equal(t, "3", row["jerseyNumDef"])
+	assert.Equal(t, 28.4, row["partialPossessions"])
 }
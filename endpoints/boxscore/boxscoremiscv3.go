@@ -6,6 +6,42 @@
 	"log/slog"
 )
 
+// MiscStatistics holds the miscellaneous box score statistics of a
+// player or team.
+type MiscStatistics struct {
+	Minutes               string `json:"minutes"`
+	PointsOffTurnovers    int    `json:"pointsOffTurnovers"`
+	PointsSecondChance    int    `json:"pointsSecondChance"`
+	PointsFastBreak       int    `json:"pointsFastBreak"`
+	PointsPaint           int    `json:"pointsPaint"`
+	OppPointsOffTurnovers int    `json:"oppPointsOffTurnovers"`
+	OppPointsSecondChance int    `json:"oppPointsSecondChance"`
+	OppPointsFastBreak    int    `json:"oppPointsFastBreak"`
+	OppPointsPaint        int    `json:"oppPointsPaint"`
+	Blocks                int    `json:"blocks"`
+	BlocksAgainst         int    `json:"blocksAgainst"`
+	FoulsPersonal         int    `json:"foulsPersonal"`
+	FoulsDrawn            int    `json:"foulsDrawn"`
+}
+
+// BoxScoreMiscV3Response is the response of the boxscoremiscv3 endpoint.
+type BoxScoreMiscV3Response struct {
+	Meta         Meta                       `json:"meta"`
+	BoxScoreMisc BoxScoreV3[MiscStatistics] `json:"boxScoreMisc"`
+}
+
+// StatsResponse converts the box score to the PlayerStats and TeamStats
+// result sets.
+func (r *BoxScoreMiscV3Response) StatsResponse() *StatsResponse {
+	return &StatsResponse{
+		Resource: "boxscoremiscv3",
+		ResultSets: []ResultSet{
+			r.BoxScoreMisc.playerStats(),
+			r.BoxScoreMisc.teamStats(),
+		},
+	}
+}
+
 // BoxScoreMiscV3Params holds parameters for the BoxScoreMiscV3 endpoint.
 type BoxScoreMiscV3Params struct {
 	GameId string
@@ -16,8 +52,19 @@ type BoxScoreMiscV3Params struct {
 	StartRange string
 }
 
-// GetBoxScoreMiscV3 fetches data from the boxscoremiscv3 endpoint.
+// GetBoxScoreMiscV3 fetches data from the boxscoremiscv3 endpoint and converts
+// it to result sets. Use GetBoxScoreMiscV3Typed for the nested box score.
 func (c *Client) GetBoxScoreMiscV3(ctx context.Context, params BoxScoreMiscV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreMiscV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreMiscV3Typed fetches the nested box score from the
+// boxscoremiscv3 endpoint.
+func (c *Client) GetBoxScoreMiscV3Typed(ctx context.Context, params BoxScoreMiscV3Params) (*BoxScoreMiscV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscoremiscv3")
 
 	reqParams := map[string]string{
@@ -29,27 +76,15 @@ func (c *Client) GetBoxScoreMiscV3(ctx context.Context, params BoxScoreMiscV3Par
 		"StartRange": params.StartRange,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscoremiscv3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreMiscV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscoremiscv3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscoremiscv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscoremiscv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscoremiscv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscoremiscv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscoremiscv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", boxScoreResp.BoxScoreMisc.playerCount()))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
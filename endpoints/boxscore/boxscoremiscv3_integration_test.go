@@ -9,6 +9,7 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/vcr"
 )
 
 func TestGetBoxScoreMiscV3_Integration(t *testing.T) {
@@ -24,6 +25,7 @@ func TestGetBoxScoreMiscV3_Integration(t *testing.T) {
 	response, err := client.GetBoxScoreMiscV3(ctx, params)
 
 	if err != nil {
+		require.NotErrorIs(t, err, vcr.ErrFixtureNotFound, "no replay fixture, run make test-record or make test-seed")
 		t.Logf("BoxScoreMiscV3 endpoint error: %v", err)
 		t.Skip("Endpoint may be unavailable or parameters incorrect")
 		return
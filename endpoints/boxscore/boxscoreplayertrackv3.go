@@ -6,40 +6,83 @@
 	"log/slog"
 )
 
+// PlayerTrackStatistics holds the player tracking box score statistics of a
+// player or team.
+type PlayerTrackStatistics struct {
+	Minutes                          string  `json:"minutes"`
+	Speed                            float64 `json:"speed"`
+	Distance                         float64 `json:"distance"`
+	ReboundChancesOffensive          int     `json:"reboundChancesOffensive"`
+	ReboundChancesDefensive          int     `json:"reboundChancesDefensive"`
+	ReboundChancesTotal              int     `json:"reboundChancesTotal"`
+	Touches                          int     `json:"touches"`
+	SecondaryAssists                 int     `json:"secondaryAssists"`
+	FreeThrowAssists                 int     `json:"freeThrowAssists"`
+	Passes                           int     `json:"passes"`
+	Assists                          int     `json:"assists"`
+	ContestedFieldGoalsMade          int     `json:"contestedFieldGoalsMade"`
+	ContestedFieldGoalsAttempted     int     `json:"contestedFieldGoalsAttempted"`
+	ContestedFieldGoalPercentage     float64 `json:"contestedFieldGoalPercentage"`
+	UncontestedFieldGoalsMade        int     `json:"uncontestedFieldGoalsMade"`
+	UncontestedFieldGoalsAttempted   int     `json:"uncontestedFieldGoalsAttempted"`
+	UncontestedFieldGoalsPercentage  float64 `json:"uncontestedFieldGoalsPercentage"`
+	FieldGoalPercentage              float64 `json:"fieldGoalPercentage"`
+	DefendedAtRimFieldGoalsMade      int     `json:"defendedAtRimFieldGoalsMade"`
+	DefendedAtRimFieldGoalsAttempted int     `json:"defendedAtRimFieldGoalsAttempted"`
+	DefendedAtRimFieldGoalPercentage float64 `json:"defendedAtRimFieldGoalPercentage"`
+}
+
+// BoxScorePlayerTrackV3Response is the response of the boxscoreplayertrackv3 endpoint.
+type BoxScorePlayerTrackV3Response struct {
+	Meta                Meta                              `json:"meta"`
+	BoxScorePlayerTrack BoxScoreV3[PlayerTrackStatistics] `json:"boxScorePlayerTrack"`
+}
+
+// StatsResponse converts the box score to the PlayerStats and TeamStats
+// result sets.
+func (r *BoxScorePlayerTrackV3Response) StatsResponse() *StatsResponse {
+	return &StatsResponse{
+		Resource: "boxscoreplayertrackv3",
+		ResultSets: []ResultSet{
+			r.BoxScorePlayerTrack.playerStats(),
+			r.BoxScorePlayerTrack.teamStats(),
+		},
+	}
+}
+
 // BoxScorePlayerTrackV3Params holds parameters for the BoxScorePlayerTrackV3 endpoint.
 type BoxScorePlayerTrackV3Params struct {
 	GameId string
 }
 
-// GetBoxScorePlayerTrackV3 fetches data from the boxscoreplayertrackv3 endpoint.
+// GetBoxScorePlayerTrackV3 fetches data from the boxscoreplayertrackv3 endpoint and converts
+// it to result sets. Use GetBoxScorePlayerTrackV3Typed for the nested box score.
 func (c *Client) GetBoxScorePlayerTrackV3(ctx context.Context, params BoxScorePlayerTrackV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScorePlayerTrackV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScorePlayerTrackV3Typed fetches the nested box score from the
+// boxscoreplayertrackv3 endpoint.
+func (c *Client) GetBoxScorePlayerTrackV3Typed(ctx context.Context, params BoxScorePlayerTrackV3Params) (*BoxScorePlayerTrackV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscoreplayertrackv3")
 
 	reqParams := map[string]string{
 		"GameID": params.GameId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscoreplayertrackv3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScorePlayerTrackV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscoreplayertrackv3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscoreplayertrackv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscoreplayertrackv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscoreplayertrackv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscoreplayertrackv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscoreplayertrackv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", boxScoreResp.BoxScorePlayerTrack.playerCount()))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
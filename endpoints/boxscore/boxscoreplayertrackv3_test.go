@@ -15,20 +15,30 @@
 
 func TestGetBoxScorePlayerTrackV3(t *testing.T) {
 	mockResponse := `{
-		"resource": "boxscoreplayertrackv3",
-		"parameters": {},
-		"resultSets": [
-{
-				"name": "PlayerStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+		"meta": {"version": 1, "request": "http://nba.cloud/games/0022300061/boxscoreplayertrackv3", "time": "2023-10-25 01:12:34.5634"},
+		"boxScorePlayerTrack": {
+			"gameId": "0022300061",
+			"awayTeamId": 1610612747,
+			"homeTeamId": 1610612743,
+			"homeTeam": {
+				"teamId": 1610612743, "teamCity": "Denver", "teamName": "Nuggets", "teamTricode": "DEN", "teamSlug": "nuggets",
+				"players": [{
+					"personId": 203999, "firstName": "Nikola", "familyName": "Jokic", "nameI": "N. Jokic",
+					"playerSlug": "nikola-jokic", "position": "C", "comment": "", "jerseyNum": "15",
+					"statistics": {"minutes": "34:12", "distance": 2.41, "touches": 64}
+				}],
+				"statistics": {"minutes": "240:00", "distance": 2.41, "touches": 64}
 			},
-{
-				"name": "TeamStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+			"awayTeam": {
+				"teamId": 1610612747, "teamCity": "Los Angeles", "teamName": "Lakers", "teamTricode": "LAL", "teamSlug": "lakers",
+				"players": [{
+					"personId": 2544, "firstName": "LeBron", "familyName": "James", "nameI": "L. James",
+					"playerSlug": "lebron-james", "position": "F", "comment": "", "jerseyNum": "23",
+					"statistics": {"minutes": "34:12", "distance": 2.41, "touches": 64}
+				}],
+				"statistics": {"minutes": "240:00", "distance": 2.41, "touches": 64}
 			}
-		]
+		}
 	}`
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -47,11 +57,35 @@ func TestGetBoxScorePlayerTrackV3(t *testing.T) {
 
 	params := BoxScorePlayerTrackV3Params{}
 
+	resp, err := c.GetBoxScorePlayerTrackV3Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	box := resp.BoxScorePlayerTrack
+	assert.Equal(t, "0022300061", box.GameID)
+	assert.Equal(t, "DEN", box.HomeTeam.TeamTricode)
+	require.Len(t, box.AwayTeam.Players, 1)
+	assert.Equal(t, "lebron-james", box.AwayTeam.Players[0].PlayerSlug)
+	assert.Equal(t, 64, box.AwayTeam.Players[0].Statistics.Touches)
+	assert.Equal(t, "240:00", box.HomeTeam.Statistics.Minutes)
+
 	response, err := c.GetBoxScorePlayerTrackV3(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "boxscoreplayertrackv3", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+	var names []string
+	for _, rs := range response.ResultSets {
+		names = append(names, rs.Name)
+	}
+	assert.Equal(t, []string{"PlayerStats", "TeamStats"}, names)
+
+	players, err := response.GetDataSet("PlayerStats")
+	require.NoError(t, err)
+	require.Equal(t, 2, players.RowCount())
+	row, err := players.GetRow(1)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2544), row["personId"])
+	assert.Equal(t, "Lakers", row["teamName"])
+	assert.Equal(t, float64(64), row["touches"])
 }
@@ -6,6 +6,45 @@
 	"log/slog"
 )
 
+// ScoringStatistics holds the scoring box score statistics of a
+// player or team.
+type ScoringStatistics struct {
+	Minutes                          string  `json:"minutes"`
+	PercentageFieldGoalsAttempted2Pt float64 `json:"percentageFieldGoalsAttempted2pt"`
+	PercentageFieldGoalsAttempted3Pt float64 `json:"percentageFieldGoalsAttempted3pt"`
+	PercentagePoints2Pt              float64 `json:"percentagePoints2pt"`
+	PercentagePointsMidrange2Pt      float64 `json:"percentagePointsMidrange2pt"`
+	PercentagePoints3Pt              float64 `json:"percentagePoints3pt"`
+	PercentagePointsFastBreak        float64 `json:"percentagePointsFastBreak"`
+	PercentagePointsFreeThrow        float64 `json:"percentagePointsFreeThrow"`
+	PercentagePointsOffTurnovers     float64 `json:"percentagePointsOffTurnovers"`
+	PercentagePointsPaint            float64 `json:"percentagePointsPaint"`
+	PercentageAssisted2Pt            float64 `json:"percentageAssisted2pt"`
+	PercentageUnassisted2Pt          float64 `json:"percentageUnassisted2pt"`
+	PercentageAssisted3Pt            float64 `json:"percentageAssisted3pt"`
+	PercentageUnassisted3Pt          float64 `json:"percentageUnassisted3pt"`
+	PercentageAssistedFGM            float64 `json:"percentageAssistedFGM"`
+	PercentageUnassistedFGM          float64 `json:"percentageUnassistedFGM"`
+}
+
+// BoxScoreScoringV3Response is the response of the boxscorescoringv3 endpoint.
+type BoxScoreScoringV3Response struct {
+	Meta            Meta                          `json:"meta"`
+	BoxScoreScoring BoxScoreV3[ScoringStatistics] `json:"boxScoreScoring"`
+}
+
+// StatsResponse converts the box score to the PlayerStats and TeamStats
+// result sets.
+func (r *BoxScoreScoringV3Response) StatsResponse() *StatsResponse {
+	return &StatsResponse{
+		Resource: "boxscorescoringv3",
+		ResultSets: []ResultSet{
+			r.BoxScoreScoring.playerStats(),
+			r.BoxScoreScoring.teamStats(),
+		},
+	}
+}
+
 // BoxScoreScoringV3Params holds parameters for the BoxScoreScoringV3 endpoint.
 type BoxScoreScoringV3Params struct {
 	GameId string
@@ -16,8 +55,19 @@ type BoxScoreScoringV3Params struct {
 	StartRange string
 }
 
-// GetBoxScoreScoringV3 fetches data from the boxscorescoringv3 endpoint.
+// GetBoxScoreScoringV3 fetches data from the boxscorescoringv3 endpoint and converts
+// it to result sets. Use GetBoxScoreScoringV3Typed for the nested box score.
 func (c *Client) GetBoxScoreScoringV3(ctx context.Context, params BoxScoreScoringV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreScoringV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreScoringV3Typed fetches the nested box score from the
+// boxscorescoringv3 endpoint.
+func (c *Client) GetBoxScoreScoringV3Typed(ctx context.Context, params BoxScoreScoringV3Params) (*BoxScoreScoringV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscorescoringv3")
 
 	reqParams := map[string]string{
@@ -29,27 +79,15 @@ func (c *Client) GetBoxScoreScoringV3(ctx context.Context, params BoxScoreScorin
 		"StartRange": params.StartRange,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscorescoringv3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreScoringV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscorescoringv3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscorescoringv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscorescoringv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscorescoringv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscorescoringv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscorescoringv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", boxScoreResp.BoxScoreScoring.playerCount()))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
@@ -4,42 +4,163 @@
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 )
 
+// ArenaV3 describes the arena a game is played in.
+type ArenaV3 struct {
+	ArenaID            int    `json:"arenaId"`
+	ArenaName          string `json:"arenaName"`
+	ArenaCity          string `json:"arenaCity"`
+	ArenaState         string `json:"arenaState"`
+	ArenaCountry       string `json:"arenaCountry"`
+	ArenaTimezone      string `json:"arenaTimezone"`
+	ArenaStreetAddress string `json:"arenaStreetAddress"`
+	ArenaPostalCode    string `json:"arenaPostalCode"`
+}
+
+// OfficialV3 is a referee of a game.
+type OfficialV3 struct {
+	PersonID   int    `json:"personId"`
+	Name       string `json:"name"`
+	NameI      string `json:"nameI"`
+	FirstName  string `json:"firstName"`
+	FamilyName string `json:"familyName"`
+	JerseyNum  string `json:"jerseyNum"`
+	Assignment string `json:"assignment"`
+}
+
+// PeriodScoreV3 is a team's score in one period.
+type PeriodScoreV3 struct {
+	Period     int    `json:"period"`
+	PeriodType string `json:"periodType"`
+	Score      int    `json:"score"`
+}
+
+// TeamSummaryV3 holds a team's record and score by period.
+type TeamSummaryV3 struct {
+	TeamInfoV3
+	TeamWins          int             `json:"teamWins"`
+	TeamLosses        int             `json:"teamLosses"`
+	Score             int             `json:"score"`
+	InBonus           string          `json:"inBonus"`
+	TimeoutsRemaining int             `json:"timeoutsRemaining"`
+	Periods           []PeriodScoreV3 `json:"periods"`
+}
+
+// BoxScoreSummaryV3 holds the summary of a game. GameTimeUTC and GameEt are
+// ISO 8601 timestamps, and Duration is formatted as "H:MM".
+type BoxScoreSummaryV3 struct {
+	GameID           string        `json:"gameId"`
+	GameCode         string        `json:"gameCode"`
+	GameStatus       int           `json:"gameStatus"`
+	GameStatusText   string        `json:"gameStatusText"`
+	Period           int           `json:"period"`
+	GameClock        string        `json:"gameClock"`
+	GameTimeUTC      string        `json:"gameTimeUTC"`
+	GameEt           string        `json:"gameEt"`
+	AwayTeamID       int           `json:"awayTeamId"`
+	HomeTeamID       int           `json:"homeTeamId"`
+	Duration         string        `json:"duration"`
+	Attendance       int           `json:"attendance"`
+	Sellout          int           `json:"sellout"`
+	SeriesGameNumber string        `json:"seriesGameNumber"`
+	GameLabel        string        `json:"gameLabel"`
+	GameSubLabel     string        `json:"gameSubLabel"`
+	SeriesText       string        `json:"seriesText"`
+	IfNecessary      bool          `json:"ifNecessary"`
+	IsNeutral        bool          `json:"isNeutral"`
+	Arena            ArenaV3       `json:"arena"`
+	Officials        []OfficialV3  `json:"officials"`
+	HomeTeam         TeamSummaryV3 `json:"homeTeam"`
+	AwayTeam         TeamSummaryV3 `json:"awayTeam"`
+}
+
+// BoxScoreSummaryV3Response is the response of the boxscoresummaryv3 endpoint.
+type BoxScoreSummaryV3Response struct {
+	Meta            Meta              `json:"meta"`
+	BoxScoreSummary BoxScoreSummaryV3 `json:"boxScoreSummary"`
+}
+
+// StatsResponse converts the summary to the GameSummary, ArenaInfo,
+// Officials and LineScore result sets. LineScore has a periodNScore column
+// for every period played, overtimes included.
+func (r *BoxScoreSummaryV3Response) StatsResponse() *StatsResponse {
+	s := r.BoxScoreSummary
+	key := gameKey{s.GameID}
+
+	summary := ResultSet{Name: "GameSummary", Headers: columns(s), RowSet: [][]interface{}{row(s)}}
+	arena := ResultSet{Name: "ArenaInfo", Headers: columns(key, s.Arena), RowSet: [][]interface{}{row(key, s.Arena)}}
+
+	officials := ResultSet{Name: "Officials", Headers: columns(key, OfficialV3{})}
+	for _, official := range s.Officials {
+		officials.RowSet = append(officials.RowSet, row(key, official))
+	}
+
+	teams := []TeamSummaryV3{s.HomeTeam, s.AwayTeam}
+	periods := 0
+	for _, team := range teams {
+		periods = max(periods, len(team.Periods))
+	}
+	lineScore := ResultSet{Name: "LineScore", Headers: columns(key, TeamInfoV3{})}
+	lineScore.Headers = append(lineScore.Headers, "teamWins", "teamLosses")
+	for i := 1; i <= periods; i++ {
+		lineScore.Headers = append(lineScore.Headers, "period"+strconv.Itoa(i)+"Score")
+	}
+	lineScore.Headers = append(lineScore.Headers, "score")
+	for _, team := range teams {
+		values := row(key, team.TeamInfoV3)
+		values = append(values, float64(team.TeamWins), float64(team.TeamLosses))
+		for i := 0; i < periods; i++ {
+			if i < len(team.Periods) {
+				values = append(values, float64(team.Periods[i].Score))
+			} else {
+				values = append(values, nil)
+			}
+		}
+		lineScore.RowSet = append(lineScore.RowSet, append(values, float64(team.Score)))
+	}
+
+	return &StatsResponse{
+		Resource:   "boxscoresummaryv3",
+		ResultSets: []ResultSet{summary, arena, officials, lineScore},
+	}
+}
+
 // BoxScoreSummaryV3Params holds parameters for the BoxScoreSummaryV3 endpoint.
 type BoxScoreSummaryV3Params struct {
 	GameId string
 }
 
-// GetBoxScoreSummaryV3 fetches data from the boxscoresummaryv3 endpoint.
+// GetBoxScoreSummaryV3 fetches data from the boxscoresummaryv3 endpoint and
+// converts it to result sets. Use GetBoxScoreSummaryV3Typed for the nested
+// summary.
 func (c *Client) GetBoxScoreSummaryV3(ctx context.Context, params BoxScoreSummaryV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreSummaryV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreSummaryV3Typed fetches the nested summary from the
+// boxscoresummaryv3 endpoint.
+func (c *Client) GetBoxScoreSummaryV3Typed(ctx context.Context, params BoxScoreSummaryV3Params) (*BoxScoreSummaryV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscoresummaryv3")
 
 	reqParams := map[string]string{
 		"GameID": params.GameId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscoresummaryv3", reqParams)
-	if err != nil {
+	var summaryResp BoxScoreSummaryV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscoresummaryv3", reqParams, &summaryResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscoresummaryv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscoresummaryv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscoresummaryv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscoresummaryv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscoresummaryv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.String("game_status", summaryResp.BoxScoreSummary.GameStatusText))
 
-	return &statsResp, nil
+	return &summaryResp, nil
 }
@@ -15,11 +15,29 @@
 
 func TestGetBoxScoreSummaryV3(t *testing.T) {
 	mockResponse := `{
-		"resource": "boxscoresummaryv3",
-		"parameters": {},
-		"resultSets": [
-
-		]
+		"meta": {"version": 1, "request": "http://nba.cloud/games/0022300061/boxscoresummaryv3", "time": "2023-10-25 01:12:34.5634"},
+		"boxScoreSummary": {
+			"gameId": "0022300061", "gameCode": "20231024/LALDEN", "gameStatus": 3, "gameStatusText": "Final",
+			"period": 5, "gameClock": "PT00M00.00S", "gameTimeUTC": "2023-10-24T23:30:00Z", "gameEt": "2023-10-24T19:30:00Z",
+			"awayTeamId": 1610612747, "homeTeamId": 1610612743, "duration": "2:41", "attendance": 19842, "sellout": 1,
+			"seriesGameNumber": "", "gameLabel": "", "gameSubLabel": "", "seriesText": "", "ifNecessary": false, "isNeutral": false,
+			"arena": {"arenaId": 1000193, "arenaName": "Ball Arena", "arenaCity": "Denver", "arenaState": "CO", "arenaCountry": "US", "arenaTimezone": "America/Denver"},
+			"officials": [{"personId": 1151, "name": "Scott Foster", "nameI": "S. Foster", "firstName": "Scott", "familyName": "Foster", "jerseyNum": "48", "assignment": "OFFICIAL1"}],
+			"homeTeam": {
+				"teamId": 1610612743, "teamCity": "Denver", "teamName": "Nuggets", "teamTricode": "DEN", "teamSlug": "nuggets",
+				"teamWins": 1, "teamLosses": 0, "score": 125, "inBonus": "0", "timeoutsRemaining": 2,
+				"periods": [{"period": 1, "periodType": "REGULAR", "score": 31}, {"period": 2, "periodType": "REGULAR", "score": 28},
+					{"period": 3, "periodType": "REGULAR", "score": 24}, {"period": 4, "periodType": "REGULAR", "score": 27},
+					{"period": 5, "periodType": "OVERTIME", "score": 15}]
+			},
+			"awayTeam": {
+				"teamId": 1610612747, "teamCity": "Los Angeles", "teamName": "Lakers", "teamTricode": "LAL", "teamSlug": "lakers",
+				"teamWins": 0, "teamLosses": 1, "score": 117, "inBonus": "1", "timeoutsRemaining": 0,
+				"periods": [{"period": 1, "periodType": "REGULAR", "score": 21}, {"period": 2, "periodType": "REGULAR", "score": 29},
+					{"period": 3, "periodType": "REGULAR", "score": 30}, {"period": 4, "periodType": "REGULAR", "score": 30},
+					{"period": 5, "periodType": "OVERTIME", "score": 7}]
+			}
+		}
 	}`
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -38,11 +56,44 @@ func TestGetBoxScoreSummaryV3(t *testing.T) {
 
 	params := BoxScoreSummaryV3Params{}
 
+	resp, err := c.GetBoxScoreSummaryV3Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	summary := resp.BoxScoreSummary
+	assert.Equal(t, "Final", summary.GameStatusText)
+	assert.Equal(t, 19842, summary.Attendance)
+	assert.Equal(t, "Ball Arena", summary.Arena.ArenaName)
+	require.Len(t, summary.Officials, 1)
+	assert.Equal(t, "Scott Foster", summary.Officials[0].Name)
+	assert.Equal(t, 125, summary.HomeTeam.Score)
+	require.Len(t, summary.AwayTeam.Periods, 5)
+	assert.Equal(t, "OVERTIME", summary.AwayTeam.Periods[4].PeriodType)
+
 	response, err := c.GetBoxScoreSummaryV3(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "boxscoresummaryv3", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+	var names []string
+	for _, rs := range response.ResultSets {
+		names = append(names, rs.Name)
+	}
+	assert.Equal(t, []string{"GameSummary", "ArenaInfo", "Officials", "LineScore"}, names)
+
+	game, err := response.GetDataSet("GameSummary")
+	require.NoError(t, err)
+	row, err := game.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, "0022300061", row["gameId"])
+	assert.Equal(t, "2:41", row["duration"])
+
+	lineScore, err := response.GetDataSet("LineScore")
+	require.NoError(t, err)
+	require.Equal(t, 2, lineScore.RowCount())
+	row, err = lineScore.GetRow(1)
+	require.NoError(t, err)
+	assert.Equal(t, "LAL", row["teamTricode"])
+	assert.Equal(t, float64(7), row["period5Score"])
+	assert.Equal(t, float64(117), row["score"])
 }
@@ -6,6 +6,51 @@
 	"log/slog"
 )
 
+// TraditionalStatistics holds the traditional box score statistics of a
+// player or team. Minutes is formatted as "MM:SS".
+type TraditionalStatistics struct {
+	Minutes                 string  `json:"minutes"`
+	FieldGoalsMade          int     `json:"fieldGoalsMade"`
+	FieldGoalsAttempted     int     `json:"fieldGoalsAttempted"`
+	FieldGoalsPercentage    float64 `json:"fieldGoalsPercentage"`
+	ThreePointersMade       int     `json:"threePointersMade"`
+	ThreePointersAttempted  int     `json:"threePointersAttempted"`
+	ThreePointersPercentage float64 `json:"threePointersPercentage"`
+	FreeThrowsMade          int     `json:"freeThrowsMade"`
+	FreeThrowsAttempted     int     `json:"freeThrowsAttempted"`
+	FreeThrowsPercentage    float64 `json:"freeThrowsPercentage"`
+	ReboundsOffensive       int     `json:"reboundsOffensive"`
+	ReboundsDefensive       int     `json:"reboundsDefensive"`
+	ReboundsTotal           int     `json:"reboundsTotal"`
+	Assists                 int     `json:"assists"`
+	Steals                  int     `json:"steals"`
+	Blocks                  int     `json:"blocks"`
+	Turnovers               int     `json:"turnovers"`
+	FoulsPersonal           int     `json:"foulsPersonal"`
+	Points                  int     `json:"points"`
+	PlusMinusPoints         float64 `json:"plusMinusPoints"`
+}
+
+// BoxScoreTraditionalV3Response is the response of the boxscoretraditionalv3
+// endpoint.
+type BoxScoreTraditionalV3Response struct {
+	Meta                Meta                              `json:"meta"`
+	BoxScoreTraditional BoxScoreV3[TraditionalStatistics] `json:"boxScoreTraditional"`
+}
+
+// StatsResponse converts the box score to the PlayerStats,
+// TeamStarterBenchStats and TeamStats result sets.
+func (r *BoxScoreTraditionalV3Response) StatsResponse() *StatsResponse {
+	return &StatsResponse{
+		Resource: "boxscoretraditionalv3",
+		ResultSets: []ResultSet{
+			r.BoxScoreTraditional.playerStats(),
+			r.BoxScoreTraditional.starterBenchStats(),
+			r.BoxScoreTraditional.teamStats(),
+		},
+	}
+}
+
 // BoxScoreTraditionalV3Params holds parameters for the BoxScoreTraditionalV3 endpoint.
 type BoxScoreTraditionalV3Params struct {
 	GameId string
@@ -16,8 +61,20 @@ type BoxScoreTraditionalV3Params struct {
 	StartRange string
 }
 
-// GetBoxScoreTraditionalV3 fetches data from the boxscoretraditionalv3 endpoint.
+// GetBoxScoreTraditionalV3 fetches data from the boxscoretraditionalv3 endpoint
+// and converts it to result sets. Use GetBoxScoreTraditionalV3Typed for the
+// nested box score.
 func (c *Client) GetBoxScoreTraditionalV3(ctx context.Context, params BoxScoreTraditionalV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreTraditionalV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreTraditionalV3Typed fetches the nested box score from the
+// boxscoretraditionalv3 endpoint.
+func (c *Client) GetBoxScoreTraditionalV3Typed(ctx context.Context, params BoxScoreTraditionalV3Params) (*BoxScoreTraditionalV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscoretraditionalv3")
 
 	reqParams := map[string]string{
@@ -41,27 +98,15 @@ func (c *Client) GetBoxScoreTraditionalV3(ctx context.Context, params BoxScoreTr
 		reqParams["StartRange"] = params.StartRange
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscoretraditionalv3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreTraditionalV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscoretraditionalv3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscoretraditionalv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscoretraditionalv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscoretraditionalv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscoretraditionalv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscoretraditionalv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", boxScoreResp.BoxScoreTraditional.playerCount()))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
@@ -15,25 +15,34 @@
 
 func TestGetBoxScoreTraditionalV3(t *testing.T) {
 	mockResponse := `{
-		"resource": "boxscoretraditionalv3",
-		"parameters": {},
-		"resultSets": [
-{
-				"name": "PlayerStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+		"meta": {"version": 1, "request": "http://nba.cloud/games/0022300061/boxscoretraditionalv3", "time": "2023-10-25 01:12:34.5634"},
+		"boxScoreTraditional": {
+			"gameId": "0022300061",
+			"awayTeamId": 1610612747,
+			"homeTeamId": 1610612743,
+			"homeTeam": {
+				"teamId": 1610612743, "teamCity": "Denver", "teamName": "Nuggets", "teamTricode": "DEN", "teamSlug": "nuggets",
+				"players": [{
+					"personId": 203999, "firstName": "Nikola", "familyName": "Jokic", "nameI": "N. Jokic",
+					"playerSlug": "nikola-jokic", "position": "C", "comment": "", "jerseyNum": "15",
+					"statistics": {"minutes": "34:12", "points": 21, "reboundsTotal": 8, "assists": 5, "fieldGoalsPercentage": 0.5}
+				}],
+				"statistics": {"minutes": "240:00", "points": 21, "reboundsTotal": 8, "assists": 5, "fieldGoalsPercentage": 0.5},
+				"starters": {"minutes": "160:30", "points": 80},
+				"bench": {"minutes": "79:30", "points": 39}
 			},
-{
-				"name": "TeamStarterBenchStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
-			},
-{
-				"name": "TeamStats",
-				"headers": ["gameId", "teamId", "teamCity", "teamName", "teamTricode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+			"awayTeam": {
+				"teamId": 1610612747, "teamCity": "Los Angeles", "teamName": "Lakers", "teamTricode": "LAL", "teamSlug": "lakers",
+				"players": [{
+					"personId": 2544, "firstName": "LeBron", "familyName": "James", "nameI": "L. James",
+					"playerSlug": "lebron-james", "position": "F", "comment": "", "jerseyNum": "23",
+					"statistics": {"minutes": "34:12", "points": 21, "reboundsTotal": 8, "assists": 5, "fieldGoalsPercentage": 0.5}
+				}],
+				"statistics": {"minutes": "240:00", "points": 21, "reboundsTotal": 8, "assists": 5, "fieldGoalsPercentage": 0.5},
+				"starters": {"minutes": "160:30", "points": 80},
+				"bench": {"minutes": "79:30", "points": 39}
 			}
-		]
+		}
 	}`
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -52,11 +61,50 @@ func TestGetBoxScoreTraditionalV3(t *testing.T) {
 
 	params := BoxScoreTraditionalV3Params{}
 
+	resp, err := c.GetBoxScoreTraditionalV3Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	box := resp.BoxScoreTraditional
+	assert.Equal(t, "0022300061", box.GameID)
+	assert.Equal(t, "DEN", box.HomeTeam.TeamTricode)
+	require.Len(t, box.AwayTeam.Players, 1)
+	assert.Equal(t, "lebron-james", box.AwayTeam.Players[0].PlayerSlug)
+	assert.Equal(t, 21, box.AwayTeam.Players[0].Statistics.Points)
+	assert.Equal(t, "240:00", box.HomeTeam.Statistics.Minutes)
+	require.NotNil(t, box.HomeTeam.Starters)
+	assert.Equal(t, 80, box.HomeTeam.Starters.Points)
+
 	response, err := c.GetBoxScoreTraditionalV3(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "boxscoretraditionalv3", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+	var names []string
+	for _, rs := range response.ResultSets {
+		names = append(names, rs.Name)
+	}
+	assert.Equal(t, []string{"PlayerStats", "TeamStarterBenchStats", "TeamStats"}, names)
+
+	players, err := response.GetDataSet("PlayerStats")
+	require.NoError(t, err)
+	require.Equal(t, 2, players.RowCount())
+	row, err := players.GetRow(1)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2544), row["personId"])
+	assert.Equal(t, "Lakers", row["teamName"])
+	assert.Equal(t, float64(21), row["points"])
+
+	var units []struct {
+		TeamTricode   string
+		StartersBench string
+		Points        int
+	}
+	require.NoError(t, response.Decode("TeamStarterBenchStats", &units))
+	require.Len(t, units, 4)
+	assert.Equal(t, "DEN", units[0].TeamTricode)
+	assert.Equal(t, "Starters", units[0].StartersBench)
+	assert.Equal(t, 80, units[0].Points)
+	assert.Equal(t, "Bench", units[1].StartersBench)
+	assert.Equal(t, 39, units[1].Points)
 }
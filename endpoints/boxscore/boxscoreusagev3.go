@@ -6,6 +6,47 @@
 	"log/slog"
 )
 
+// UsageStatistics holds the usage box score statistics of a player or team.
+type UsageStatistics struct {
+	Minutes                          string  `json:"minutes"`
+	UsagePercentage                  float64 `json:"usagePercentage"`
+	PercentageFieldGoalsMade         float64 `json:"percentageFieldGoalsMade"`
+	PercentageFieldGoalsAttempted    float64 `json:"percentageFieldGoalsAttempted"`
+	PercentageThreePointersMade      float64 `json:"percentageThreePointersMade"`
+	PercentageThreePointersAttempted float64 `json:"percentageThreePointersAttempted"`
+	PercentageFreeThrowsMade         float64 `json:"percentageFreeThrowsMade"`
+	PercentageFreeThrowsAttempted    float64 `json:"percentageFreeThrowsAttempted"`
+	PercentageReboundsOffensive      float64 `json:"percentageReboundsOffensive"`
+	PercentageReboundsDefensive      float64 `json:"percentageReboundsDefensive"`
+	PercentageReboundsTotal          float64 `json:"percentageReboundsTotal"`
+	PercentageAssists                float64 `json:"percentageAssists"`
+	PercentageTurnovers              float64 `json:"percentageTurnovers"`
+	PercentageSteals                 float64 `json:"percentageSteals"`
+	PercentageBlocks                 float64 `json:"percentageBlocks"`
+	PercentageBlocksAllowed          float64 `json:"percentageBlocksAllowed"`
+	PercentagePersonalFouls          float64 `json:"percentagePersonalFouls"`
+	PercentagePersonalFoulsDrawn     float64 `json:"percentagePersonalFoulsDrawn"`
+	PercentagePoints                 float64 `json:"percentagePoints"`
+}
+
+// BoxScoreUsageV3Response is the response of the boxscoreusagev3 endpoint.
+type BoxScoreUsageV3Response struct {
+	Meta          Meta                        `json:"meta"`
+	BoxScoreUsage BoxScoreV3[UsageStatistics] `json:"boxScoreUsage"`
+}
+
+// StatsResponse converts the box score to the PlayerStats and TeamStats
+// result sets.
+func (r *BoxScoreUsageV3Response) StatsResponse() *StatsResponse {
+	return &StatsResponse{
+		Resource: "boxscoreusagev3",
+		ResultSets: []ResultSet{
+			r.BoxScoreUsage.playerStats(),
+			r.BoxScoreUsage.teamStats(),
+		},
+	}
+}
+
 // BoxScoreUsageV3Params holds parameters for the BoxScoreUsageV3 endpoint.
 type BoxScoreUsageV3Params struct {
 	GameId string
@@ -16,8 +57,19 @@ type BoxScoreUsageV3Params struct {
 	StartRange string
 }
 
-// GetBoxScoreUsageV3 fetches data from the boxscoreusagev3 endpoint.
+// GetBoxScoreUsageV3 fetches data from the boxscoreusagev3 endpoint and converts
+// it to result sets. Use GetBoxScoreUsageV3Typed for the nested box score.
 func (c *Client) GetBoxScoreUsageV3(ctx context.Context, params BoxScoreUsageV3Params) (*StatsResponse, error) {
+	resp, err := c.GetBoxScoreUsageV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetBoxScoreUsageV3Typed fetches the nested box score from the
+// boxscoreusagev3 endpoint.
+func (c *Client) GetBoxScoreUsageV3Typed(ctx context.Context, params BoxScoreUsageV3Params) (*BoxScoreUsageV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching boxscoreusagev3")
 
 	reqParams := map[string]string{
@@ -29,27 +81,15 @@ func (c *Client) GetBoxScoreUsageV3(ctx context.Context, params BoxScoreUsageV3P
 		"StartRange": params.StartRange,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "boxscoreusagev3", reqParams)
-	if err != nil {
+	var boxScoreResp BoxScoreUsageV3Response
+	if _, err := c.httpClient.Get(ctx, "boxscoreusagev3", reqParams, &boxScoreResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch boxscoreusagev3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch boxscoreusagev3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from boxscoreusagev3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal boxscoreusagev3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched boxscoreusagev3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("players_count", boxScoreResp.BoxScoreUsage.playerCount()))
 
-	return &statsResp, nil
+	return &boxScoreResp, nil
 }
@@ -0,0 +1,102 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package boxscore
+
+import (
+	"context"
+	"fmt"
+)
+
+// HustleStatsBoxScoreResult holds the decoded result sets of the
+// hustlestatsboxscore endpoint.
+type HustleStatsBoxScoreResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	HustleStatsAvailable []HustleStatsBoxScoreHustleStatsAvailableRow
+	PlayerStats          []HustleStatsBoxScorePlayerStatsRow
+	TeamStats            []HustleStatsBoxScoreTeamStatsRow
+}
+
+// GetHustleStatsBoxScoreTyped is like GetHustleStatsBoxScore but decodes the
+// result sets into a HustleStatsBoxScoreResult.
+func (c *Client) GetHustleStatsBoxScoreTyped(ctx context.Context, params HustleStatsBoxScoreParams) (*HustleStatsBoxScoreResult, error) {
+	resp, err := c.GetHustleStatsBoxScore(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &HustleStatsBoxScoreResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"HustleStatsAvailable": &result.HustleStatsAvailable,
+		"PlayerStats":          &result.PlayerStats,
+		"TeamStats":            &result.TeamStats,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode hustlestatsboxscore: %w", err)
+	}
+	return result, nil
+}
+
+// HustleStatsBoxScoreHustleStatsAvailableRow is a row of the
+// hustlestatsboxscore HustleStatsAvailable result set.
+type HustleStatsBoxScoreHustleStatsAvailableRow struct {
+	GameID       string `nba:"GAME_ID"`
+	HustleStatus int    `nba:"HUSTLE_STATUS"`
+}
+
+// HustleStatsBoxScorePlayerStatsRow is a row of the hustlestatsboxscore
+// PlayerStats result set.
+type HustleStatsBoxScorePlayerStatsRow struct {
+	GameID                 string  `nba:"GAME_ID"`
+	TeamID                 int     `nba:"TEAM_ID"`
+	TeamAbbreviation       string  `nba:"TEAM_ABBREVIATION"`
+	TeamCity               string  `nba:"TEAM_CITY"`
+	PlayerID               int     `nba:"PLAYER_ID"`
+	PlayerName             string  `nba:"PLAYER_NAME"`
+	StartPosition          string  `nba:"START_POSITION"`
+	Comment                string  `nba:"COMMENT"`
+	Minutes                string  `nba:"MINUTES"`
+	PTS                    float64 `nba:"PTS"`
+	ContestedShots         float64 `nba:"CONTESTED_SHOTS"`
+	ContestedShots2pt      float64 `nba:"CONTESTED_SHOTS_2PT"`
+	ContestedShots3pt      float64 `nba:"CONTESTED_SHOTS_3PT"`
+	Deflections            float64 `nba:"DEFLECTIONS"`
+	ChargesDrawn           float64 `nba:"CHARGES_DRAWN"`
+	ScreenAssists          float64 `nba:"SCREEN_ASSISTS"`
+	ScreenASTPTS           float64 `nba:"SCREEN_AST_PTS"`
+	OffLooseBallsRecovered float64 `nba:"OFF_LOOSE_BALLS_RECOVERED"`
+	DefLooseBallsRecovered float64 `nba:"DEF_LOOSE_BALLS_RECOVERED"`
+	LooseBallsRecovered    float64 `nba:"LOOSE_BALLS_RECOVERED"`
+	OffBoxouts             float64 `nba:"OFF_BOXOUTS"`
+	DefBoxouts             float64 `nba:"DEF_BOXOUTS"`
+	BoxOutPlayerTeamRebs   float64 `nba:"BOX_OUT_PLAYER_TEAM_REBS"`
+	BoxOutPlayerRebs       float64 `nba:"BOX_OUT_PLAYER_REBS"`
+	BoxOuts                float64 `nba:"BOX_OUTS"`
+}
+
+// HustleStatsBoxScoreTeamStatsRow is a row of the hustlestatsboxscore
+// TeamStats result set.
+type HustleStatsBoxScoreTeamStatsRow struct {
+	GameID                 string  `nba:"GAME_ID"`
+	TeamID                 int     `nba:"TEAM_ID"`
+	TeamName               string  `nba:"TEAM_NAME"`
+	TeamAbbreviation       string  `nba:"TEAM_ABBREVIATION"`
+	TeamCity               string  `nba:"TEAM_CITY"`
+	Minutes                string  `nba:"MINUTES"`
+	PTS                    float64 `nba:"PTS"`
+	ContestedShots         float64 `nba:"CONTESTED_SHOTS"`
+	ContestedShots2pt      float64 `nba:"CONTESTED_SHOTS_2PT"`
+	ContestedShots3pt      float64 `nba:"CONTESTED_SHOTS_3PT"`
+	Deflections            float64 `nba:"DEFLECTIONS"`
+	ChargesDrawn           float64 `nba:"CHARGES_DRAWN"`
+	ScreenAssists          float64 `nba:"SCREEN_ASSISTS"`
+	ScreenASTPTS           float64 `nba:"SCREEN_AST_PTS"`
+	OffLooseBallsRecovered float64 `nba:"OFF_LOOSE_BALLS_RECOVERED"`
+	DefLooseBallsRecovered float64 `nba:"DEF_LOOSE_BALLS_RECOVERED"`
+	LooseBallsRecovered    float64 `nba:"LOOSE_BALLS_RECOVERED"`
+	OffBoxouts             float64 `nba:"OFF_BOXOUTS"`
+	DefBoxouts             float64 `nba:"DEF_BOXOUTS"`
+	BoxOutPlayerTeamRebs   float64 `nba:"BOX_OUT_PLAYER_TEAM_REBS"`
+	BoxOutPlayerRebs       float64 `nba:"BOX_OUT_PLAYER_REBS"`
+	BoxOuts                float64 `nba:"BOX_OUTS"`
+}
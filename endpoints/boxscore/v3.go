@@ -0,0 +1,210 @@
+package boxscore
+
+import (
+	"reflect"
+	"strings"
+)
+
+// The V3 box score endpoints return nested JSON rather than result sets:
+//
+//	{"meta": {...}, "boxScoreTraditional": {"gameId": "...", "homeTeam": {
+//	  "teamId": ..., "players": [{"personId": ..., "statistics": {...}}],
+//	  "statistics": {...}}, "awayTeam": {...}}}
+//
+// The types below model that shape. BoxScoreV3 is shared by the endpoints
+// that only differ in their statistics, and StatsResponse methods on each
+// response convert it to the tabular shape of the older endpoints.
+
+// Meta holds the metadata of a V3 response.
+type Meta struct {
+	Version int    `json:"version"`
+	Request string `json:"request"`
+	Time    string `json:"time"`
+}
+
+// BoxScoreV3 is the box score of a game with statistics of type S for every
+// player and team.
+type BoxScoreV3[S any] struct {
+	GameID     string            `json:"gameId"`
+	AwayTeamID int               `json:"awayTeamId"`
+	HomeTeamID int               `json:"homeTeamId"`
+	HomeTeam   TeamBoxScoreV3[S] `json:"homeTeam"`
+	AwayTeam   TeamBoxScoreV3[S] `json:"awayTeam"`
+}
+
+// TeamInfoV3 identifies a team in a V3 response.
+type TeamInfoV3 struct {
+	TeamID      int    `json:"teamId"`
+	TeamCity    string `json:"teamCity"`
+	TeamName    string `json:"teamName"`
+	TeamTricode string `json:"teamTricode"`
+	TeamSlug    string `json:"teamSlug"`
+}
+
+// PlayerInfoV3 identifies a player in a V3 response. Comment explains why a
+// player did not play, e.g. "DNP - Coach's Decision".
+type PlayerInfoV3 struct {
+	PersonID   int    `json:"personId"`
+	FirstName  string `json:"firstName"`
+	FamilyName string `json:"familyName"`
+	NameI      string `json:"nameI"`
+	PlayerSlug string `json:"playerSlug"`
+	Position   string `json:"position"`
+	Comment    string `json:"comment"`
+	JerseyNum  string `json:"jerseyNum"`
+}
+
+// TeamBoxScoreV3 holds a team's players and totals. Starters and Bench are
+// only returned by boxscoretraditionalv3.
+type TeamBoxScoreV3[S any] struct {
+	TeamInfoV3
+	Players    []PlayerBoxScoreV3[S] `json:"players"`
+	Statistics S                     `json:"statistics"`
+	Starters   *S                    `json:"starters,omitempty"`
+	Bench      *S                    `json:"bench,omitempty"`
+}
+
+// PlayerBoxScoreV3 holds a player's statistics.
+type PlayerBoxScoreV3[S any] struct {
+	PlayerInfoV3
+	Statistics S `json:"statistics"`
+}
+
+// gameKey is the leading column of every converted result set.
+type gameKey struct {
+	GameID string `json:"gameId"`
+}
+
+// teams returns the home and away team.
+func (b *BoxScoreV3[S]) teams() []TeamBoxScoreV3[S] {
+	return []TeamBoxScoreV3[S]{b.HomeTeam, b.AwayTeam}
+}
+
+// playerCount returns the number of players of both teams.
+func (b *BoxScoreV3[S]) playerCount() int {
+	return len(b.HomeTeam.Players) + len(b.AwayTeam.Players)
+}
+
+// playerStats returns one row per player, named PlayerStats.
+func (b *BoxScoreV3[S]) playerStats() ResultSet {
+	var zero S
+	rs := ResultSet{Name: "PlayerStats", Headers: columns(gameKey{}, TeamInfoV3{}, PlayerInfoV3{}, zero)}
+	for _, team := range b.teams() {
+		for _, player := range team.Players {
+			rs.RowSet = append(rs.RowSet, row(gameKey{b.GameID}, team.TeamInfoV3, player.PlayerInfoV3, player.Statistics))
+		}
+	}
+	return rs
+}
+
+// teamStats returns one row per team, named TeamStats.
+func (b *BoxScoreV3[S]) teamStats() ResultSet {
+	var zero S
+	rs := ResultSet{Name: "TeamStats", Headers: columns(gameKey{}, TeamInfoV3{}, zero)}
+	for _, team := range b.teams() {
+		rs.RowSet = append(rs.RowSet, row(gameKey{b.GameID}, team.TeamInfoV3, team.Statistics))
+	}
+	return rs
+}
+
+// starterBenchStats returns the starters' and the bench's rows of each team,
+// named TeamStarterBenchStats.
+func (b *BoxScoreV3[S]) starterBenchStats() ResultSet {
+	type unit struct {
+		StartersBench string `json:"startersBench"`
+	}
+
+	var zero S
+	rs := ResultSet{Name: "TeamStarterBenchStats", Headers: columns(gameKey{}, TeamInfoV3{}, unit{}, zero)}
+	for _, team := range b.teams() {
+		if team.Starters != nil {
+			rs.RowSet = append(rs.RowSet, row(gameKey{b.GameID}, team.TeamInfoV3, unit{"Starters"}, *team.Starters))
+		}
+		if team.Bench != nil {
+			rs.RowSet = append(rs.RowSet, row(gameKey{b.GameID}, team.TeamInfoV3, unit{"Bench"}, *team.Bench))
+		}
+	}
+	return rs
+}
+
+// columns returns the JSON names of the scalar fields of parts, in order.
+func columns(parts ...interface{}) []string {
+	var headers []string
+	for _, part := range parts {
+		walkScalars(reflect.ValueOf(part), func(name string, _ interface{}) {
+			headers = append(headers, name)
+		})
+	}
+	return headers
+}
+
+// row returns the values of the scalar fields of parts, in the order of
+// columns. Numbers are returned as float64, like values decoded from a
+// result set.
+func row(parts ...interface{}) []interface{} {
+	var values []interface{}
+	for _, part := range parts {
+		walkScalars(reflect.ValueOf(part), func(_ string, value interface{}) {
+			values = append(values, value)
+		})
+	}
+	return values
+}
+
+// walkScalars calls fn for every string, number and bool field of the struct
+// v, descending into embedded structs. Nil pointers yield nil.
+func walkScalars(v reflect.Value, fn func(name string, value interface{})) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			walkScalars(v.Field(i), fn)
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Ptr {
+			if !isScalar(fv.Type().Elem().Kind()) {
+				continue
+			}
+			if fv.IsNil() {
+				fn(name, nil)
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if !isScalar(fv.Kind()) {
+			continue
+		}
+
+		switch fv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			fn(name, float64(fv.Int()))
+		case reflect.Float32, reflect.Float64:
+			fn(name, fv.Float())
+		case reflect.Bool:
+			fn(name, fv.Bool())
+		default:
+			fn(name, fv.String())
+		}
+	}
+}
+
+// isScalar reports whether values of kind k become a result set cell.
+func isScalar(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -25,25 +25,13 @@ func (c *Client) GetDraftCombineDrillResults(ctx context.Context, params DraftCo
 		reqParams["SeasonYear"] = params.SeasonYear
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "draftcombinedrillresults", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "draftcombinedrillresults", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch draftcombinedrillresults",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch draftcombinedrillresults: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from draftcombinedrillresults endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal draftcombinedrillresults response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched draftcombinedrillresults",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -25,25 +25,13 @@ func (c *Client) GetDraftCombineSpotShooting(ctx context.Context, params DraftCo
 		reqParams["SeasonYear"] = params.SeasonYear
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "draftcombinespotshooting", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "draftcombinespotshooting", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch draftcombinespotshooting",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch draftcombinespotshooting: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from draftcombinespotshooting endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal draftcombinespotshooting response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched draftcombinespotshooting",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -25,25 +25,13 @@ func (c *Client) GetDraftCombineStats(ctx context.Context, params DraftCombineSt
 		reqParams["SeasonYear"] = params.SeasonAllTime
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "draftcombinestats", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "draftcombinestats", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch draftcombinestats",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch draftcombinestats: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from draftcombinestats endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal draftcombinestats response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched draftcombinestats",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -49,25 +49,13 @@ func (c *Client) GetDraftHistory(ctx context.Context, params DraftHistoryParams)
 		reqParams["TopX"] = params.TopxNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "drafthistory", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "drafthistory", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch drafthistory",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch drafthistory: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from drafthistory endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal drafthistory response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched drafthistory",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -0,0 +1,25 @@
+//go:build integration
+
+package draft
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/utkonoser/nba-api-go/vcr"
+)
+
+// TestMain replays recorded fixtures from testdata/fixtures when NBA_VCR_MODE
+// is set, so the integration tests can run without network access.
+func TestMain(m *testing.M) {
+	restore, err := vcr.Setup("testdata/fixtures")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to set up vcr: %v\n", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	restore()
+	os.Exit(code)
+}
@@ -0,0 +1,366 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package draft
+
+import (
+	"context"
+	"fmt"
+)
+
+// DraftCombineDrillResultsResult holds the decoded result sets of the
+// draftcombinedrillresults endpoint.
+type DraftCombineDrillResultsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Results []DraftCombineDrillResultsResultsRow
+}
+
+// GetDraftCombineDrillResultsTyped is like GetDraftCombineDrillResults but
+// decodes the result sets into a DraftCombineDrillResultsResult.
+func (c *Client) GetDraftCombineDrillResultsTyped(ctx context.Context, params DraftCombineDrillResultsParams) (*DraftCombineDrillResultsResult, error) {
+	resp, err := c.GetDraftCombineDrillResults(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DraftCombineDrillResultsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Results": &result.Results,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode draftcombinedrillresults: %w", err)
+	}
+	return result, nil
+}
+
+// DraftCombineNonStationaryShootingResult holds the decoded result sets of
+// the draftcombinenonstationaryshooting endpoint.
+type DraftCombineNonStationaryShootingResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Results []DraftCombineNonStationaryShootingResultsRow
+}
+
+// GetDraftCombineNonStationaryShootingTyped is like
+// GetDraftCombineNonStationaryShooting but decodes the result sets into a
+// DraftCombineNonStationaryShootingResult.
+func (c *Client) GetDraftCombineNonStationaryShootingTyped(ctx context.Context, params DraftCombineNonStationaryShootingParams) (*DraftCombineNonStationaryShootingResult, error) {
+	resp, err := c.GetDraftCombineNonStationaryShooting(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DraftCombineNonStationaryShootingResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Results": &result.Results,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode draftcombinenonstationaryshooting: %w", err)
+	}
+	return result, nil
+}
+
+// DraftCombinePlayerAnthroResult holds the decoded result sets of the
+// draftcombineplayeranthro endpoint.
+type DraftCombinePlayerAnthroResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Results []DraftCombinePlayerAnthroResultsRow
+}
+
+// GetDraftCombinePlayerAnthroTyped is like GetDraftCombinePlayerAnthro but
+// decodes the result sets into a DraftCombinePlayerAnthroResult.
+func (c *Client) GetDraftCombinePlayerAnthroTyped(ctx context.Context, params DraftCombinePlayerAnthroParams) (*DraftCombinePlayerAnthroResult, error) {
+	resp, err := c.GetDraftCombinePlayerAnthro(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DraftCombinePlayerAnthroResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Results": &result.Results,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode draftcombineplayeranthro: %w", err)
+	}
+	return result, nil
+}
+
+// DraftCombineSpotShootingResult holds the decoded result sets of the
+// draftcombinespotshooting endpoint.
+type DraftCombineSpotShootingResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Results []DraftCombineSpotShootingResultsRow
+}
+
+// GetDraftCombineSpotShootingTyped is like GetDraftCombineSpotShooting but
+// decodes the result sets into a DraftCombineSpotShootingResult.
+func (c *Client) GetDraftCombineSpotShootingTyped(ctx context.Context, params DraftCombineSpotShootingParams) (*DraftCombineSpotShootingResult, error) {
+	resp, err := c.GetDraftCombineSpotShooting(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DraftCombineSpotShootingResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Results": &result.Results,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode draftcombinespotshooting: %w", err)
+	}
+	return result, nil
+}
+
+// DraftCombineStatsResult holds the decoded result sets of the
+// draftcombinestats endpoint.
+type DraftCombineStatsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	DraftCombineStats []DraftCombineStatsRow
+}
+
+// GetDraftCombineStatsTyped is like GetDraftCombineStats but decodes the
+// result sets into a DraftCombineStatsResult.
+func (c *Client) GetDraftCombineStatsTyped(ctx context.Context, params DraftCombineStatsParams) (*DraftCombineStatsResult, error) {
+	resp, err := c.GetDraftCombineStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DraftCombineStatsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"DraftCombineStats": &result.DraftCombineStats,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode draftcombinestats: %w", err)
+	}
+	return result, nil
+}
+
+// DraftHistoryResult holds the decoded result sets of the drafthistory
+// endpoint.
+type DraftHistoryResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	DraftHistory []DraftHistoryRow
+}
+
+// GetDraftHistoryTyped is like GetDraftHistory but decodes the result sets
+// into a DraftHistoryResult.
+func (c *Client) GetDraftHistoryTyped(ctx context.Context, params DraftHistoryParams) (*DraftHistoryResult, error) {
+	resp, err := c.GetDraftHistory(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DraftHistoryResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"DraftHistory": &result.DraftHistory,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode drafthistory: %w", err)
+	}
+	return result, nil
+}
+
+// DraftCombineDrillResultsResultsRow is a row of the draftcombinedrillresults
+// Results result set.
+type DraftCombineDrillResultsResultsRow struct {
+	TempPlayerID            int     `nba:"TEMP_PLAYER_ID"`
+	PlayerID                int     `nba:"PLAYER_ID"`
+	FirstName               string  `nba:"FIRST_NAME"`
+	LastName                string  `nba:"LAST_NAME"`
+	PlayerName              string  `nba:"PLAYER_NAME"`
+	Position                string  `nba:"POSITION"`
+	StandingVerticalLeap    float64 `nba:"STANDING_VERTICAL_LEAP"`
+	MaxVerticalLeap         float64 `nba:"MAX_VERTICAL_LEAP"`
+	LaneAgilityTime         float64 `nba:"LANE_AGILITY_TIME"`
+	ModifiedLaneAgilityTime float64 `nba:"MODIFIED_LANE_AGILITY_TIME"`
+	ThreeQuarterSprint      float64 `nba:"THREE_QUARTER_SPRINT"`
+	BenchPress              float64 `nba:"BENCH_PRESS"`
+}
+
+// DraftCombineNonStationaryShootingResultsRow is a row of the
+// draftcombinenonstationaryshooting Results result set.
+type DraftCombineNonStationaryShootingResultsRow struct {
+	TempPlayerID                    int     `nba:"TEMP_PLAYER_ID"`
+	PlayerID                        int     `nba:"PLAYER_ID"`
+	FirstName                       string  `nba:"FIRST_NAME"`
+	LastName                        string  `nba:"LAST_NAME"`
+	PlayerName                      string  `nba:"PLAYER_NAME"`
+	Position                        string  `nba:"POSITION"`
+	OffDribFifteenBreakLeftMade     float64 `nba:"OFF_DRIB_FIFTEEN_BREAK_LEFT_MADE"`
+	OffDribFifteenBreakLeftAttempt  float64 `nba:"OFF_DRIB_FIFTEEN_BREAK_LEFT_ATTEMPT"`
+	OffDribFifteenBreakLeftPct      float64 `nba:"OFF_DRIB_FIFTEEN_BREAK_LEFT_PCT"`
+	OffDribFifteenTopKeyMade        float64 `nba:"OFF_DRIB_FIFTEEN_TOP_KEY_MADE"`
+	OffDribFifteenTopKeyAttempt     float64 `nba:"OFF_DRIB_FIFTEEN_TOP_KEY_ATTEMPT"`
+	OffDribFifteenTopKeyPct         float64 `nba:"OFF_DRIB_FIFTEEN_TOP_KEY_PCT"`
+	OffDribFifteenBreakRightMade    float64 `nba:"OFF_DRIB_FIFTEEN_BREAK_RIGHT_MADE"`
+	OffDribFifteenBreakRightAttempt float64 `nba:"OFF_DRIB_FIFTEEN_BREAK_RIGHT_ATTEMPT"`
+	OffDribFifteenBreakRightPct     float64 `nba:"OFF_DRIB_FIFTEEN_BREAK_RIGHT_PCT"`
+	OffDribCollegeBreakLeftMade     float64 `nba:"OFF_DRIB_COLLEGE_BREAK_LEFT_MADE"`
+	OffDribCollegeBreakLeftAttempt  float64 `nba:"OFF_DRIB_COLLEGE_BREAK_LEFT_ATTEMPT"`
+	OffDribCollegeBreakLeftPct      float64 `nba:"OFF_DRIB_COLLEGE_BREAK_LEFT_PCT"`
+	OffDribCollegeTopKeyMade        float64 `nba:"OFF_DRIB_COLLEGE_TOP_KEY_MADE"`
+	OffDribCollegeTopKeyAttempt     float64 `nba:"OFF_DRIB_COLLEGE_TOP_KEY_ATTEMPT"`
+	OffDribCollegeTopKeyPct         float64 `nba:"OFF_DRIB_COLLEGE_TOP_KEY_PCT"`
+	OffDribCollegeBreakRightMade    float64 `nba:"OFF_DRIB_COLLEGE_BREAK_RIGHT_MADE"`
+	OffDribCollegeBreakRightAttempt float64 `nba:"OFF_DRIB_COLLEGE_BREAK_RIGHT_ATTEMPT"`
+	OffDribCollegeBreakRightPct     float64 `nba:"OFF_DRIB_COLLEGE_BREAK_RIGHT_PCT"`
+	OnMoveFifteenMade               float64 `nba:"ON_MOVE_FIFTEEN_MADE"`
+	OnMoveFifteenAttempt            float64 `nba:"ON_MOVE_FIFTEEN_ATTEMPT"`
+	OnMoveFifteenPct                float64 `nba:"ON_MOVE_FIFTEEN_PCT"`
+	OnMoveCollegeMade               float64 `nba:"ON_MOVE_COLLEGE_MADE"`
+	OnMoveCollegeAttempt            float64 `nba:"ON_MOVE_COLLEGE_ATTEMPT"`
+	OnMoveCollegePct                float64 `nba:"ON_MOVE_COLLEGE_PCT"`
+}
+
+// DraftCombinePlayerAnthroResultsRow is a row of the draftcombineplayeranthro
+// Results result set.
+type DraftCombinePlayerAnthroResultsRow struct {
+	TempPlayerID      int     `nba:"TEMP_PLAYER_ID"`
+	PlayerID          int     `nba:"PLAYER_ID"`
+	FirstName         string  `nba:"FIRST_NAME"`
+	LastName          string  `nba:"LAST_NAME"`
+	PlayerName        string  `nba:"PLAYER_NAME"`
+	Position          string  `nba:"POSITION"`
+	HeightWoShoes     float64 `nba:"HEIGHT_WO_SHOES"`
+	HeightWoShoesFTIn string  `nba:"HEIGHT_WO_SHOES_FT_IN"`
+	HeightWShoes      float64 `nba:"HEIGHT_W_SHOES"`
+	HeightWShoesFTIn  string  `nba:"HEIGHT_W_SHOES_FT_IN"`
+	Weight            string  `nba:"WEIGHT"`
+	Wingspan          float64 `nba:"WINGSPAN"`
+	WingspanFTIn      string  `nba:"WINGSPAN_FT_IN"`
+	StandingReach     float64 `nba:"STANDING_REACH"`
+	StandingReachFTIn string  `nba:"STANDING_REACH_FT_IN"`
+	BodyFatPct        string  `nba:"BODY_FAT_PCT"`
+	HandLength        string  `nba:"HAND_LENGTH"`
+	HandWidth         string  `nba:"HAND_WIDTH"`
+}
+
+// DraftCombineSpotShootingResultsRow is a row of the draftcombinespotshooting
+// Results result set.
+type DraftCombineSpotShootingResultsRow struct {
+	TempPlayerID              int     `nba:"TEMP_PLAYER_ID"`
+	PlayerID                  int     `nba:"PLAYER_ID"`
+	FirstName                 string  `nba:"FIRST_NAME"`
+	LastName                  string  `nba:"LAST_NAME"`
+	PlayerName                string  `nba:"PLAYER_NAME"`
+	Position                  string  `nba:"POSITION"`
+	FifteenCornerLeftMade     float64 `nba:"FIFTEEN_CORNER_LEFT_MADE"`
+	FifteenCornerLeftAttempt  float64 `nba:"FIFTEEN_CORNER_LEFT_ATTEMPT"`
+	FifteenCornerLeftPct      float64 `nba:"FIFTEEN_CORNER_LEFT_PCT"`
+	FifteenBreakLeftMade      float64 `nba:"FIFTEEN_BREAK_LEFT_MADE"`
+	FifteenBreakLeftAttempt   float64 `nba:"FIFTEEN_BREAK_LEFT_ATTEMPT"`
+	FifteenBreakLeftPct       float64 `nba:"FIFTEEN_BREAK_LEFT_PCT"`
+	FifteenTopKeyMade         float64 `nba:"FIFTEEN_TOP_KEY_MADE"`
+	FifteenTopKeyAttempt      float64 `nba:"FIFTEEN_TOP_KEY_ATTEMPT"`
+	FifteenTopKeyPct          float64 `nba:"FIFTEEN_TOP_KEY_PCT"`
+	FifteenBreakRightMade     float64 `nba:"FIFTEEN_BREAK_RIGHT_MADE"`
+	FifteenBreakRightAttempt  float64 `nba:"FIFTEEN_BREAK_RIGHT_ATTEMPT"`
+	FifteenBreakRightPct      float64 `nba:"FIFTEEN_BREAK_RIGHT_PCT"`
+	FifteenCornerRightMade    float64 `nba:"FIFTEEN_CORNER_RIGHT_MADE"`
+	FifteenCornerRightAttempt float64 `nba:"FIFTEEN_CORNER_RIGHT_ATTEMPT"`
+	FifteenCornerRightPct     float64 `nba:"FIFTEEN_CORNER_RIGHT_PCT"`
+	CollegeCornerLeftMade     float64 `nba:"COLLEGE_CORNER_LEFT_MADE"`
+	CollegeCornerLeftAttempt  float64 `nba:"COLLEGE_CORNER_LEFT_ATTEMPT"`
+	CollegeCornerLeftPct      float64 `nba:"COLLEGE_CORNER_LEFT_PCT"`
+	CollegeBreakLeftMade      float64 `nba:"COLLEGE_BREAK_LEFT_MADE"`
+	CollegeBreakLeftAttempt   float64 `nba:"COLLEGE_BREAK_LEFT_ATTEMPT"`
+	CollegeBreakLeftPct       float64 `nba:"COLLEGE_BREAK_LEFT_PCT"`
+	CollegeTopKeyMade         float64 `nba:"COLLEGE_TOP_KEY_MADE"`
+	CollegeTopKeyAttempt      float64 `nba:"COLLEGE_TOP_KEY_ATTEMPT"`
+	CollegeTopKeyPct          float64 `nba:"COLLEGE_TOP_KEY_PCT"`
+	CollegeBreakRightMade     float64 `nba:"COLLEGE_BREAK_RIGHT_MADE"`
+	CollegeBreakRightAttempt  float64 `nba:"COLLEGE_BREAK_RIGHT_ATTEMPT"`
+	CollegeBreakRightPct      float64 `nba:"COLLEGE_BREAK_RIGHT_PCT"`
+	CollegeCornerRightMade    float64 `nba:"COLLEGE_CORNER_RIGHT_MADE"`
+	CollegeCornerRightAttempt float64 `nba:"COLLEGE_CORNER_RIGHT_ATTEMPT"`
+	CollegeCornerRightPct     float64 `nba:"COLLEGE_CORNER_RIGHT_PCT"`
+	NBACornerLeftMade         float64 `nba:"NBA_CORNER_LEFT_MADE"`
+	NBACornerLeftAttempt      float64 `nba:"NBA_CORNER_LEFT_ATTEMPT"`
+	NBACornerLeftPct          float64 `nba:"NBA_CORNER_LEFT_PCT"`
+	NBABreakLeftMade          float64 `nba:"NBA_BREAK_LEFT_MADE"`
+	NBABreakLeftAttempt       float64 `nba:"NBA_BREAK_LEFT_ATTEMPT"`
+	NBABreakLeftPct           float64 `nba:"NBA_BREAK_LEFT_PCT"`
+	NBATopKeyMade             float64 `nba:"NBA_TOP_KEY_MADE"`
+	NBATopKeyAttempt          float64 `nba:"NBA_TOP_KEY_ATTEMPT"`
+	NBATopKeyPct              float64 `nba:"NBA_TOP_KEY_PCT"`
+	NBABreakRightMade         float64 `nba:"NBA_BREAK_RIGHT_MADE"`
+	NBABreakRightAttempt      float64 `nba:"NBA_BREAK_RIGHT_ATTEMPT"`
+	NBABreakRightPct          float64 `nba:"NBA_BREAK_RIGHT_PCT"`
+	NBACornerRightMade        float64 `nba:"NBA_CORNER_RIGHT_MADE"`
+	NBACornerRightAttempt     float64 `nba:"NBA_CORNER_RIGHT_ATTEMPT"`
+	NBACornerRightPct         float64 `nba:"NBA_CORNER_RIGHT_PCT"`
+}
+
+// DraftCombineStatsRow is a row of the draftcombinestats DraftCombineStats
+// result set.
+type DraftCombineStatsRow struct {
+	Season                   string  `nba:"SEASON"`
+	PlayerID                 int     `nba:"PLAYER_ID"`
+	FirstName                string  `nba:"FIRST_NAME"`
+	LastName                 string  `nba:"LAST_NAME"`
+	PlayerName               string  `nba:"PLAYER_NAME"`
+	Position                 string  `nba:"POSITION"`
+	HeightWoShoes            float64 `nba:"HEIGHT_WO_SHOES"`
+	HeightWoShoesFTIn        string  `nba:"HEIGHT_WO_SHOES_FT_IN"`
+	HeightWShoes             float64 `nba:"HEIGHT_W_SHOES"`
+	HeightWShoesFTIn         string  `nba:"HEIGHT_W_SHOES_FT_IN"`
+	Weight                   string  `nba:"WEIGHT"`
+	Wingspan                 float64 `nba:"WINGSPAN"`
+	WingspanFTIn             string  `nba:"WINGSPAN_FT_IN"`
+	StandingReach            float64 `nba:"STANDING_REACH"`
+	StandingReachFTIn        string  `nba:"STANDING_REACH_FT_IN"`
+	BodyFatPct               string  `nba:"BODY_FAT_PCT"`
+	HandLength               string  `nba:"HAND_LENGTH"`
+	HandWidth                string  `nba:"HAND_WIDTH"`
+	StandingVerticalLeap     float64 `nba:"STANDING_VERTICAL_LEAP"`
+	MaxVerticalLeap          float64 `nba:"MAX_VERTICAL_LEAP"`
+	LaneAgilityTime          float64 `nba:"LANE_AGILITY_TIME"`
+	ModifiedLaneAgilityTime  float64 `nba:"MODIFIED_LANE_AGILITY_TIME"`
+	ThreeQuarterSprint       float64 `nba:"THREE_QUARTER_SPRINT"`
+	BenchPress               float64 `nba:"BENCH_PRESS"`
+	SpotFifteenCornerLeft    string  `nba:"SPOT_FIFTEEN_CORNER_LEFT"`
+	SpotFifteenBreakLeft     string  `nba:"SPOT_FIFTEEN_BREAK_LEFT"`
+	SpotFifteenTopKey        string  `nba:"SPOT_FIFTEEN_TOP_KEY"`
+	SpotFifteenBreakRight    string  `nba:"SPOT_FIFTEEN_BREAK_RIGHT"`
+	SpotFifteenCornerRight   string  `nba:"SPOT_FIFTEEN_CORNER_RIGHT"`
+	SpotCollegeCornerLeft    string  `nba:"SPOT_COLLEGE_CORNER_LEFT"`
+	SpotCollegeBreakLeft     string  `nba:"SPOT_COLLEGE_BREAK_LEFT"`
+	SpotCollegeTopKey        string  `nba:"SPOT_COLLEGE_TOP_KEY"`
+	SpotCollegeBreakRight    string  `nba:"SPOT_COLLEGE_BREAK_RIGHT"`
+	SpotCollegeCornerRight   string  `nba:"SPOT_COLLEGE_CORNER_RIGHT"`
+	SpotNBACornerLeft        string  `nba:"SPOT_NBA_CORNER_LEFT"`
+	SpotNBABreakLeft         string  `nba:"SPOT_NBA_BREAK_LEFT"`
+	SpotNBATopKey            string  `nba:"SPOT_NBA_TOP_KEY"`
+	SpotNBABreakRight        string  `nba:"SPOT_NBA_BREAK_RIGHT"`
+	SpotNBACornerRight       string  `nba:"SPOT_NBA_CORNER_RIGHT"`
+	OffDribFifteenBreakLeft  string  `nba:"OFF_DRIB_FIFTEEN_BREAK_LEFT"`
+	OffDribFifteenTopKey     string  `nba:"OFF_DRIB_FIFTEEN_TOP_KEY"`
+	OffDribFifteenBreakRight string  `nba:"OFF_DRIB_FIFTEEN_BREAK_RIGHT"`
+	OffDribCollegeBreakLeft  string  `nba:"OFF_DRIB_COLLEGE_BREAK_LEFT"`
+	OffDribCollegeTopKey     string  `nba:"OFF_DRIB_COLLEGE_TOP_KEY"`
+	OffDribCollegeBreakRight string  `nba:"OFF_DRIB_COLLEGE_BREAK_RIGHT"`
+	OnMoveFifteen            string  `nba:"ON_MOVE_FIFTEEN"`
+	OnMoveCollege            string  `nba:"ON_MOVE_COLLEGE"`
+}
+
+// DraftHistoryRow is a row of the drafthistory DraftHistory result set.
+type DraftHistoryRow struct {
+	PersonID          int     `nba:"PERSON_ID"`
+	PlayerName        string  `nba:"PLAYER_NAME"`
+	Season            string  `nba:"SEASON"`
+	RoundNumber       int     `nba:"ROUND_NUMBER"`
+	RoundPick         int     `nba:"ROUND_PICK"`
+	OverallPick       int     `nba:"OVERALL_PICK"`
+	DraftType         string  `nba:"DRAFT_TYPE"`
+	TeamID            int     `nba:"TEAM_ID"`
+	TeamCity          string  `nba:"TEAM_CITY"`
+	TeamName          string  `nba:"TEAM_NAME"`
+	TeamAbbreviation  string  `nba:"TEAM_ABBREVIATION"`
+	Organization      string  `nba:"ORGANIZATION"`
+	OrganizationType  string  `nba:"ORGANIZATION_TYPE"`
+	PlayerProfileFlag float64 `nba:"PLAYER_PROFILE_FLAG"`
+}
@@ -0,0 +1,160 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package franchise
+
+import (
+	"context"
+	"fmt"
+)
+
+// FranchiseHistoryResult holds the decoded result sets of the
+// franchisehistory endpoint.
+type FranchiseHistoryResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	DefunctTeams     []FranchiseHistoryRow
+	FranchiseHistory []FranchiseHistoryRow
+}
+
+// GetFranchiseHistoryTyped is like GetFranchiseHistory but decodes the result
+// sets into a FranchiseHistoryResult.
+func (c *Client) GetFranchiseHistoryTyped(ctx context.Context, params FranchiseHistoryParams) (*FranchiseHistoryResult, error) {
+	resp, err := c.GetFranchiseHistory(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FranchiseHistoryResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"DefunctTeams":     &result.DefunctTeams,
+		"FranchiseHistory": &result.FranchiseHistory,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode franchisehistory: %w", err)
+	}
+	return result, nil
+}
+
+// FranchiseLeadersResult holds the decoded result sets of the
+// franchiseleaders endpoint.
+type FranchiseLeadersResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	FranchiseLeaders []FranchiseLeadersRow
+}
+
+// GetFranchiseLeadersTyped is like GetFranchiseLeaders but decodes the result
+// sets into a FranchiseLeadersResult.
+func (c *Client) GetFranchiseLeadersTyped(ctx context.Context, params FranchiseLeadersParams) (*FranchiseLeadersResult, error) {
+	resp, err := c.GetFranchiseLeaders(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FranchiseLeadersResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"FranchiseLeaders": &result.FranchiseLeaders,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode franchiseleaders: %w", err)
+	}
+	return result, nil
+}
+
+// FranchisePlayersResult holds the decoded result sets of the
+// franchiseplayers endpoint.
+type FranchisePlayersResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	FranchisePlayers []FranchisePlayersRow
+}
+
+// GetFranchisePlayersTyped is like GetFranchisePlayers but decodes the result
+// sets into a FranchisePlayersResult.
+func (c *Client) GetFranchisePlayersTyped(ctx context.Context, params FranchisePlayersParams) (*FranchisePlayersResult, error) {
+	resp, err := c.GetFranchisePlayers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FranchisePlayersResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"FranchisePlayers": &result.FranchisePlayers,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode franchiseplayers: %w", err)
+	}
+	return result, nil
+}
+
+// FranchiseHistoryRow is a row of the franchisehistory DefunctTeams and
+// franchisehistory FranchiseHistory result sets.
+type FranchiseHistoryRow struct {
+	LeagueID      string  `nba:"LEAGUE_ID"`
+	TeamID        int     `nba:"TEAM_ID"`
+	TeamCity      string  `nba:"TEAM_CITY"`
+	TeamName      string  `nba:"TEAM_NAME"`
+	StartYear     string  `nba:"START_YEAR"`
+	EndYear       string  `nba:"END_YEAR"`
+	Years         int     `nba:"YEARS"`
+	Games         float64 `nba:"GAMES"`
+	Wins          float64 `nba:"WINS"`
+	Losses        float64 `nba:"LOSSES"`
+	WinPct        float64 `nba:"WIN_PCT"`
+	PoAppearances float64 `nba:"PO_APPEARANCES"`
+	DivTitles     float64 `nba:"DIV_TITLES"`
+	ConfTitles    float64 `nba:"CONF_TITLES"`
+	LeagueTitles  float64 `nba:"LEAGUE_TITLES"`
+}
+
+// FranchiseLeadersRow is a row of the franchiseleaders FranchiseLeaders
+// result set.
+type FranchiseLeadersRow struct {
+	TeamID      int     `nba:"TEAM_ID"`
+	PTS         float64 `nba:"PTS"`
+	PTSPersonID int     `nba:"PTS_PERSON_ID"`
+	PTSPlayer   string  `nba:"PTS_PLAYER"`
+	AST         float64 `nba:"AST"`
+	ASTPersonID int     `nba:"AST_PERSON_ID"`
+	ASTPlayer   string  `nba:"AST_PLAYER"`
+	REB         float64 `nba:"REB"`
+	REBPersonID int     `nba:"REB_PERSON_ID"`
+	REBPlayer   string  `nba:"REB_PLAYER"`
+	BLK         float64 `nba:"BLK"`
+	BLKPersonID int     `nba:"BLK_PERSON_ID"`
+	BLKPlayer   string  `nba:"BLK_PLAYER"`
+	STL         float64 `nba:"STL"`
+	STLPersonID int     `nba:"STL_PERSON_ID"`
+	STLPlayer   string  `nba:"STL_PLAYER"`
+}
+
+// FranchisePlayersRow is a row of the franchiseplayers FranchisePlayers
+// result set.
+type FranchisePlayersRow struct {
+	LeagueID       string  `nba:"LEAGUE_ID"`
+	TeamID         int     `nba:"TEAM_ID"`
+	Team           string  `nba:"TEAM"`
+	PersonID       int     `nba:"PERSON_ID"`
+	Player         string  `nba:"PLAYER"`
+	SeasonType     string  `nba:"SEASON_TYPE"`
+	ActiveWithTeam string  `nba:"ACTIVE_WITH_TEAM"`
+	GP             int     `nba:"GP"`
+	FGM            float64 `nba:"FGM"`
+	FGA            float64 `nba:"FGA"`
+	FGPct          float64 `nba:"FG_PCT"`
+	FG3M           float64 `nba:"FG3M"`
+	FG3A           float64 `nba:"FG3A"`
+	FG3Pct         float64 `nba:"FG3_PCT"`
+	FTM            float64 `nba:"FTM"`
+	FTA            float64 `nba:"FTA"`
+	FTPct          float64 `nba:"FT_PCT"`
+	OREB           float64 `nba:"OREB"`
+	DREB           float64 `nba:"DREB"`
+	REB            float64 `nba:"REB"`
+	AST            float64 `nba:"AST"`
+	PF             float64 `nba:"PF"`
+	STL            float64 `nba:"STL"`
+	TOV            float64 `nba:"TOV"`
+	BLK            float64 `nba:"BLK"`
+	PTS            float64 `nba:"PTS"`
+}
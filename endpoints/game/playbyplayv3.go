@@ -1,11 +1,330 @@
 package game
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Meta holds the metadata of a V3 response.
+type Meta struct {
+	Version int    `json:"version"`
+	Request string `json:"request"`
+	Time    string `json:"time"`
+}
+
+// Action is one event of a game, such as a shot, a foul or a substitution.
+//
+// Clock is the time remaining in the period. ScoreHome and ScoreAway hold
+// the running score after the action; the API only sends them when the
+// score changes, and PlayByPlay carries them forward. HasScore is false
+// while the score is unknown, e.g. for the actions before the first score
+// of a response that starts after the first period. Location is "h" for the
+// home team and "v" for the visitors, and ShotResult is "Made" or "Missed"
+// for shots.
+type Action struct {
+	ActionNumber   int           `json:"actionNumber"`
+	Clock          time.Duration `json:"clock"`
+	Period         int           `json:"period"`
+	TeamID         int           `json:"teamId"`
+	TeamTricode    string        `json:"teamTricode"`
+	PersonID       int           `json:"personId"`
+	PlayerName     string        `json:"playerName"`
+	PlayerNameI    string        `json:"playerNameI"`
+	XLegacy        int           `json:"xLegacy"`
+	YLegacy        int           `json:"yLegacy"`
+	ShotDistance   int           `json:"shotDistance"`
+	ShotResult     string        `json:"shotResult"`
+	IsFieldGoal    bool          `json:"isFieldGoal"`
+	ScoreHome      int           `json:"scoreHome"`
+	ScoreAway      int           `json:"scoreAway"`
+	PointsTotal    int           `json:"pointsTotal"`
+	Location       string        `json:"location"`
+	Description    string        `json:"description"`
+	ActionType     string        `json:"actionType"`
+	SubType        string        `json:"subType"`
+	VideoAvailable bool          `json:"videoAvailable"`
+	ShotValue      int           `json:"shotValue"`
+	ActionID       int           `json:"actionId"`
+	HasScore       bool          `json:"-"`
+}
+
+// UnmarshalJSON decodes an action, parsing the clock and the string scores
+// and 0/1 flags used by the API.
+func (a *Action) UnmarshalJSON(data []byte) error {
+	type plain Action
+	raw := struct {
+		*plain
+		Clock          string          `json:"clock"`
+		ScoreHome      json.RawMessage `json:"scoreHome"`
+		ScoreAway      json.RawMessage `json:"scoreAway"`
+		IsFieldGoal    json.RawMessage `json:"isFieldGoal"`
+		VideoAvailable json.RawMessage `json:"videoAvailable"`
+	}{plain: (*plain)(a)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	clock, err := ParseClock(raw.Clock)
+	if err != nil {
+		return fmt.Errorf("failed to decode action %d: %w", a.ActionNumber, err)
+	}
+	a.Clock = clock
+
+	home, hasHome, err := parseScore(raw.ScoreHome)
+	if err != nil {
+		return fmt.Errorf("failed to decode scoreHome of action %d: %w", a.ActionNumber, err)
+	}
+	away, hasAway, err := parseScore(raw.ScoreAway)
+	if err != nil {
+		return fmt.Errorf("failed to decode scoreAway of action %d: %w", a.ActionNumber, err)
+	}
+	a.ScoreHome, a.ScoreAway = home, away
+	a.HasScore = hasHome && hasAway
+
+	if a.IsFieldGoal, err = parseFlag(raw.IsFieldGoal); err != nil {
+		return fmt.Errorf("failed to decode isFieldGoal of action %d: %w", a.ActionNumber, err)
+	}
+	if a.VideoAvailable, err = parseFlag(raw.VideoAvailable); err != nil {
+		return fmt.Errorf("failed to decode videoAvailable of action %d: %w", a.ActionNumber, err)
+	}
+	return nil
+}
+
+// MarshalJSON encodes an action with its clock and scores in the API
+// format, so that it can be decoded again. Unknown scores are empty.
+func (a Action) MarshalJSON() ([]byte, error) {
+	type plain Action
+	var home, away string
+	if a.HasScore {
+		home, away = strconv.Itoa(a.ScoreHome), strconv.Itoa(a.ScoreAway)
+	}
+	return json.Marshal(struct {
+		plain
+		Clock     string `json:"clock"`
+		ScoreHome string `json:"scoreHome"`
+		ScoreAway string `json:"scoreAway"`
+	}{plain(a), formatClock(a.Clock), home, away})
+}
+
+// ParseClock parses a game clock such as "PT11M42.00S", or the "PT11:42.00"
+// form, into the time remaining in the period. An empty clock is zero.
+func ParseClock(clock string) (time.Duration, error) {
+	if clock == "" {
+		return 0, nil
+	}
+
+	rest, ok := strings.CutPrefix(clock, "PT")
+	if !ok {
+		return 0, fmt.Errorf("invalid clock %q", clock)
+	}
+	if minutes, seconds, ok := strings.Cut(rest, ":"); ok {
+		rest = minutes + "m" + seconds + "s"
+	}
+
+	d, err := time.ParseDuration(strings.ToLower(rest))
+	if err != nil {
+		return 0, fmt.Errorf("invalid clock %q", clock)
+	}
+	return d, nil
+}
+
+// formatClock formats d the way the API sends clocks, e.g. "PT11M42.00S".
+func formatClock(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	seconds := (d % time.Minute).Seconds()
+	return fmt.Sprintf("PT%02dM%05.2fS", minutes, seconds)
+}
+
+// parseScore parses a score sent as a string or a number. It reports false
+// for an empty score.
+func parseScore(data json.RawMessage) (int, bool, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return 0, false, nil
+	}
+
+	text := string(data)
+	if data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return 0, false, err
+		}
+		if text == "" {
+			return 0, false, nil
+		}
+	}
+
+	score, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid score %s", data)
+	}
+	return score, true, nil
+}
+
+// parseFlag parses a 0/1 or boolean flag.
+func parseFlag(data json.RawMessage) (bool, error) {
+	switch string(bytes.TrimSpace(data)) {
+	case "", "null", "0", "false":
+		return false, nil
+	case "1", "true":
+		return true, nil
+	}
+	return false, fmt.Errorf("invalid flag %s", data)
+}
+
+// PlayByPlay holds the actions of a game in order.
+type PlayByPlay struct {
+	GameID         string   `json:"gameId"`
+	VideoAvailable bool     `json:"videoAvailable"`
+	Actions        []Action `json:"actions"`
+}
+
+// UnmarshalJSON decodes a play-by-play and carries the running score forward
+// to the actions that did not change it. The actions before the first one
+// with a score keep an unknown score.
+func (p *PlayByPlay) UnmarshalJSON(data []byte) error {
+	type plain PlayByPlay
+	raw := struct {
+		*plain
+		VideoAvailable json.RawMessage `json:"videoAvailable"`
+	}{plain: (*plain)(p)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var err error
+	if p.VideoAvailable, err = parseFlag(raw.VideoAvailable); err != nil {
+		return fmt.Errorf("failed to decode videoAvailable: %w", err)
+	}
+
+	var home, away int
+	known := false
+	for i := range p.Actions {
+		action := &p.Actions[i]
+		if action.HasScore {
+			home, away = action.ScoreHome, action.ScoreAway
+			known = true
+			continue
+		}
+		if known {
+			action.ScoreHome, action.ScoreAway = home, away
+			action.HasScore = true
+		}
+	}
+	return nil
+}
+
+// Filter returns the actions for which keep returns true.
+func (p *PlayByPlay) Filter(keep func(Action) bool) []Action {
+	var actions []Action
+	for _, action := range p.Actions {
+		if keep(action) {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// ByPeriod returns the actions of a period. Overtimes follow the fourth
+// period, so the first overtime is period 5.
+func (p *PlayByPlay) ByPeriod(period int) []Action {
+	return p.Filter(func(a Action) bool { return a.Period == period })
+}
+
+// ByTeam returns the actions of a team.
+func (p *PlayByPlay) ByTeam(teamID int) []Action {
+	return p.Filter(func(a Action) bool { return a.TeamID == teamID })
+}
+
+// ByPlayer returns the actions of a player.
+func (p *PlayByPlay) ByPlayer(personID int) []Action {
+	return p.Filter(func(a Action) bool { return a.PersonID == personID })
+}
+
+// ByActionType returns the actions of an action type, such as "Made Shot",
+// "Rebound" or "Foul", matched case-insensitively. When subTypes are given,
+// only actions of one of them are returned.
+func (p *PlayByPlay) ByActionType(actionType string, subTypes ...string) []Action {
+	return p.Filter(func(a Action) bool {
+		if !strings.EqualFold(a.ActionType, actionType) {
+			return false
+		}
+		if len(subTypes) == 0 {
+			return true
+		}
+		for _, subType := range subTypes {
+			if strings.EqualFold(a.SubType, subType) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Shots returns the field goal attempts.
+func (p *PlayByPlay) Shots() []Action {
+	return p.Filter(func(a Action) bool { return a.IsFieldGoal })
+}
+
+// PlayByPlayV3Response is the response of the playbyplayv3 endpoint.
+type PlayByPlayV3Response struct {
+	Meta Meta       `json:"meta"`
+	Game PlayByPlay `json:"game"`
+}
+
+// playByPlayHeaders are the columns of the converted PlayByPlay result set.
+var playByPlayHeaders = []string{
+	"gameId", "actionNumber", "clock", "period", "teamId", "teamTricode",
+	"personId", "playerName", "playerNameI", "xLegacy", "yLegacy",
+	"shotDistance", "shotResult", "isFieldGoal", "scoreHome", "scoreAway",
+	"pointsTotal", "location", "description", "actionType", "subType",
+	"videoAvailable", "shotValue", "actionId",
+}
+
+// StatsResponse converts the play-by-play to the AvailableVideo and
+// PlayByPlay result sets. Clocks keep the API format, flags are 0 or 1, and
+// unknown scores are nil.
+func (r *PlayByPlayV3Response) StatsResponse() *StatsResponse {
+	video := ResultSet{
+		Name:    "AvailableVideo",
+		Headers: []string{"videoAvailable"},
+		RowSet:  [][]interface{}{{flagValue(r.Game.VideoAvailable)}},
+	}
+
+	plays := ResultSet{Name: "PlayByPlay", Headers: playByPlayHeaders}
+	for _, a := range r.Game.Actions {
+		var scoreHome, scoreAway interface{}
+		if a.HasScore {
+			scoreHome, scoreAway = float64(a.ScoreHome), float64(a.ScoreAway)
+		}
+		plays.RowSet = append(plays.RowSet, []interface{}{
+			r.Game.GameID, float64(a.ActionNumber), formatClock(a.Clock), float64(a.Period), float64(a.TeamID), a.TeamTricode,
+			float64(a.PersonID), a.PlayerName, a.PlayerNameI, float64(a.XLegacy), float64(a.YLegacy),
+			float64(a.ShotDistance), a.ShotResult, flagValue(a.IsFieldGoal), scoreHome, scoreAway,
+			float64(a.PointsTotal), a.Location, a.Description, a.ActionType, a.SubType,
+			flagValue(a.VideoAvailable), float64(a.ShotValue), float64(a.ActionID),
+		})
+	}
+
+	return &StatsResponse{
+		Resource:   "playbyplayv3",
+		ResultSets: []ResultSet{video, plays},
+	}
+}
+
+// flagValue returns the result set value of a flag.
+func flagValue(flag bool) float64 {
+	if flag {
+		return 1
+	}
+	return 0
+}
+
 // PlayByPlayV3Params holds parameters for the PlayByPlayV3 endpoint.
 type PlayByPlayV3Params struct {
 	GameId string
@@ -13,8 +332,19 @@ type PlayByPlayV3Params struct {
 	StartPeriod string
 }
 
-// GetPlayByPlayV3 fetches data from the playbyplayv3 endpoint.
+// GetPlayByPlayV3 fetches data from the playbyplayv3 endpoint and converts it
+// to result sets. Use GetPlayByPlayV3Typed for the actions.
 func (c *Client) GetPlayByPlayV3(ctx context.Context, params PlayByPlayV3Params) (*StatsResponse, error) {
+	resp, err := c.GetPlayByPlayV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetPlayByPlayV3Typed fetches the actions of a game from the playbyplayv3
+// endpoint.
+func (c *Client) GetPlayByPlayV3Typed(ctx context.Context, params PlayByPlayV3Params) (*PlayByPlayV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching playbyplayv3")
 
 	reqParams := map[string]string{
@@ -23,27 +353,15 @@ func (c *Client) GetPlayByPlayV3(ctx context.Context, params PlayByPlayV3Params)
 		"StartPeriod": params.StartPeriod,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playbyplayv3", reqParams)
-	if err != nil {
+	var playByPlayResp PlayByPlayV3Response
+	if _, err := c.httpClient.Get(ctx, "playbyplayv3", reqParams, &playByPlayResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playbyplayv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playbyplayv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playbyplayv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playbyplayv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playbyplayv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("actions_count", len(playByPlayResp.Game.Actions)))
 
-	return &statsResp, nil
+	return &playByPlayResp, nil
 }
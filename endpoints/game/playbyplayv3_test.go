@@ -2,39 +2,54 @@
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/utkonoser/nba-api-go/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestGetPlayByPlayV3(t *testing.T) {
-	mockResponse := `{
-		"resource": "playbyplayv3",
-		"parameters": {},
-		"resultSets": [
-{
-				"name": "AvailableVideo",
-				"headers": ["videoAvailable"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
-			},
-{
-				"name": "PlayByPlay",
-				"headers": ["gameId", "actionNumber", "clock", "period", "teamId"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
-			}
+const playByPlayV3Response = `{
+	"meta": {"version": 1, "request": "http://nba.cloud/games/0022300061/playbyplay", "time": "2023-10-25 01:12:34.5634"},
+	"game": {
+		"gameId": "0022300061",
+		"videoAvailable": 1,
+		"actions": [
+			{"actionNumber": 2, "clock": "PT12M00.00S", "period": 1, "teamId": 0, "teamTricode": "", "personId": 0,
+			 "playerName": "", "playerNameI": "", "xLegacy": 0, "yLegacy": 0, "shotDistance": 0, "shotResult": "",
+			 "isFieldGoal": 0, "scoreHome": "0", "scoreAway": "0", "pointsTotal": 0, "location": "",
+			 "description": "Period Start", "actionType": "period", "subType": "start", "videoAvailable": 0, "shotValue": 0, "actionId": 1},
+			{"actionNumber": 7, "clock": "PT11M42.00S", "period": 1, "teamId": 1610612743, "teamTricode": "DEN", "personId": 203999,
+			 "playerName": "Jokic", "playerNameI": "N. Jokic", "xLegacy": -12, "yLegacy": 8, "shotDistance": 1, "shotResult": "Made",
+			 "isFieldGoal": 1, "scoreHome": "2", "scoreAway": "0", "pointsTotal": 2, "location": "h",
+			 "description": "Jokic 1' Driving Layup (2 PTS)", "actionType": "Made Shot", "subType": "Driving Layup Shot", "videoAvailable": 1, "shotValue": 2, "actionId": 2},
+			{"actionNumber": 9, "clock": "PT11M21.00S", "period": 1, "teamId": 1610612747, "teamTricode": "LAL", "personId": 2544,
+			 "playerName": "James", "playerNameI": "L. James", "xLegacy": 221, "yLegacy": 102, "shotDistance": 24, "shotResult": "Missed",
+			 "isFieldGoal": 1, "scoreHome": "", "scoreAway": "", "pointsTotal": 2, "location": "v",
+			 "description": "MISS James 24' 3PT Pullup Jump Shot", "actionType": "Missed Shot", "subType": "Pullup Jump shot", "videoAvailable": 1, "shotValue": 3, "actionId": 3},
+			{"actionNumber": 10, "clock": "PT11M19.00S", "period": 1, "teamId": 1610612743, "teamTricode": "DEN", "personId": 203999,
+			 "playerName": "Jokic", "playerNameI": "N. Jokic", "xLegacy": 0, "yLegacy": 0, "shotDistance": 0, "shotResult": "",
+			 "isFieldGoal": 0, "scoreHome": "", "scoreAway": "", "pointsTotal": 2, "location": "h",
+			 "description": "Jokic REBOUND (Off:0 Def:1)", "actionType": "Rebound", "subType": "", "videoAvailable": 1, "shotValue": 0, "actionId": 4},
+			{"actionNumber": 512, "clock": "PT04M03.50S", "period": 5, "teamId": 1610612747, "teamTricode": "LAL", "personId": 2544,
+			 "playerName": "James", "playerNameI": "L. James", "xLegacy": 0, "yLegacy": 0, "shotDistance": 0, "shotResult": "Made",
+			 "isFieldGoal": 0, "scoreHome": "112", "scoreAway": "111", "pointsTotal": 223, "location": "v",
+			 "description": "James Free Throw 1 of 2 (22 PTS)", "actionType": "Free Throw", "subType": "Free Throw 1 of 2", "videoAvailable": 1, "shotValue": 1, "actionId": 5}
 		]
-	}`
+	}
+}`
 
+func TestGetPlayByPlayV3(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Contains(t, r.URL.Path, "playbyplayv3")
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(mockResponse))
+		_, _ = w.Write([]byte(playByPlayV3Response))
 	}))
 	defer server.Close()
 
@@ -47,11 +62,145 @@ func TestGetPlayByPlayV3(t *testing.T) {
 
 	params := PlayByPlayV3Params{}
 
+	resp, err := c.GetPlayByPlayV3Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	pbp := resp.Game
+	assert.Equal(t, "0022300061", pbp.GameID)
+	assert.True(t, pbp.VideoAvailable)
+	require.Len(t, pbp.Actions, 5)
+
+	shot := pbp.Actions[1]
+	assert.Equal(t, 11*time.Minute+42*time.Second, shot.Clock)
+	assert.Equal(t, "Made Shot", shot.ActionType)
+	assert.True(t, shot.IsFieldGoal)
+	assert.Equal(t, 2, shot.ScoreHome)
+	assert.Equal(t, -12, shot.XLegacy)
+
+	rebound := pbp.Actions[3]
+	assert.False(t, rebound.IsFieldGoal)
+	assert.Equal(t, 2, rebound.ScoreHome, "the running score is carried forward")
+	assert.Equal(t, 0, rebound.ScoreAway)
+	assert.True(t, rebound.HasScore)
+	assert.Equal(t, 4*time.Minute+3500*time.Millisecond, pbp.Actions[4].Clock)
+
 	response, err := c.GetPlayByPlayV3(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "playbyplayv3", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+	plays, err := response.GetDataSet("PlayByPlay")
+	require.NoError(t, err)
+	require.Equal(t, 5, plays.RowCount())
+	row, err := plays.GetRow(2)
+	require.NoError(t, err)
+	assert.Equal(t, "PT11M21.00S", row["clock"])
+	assert.Equal(t, float64(2), row["scoreHome"])
+	assert.Equal(t, float64(1), row["isFieldGoal"])
+	assert.Equal(t, "Pullup Jump shot", row["subType"])
+}
+
+func TestPlayByPlay_Filters(t *testing.T) {
+	var resp PlayByPlayV3Response
+	require.NoError(t, json.Unmarshal([]byte(playByPlayV3Response), &resp))
+	pbp := resp.Game
+
+	actionNumbers := func(actions []Action) []int {
+		var numbers []int
+		for _, a := range actions {
+			numbers = append(numbers, a.ActionNumber)
+		}
+		return numbers
+	}
+
+	assert.Equal(t, []int{2, 7, 9, 10}, actionNumbers(pbp.ByPeriod(1)))
+	assert.Equal(t, []int{512}, actionNumbers(pbp.ByPeriod(5)))
+	assert.Equal(t, []int{7, 10}, actionNumbers(pbp.ByTeam(1610612743)))
+	assert.Equal(t, []int{9, 512}, actionNumbers(pbp.ByPlayer(2544)))
+	assert.Equal(t, []int{7}, actionNumbers(pbp.ByActionType("made shot")))
+	assert.Equal(t, []int{512}, actionNumbers(pbp.ByActionType("Free Throw", "Free Throw 1 of 2", "Free Throw 2 of 2")))
+	assert.Empty(t, pbp.ByActionType("Free Throw", "Free Throw 1 of 1"))
+	assert.Equal(t, []int{7, 9}, actionNumbers(pbp.Shots()))
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		clock string
+		want  time.Duration
+	}{
+		{"PT12M00.00S", 12 * time.Minute},
+		{"PT11M42.00S", 11*time.Minute + 42*time.Second},
+		{"PT00M05.30S", 5300 * time.Millisecond},
+		{"PT11:42.00", 11*time.Minute + 42*time.Second},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		got, err := ParseClock(tt.clock)
+		require.NoError(t, err, tt.clock)
+		assert.Equal(t, tt.want, got, tt.clock)
+	}
+
+	_, err := ParseClock("11:42")
+	assert.Error(t, err)
+	_, err = ParseClock("PTxM")
+	assert.Error(t, err)
+}
+
+func TestAction_MarshalJSON(t *testing.T) {
+	var resp PlayByPlayV3Response
+	require.NoError(t, json.Unmarshal([]byte(playByPlayV3Response), &resp))
+
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"clock":"PT04M03.50S"`)
+
+	var again PlayByPlayV3Response
+	require.NoError(t, json.Unmarshal(data, &again))
+	assert.Equal(t, resp, again)
+}
+
+func TestPlayByPlay_UnknownScoreBeforeFirstScore(t *testing.T) {
+	// A response for StartPeriod=3 starts without a running score.
+	data := `{
+		"gameId": "0022300061",
+		"videoAvailable": 1,
+		"actions": [
+			{"actionNumber": 301, "clock": "PT12M00.00S", "period": 3, "scoreHome": "", "scoreAway": "",
+			 "description": "Period Start", "actionType": "period", "subType": "start"},
+			{"actionNumber": 303, "clock": "PT11M40.00S", "period": 3, "teamId": 1610612747, "scoreHome": "", "scoreAway": "",
+			 "description": "MISS James 24' 3PT Pullup Jump Shot", "actionType": "Missed Shot", "isFieldGoal": 1},
+			{"actionNumber": 305, "clock": "PT11M20.00S", "period": 3, "teamId": 1610612743, "scoreHome": "61", "scoreAway": "53",
+			 "description": "Jokic 1' Driving Layup (14 PTS)", "actionType": "Made Shot", "isFieldGoal": 1},
+			{"actionNumber": 306, "clock": "PT11M05.00S", "period": 3, "teamId": 1610612747, "scoreHome": "", "scoreAway": "",
+			 "description": "Davis REBOUND (Off:1 Def:3)", "actionType": "Rebound"}
+		]
+	}`
+
+	var pbp PlayByPlay
+	require.NoError(t, json.Unmarshal([]byte(data), &pbp))
+	require.Len(t, pbp.Actions, 4)
+
+	for _, a := range pbp.Actions[:2] {
+		assert.False(t, a.HasScore, "action %d has no score yet", a.ActionNumber)
+		assert.Zero(t, a.ScoreHome)
+	}
+	assert.True(t, pbp.Actions[3].HasScore)
+	assert.Equal(t, 61, pbp.Actions[3].ScoreHome)
+	assert.Equal(t, 53, pbp.Actions[3].ScoreAway)
+
+	response := (&PlayByPlayV3Response{Game: pbp}).StatsResponse()
+	plays, err := response.GetDataSet("PlayByPlay")
+	require.NoError(t, err)
+	row, err := plays.GetRow(1)
+	require.NoError(t, err)
+	assert.Nil(t, row["scoreHome"])
+	assert.Nil(t, row["scoreAway"])
+
+	encoded, err := json.Marshal(pbp.Actions[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"scoreHome":""`)
+	var again Action
+	require.NoError(t, json.Unmarshal(encoded, &again))
+	assert.False(t, again.HasScore)
 }
@@ -2,18 +2,259 @@
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/utkonoser/nba-api-go/endpoints/stats"
 )
 
+// PeriodScore is a team's score in one period.
+type PeriodScore struct {
+	Period     int    `json:"period"`
+	PeriodType string `json:"periodType"`
+	Score      int    `json:"score"`
+}
+
+// ScoreboardTeam holds a team's record and score in a scoreboard game.
+type ScoreboardTeam struct {
+	TeamID            int           `json:"teamId"`
+	TeamName          string        `json:"teamName"`
+	TeamCity          string        `json:"teamCity"`
+	TeamTricode       string        `json:"teamTricode"`
+	TeamSlug          string        `json:"teamSlug"`
+	Wins              int           `json:"wins"`
+	Losses            int           `json:"losses"`
+	Score             int           `json:"score"`
+	Seed              int           `json:"seed"`
+	InBonus           string        `json:"inBonus"`
+	TimeoutsRemaining int           `json:"timeoutsRemaining"`
+	Periods           []PeriodScore `json:"periods"`
+}
+
+// GameLeader is a team's leading player in a game.
+type GameLeader struct {
+	PersonID    int    `json:"personId"`
+	Name        string `json:"name"`
+	PlayerSlug  string `json:"playerSlug"`
+	JerseyNum   string `json:"jerseyNum"`
+	Position    string `json:"position"`
+	TeamTricode string `json:"teamTricode"`
+	Points      int    `json:"points"`
+	Rebounds    int    `json:"rebounds"`
+	Assists     int    `json:"assists"`
+}
+
+// GameLeaders holds the leading player of each team.
+type GameLeaders struct {
+	HomeLeaders GameLeader `json:"homeLeaders"`
+	AwayLeaders GameLeader `json:"awayLeaders"`
+}
+
+// Broadcaster is a TV, radio or streaming broadcaster of a game.
+type Broadcaster struct {
+	BroadcasterID          int    `json:"broadcasterId"`
+	BroadcastDisplay       string `json:"broadcastDisplay"`
+	BroadcasterTeamID      int    `json:"broadcasterTeamId"`
+	BroadcasterDescription string `json:"broadcasterDescription"`
+}
+
+// Broadcasters lists the broadcasters of a game by reach and medium.
+type Broadcasters struct {
+	NationalBroadcasters      []Broadcaster `json:"nationalBroadcasters"`
+	NationalRadioBroadcasters []Broadcaster `json:"nationalRadioBroadcasters"`
+	NationalOttBroadcasters   []Broadcaster `json:"nationalOttBroadcasters"`
+	HomeTvBroadcasters        []Broadcaster `json:"homeTvBroadcasters"`
+	HomeRadioBroadcasters     []Broadcaster `json:"homeRadioBroadcasters"`
+	HomeOttBroadcasters       []Broadcaster `json:"homeOttBroadcasters"`
+	AwayTvBroadcasters        []Broadcaster `json:"awayTvBroadcasters"`
+	AwayRadioBroadcasters     []Broadcaster `json:"awayRadioBroadcasters"`
+	AwayOttBroadcasters       []Broadcaster `json:"awayOttBroadcasters"`
+}
+
+// ScoreboardGame is a game on a scoreboard. GameTimeUTC is the tip-off time,
+// and GameEt is the same instant in Eastern Time. If gameEt is invalid or
+// does not match gameTimeUTC, GameEt is GameTimeUTC in Eastern Time and a
+// warning is logged.
+type ScoreboardGame struct {
+	GameID            string         `json:"gameId"`
+	GameCode          string         `json:"gameCode"`
+	GameStatus        int            `json:"gameStatus"`
+	GameStatusText    string         `json:"gameStatusText"`
+	Period            int            `json:"period"`
+	GameClock         string         `json:"gameClock"`
+	GameTimeUTC       time.Time      `json:"gameTimeUTC"`
+	GameEt            time.Time      `json:"gameEt"`
+	RegulationPeriods int            `json:"regulationPeriods"`
+	SeriesGameNumber  string         `json:"seriesGameNumber"`
+	GameLabel         string         `json:"gameLabel"`
+	GameSubLabel      string         `json:"gameSubLabel"`
+	SeriesText        string         `json:"seriesText"`
+	IfNecessary       bool           `json:"ifNecessary"`
+	SeriesConference  string         `json:"seriesConference"`
+	PoRoundDesc       string         `json:"poRoundDesc"`
+	GameSubtype       string         `json:"gameSubtype"`
+	IsNeutral         bool           `json:"isNeutral"`
+	GameLeaders       GameLeaders    `json:"gameLeaders"`
+	Broadcasters      Broadcasters   `json:"broadcasters"`
+	HomeTeam          ScoreboardTeam `json:"homeTeam"`
+	AwayTeam          ScoreboardTeam `json:"awayTeam"`
+
+	// timeErr reports the times that could not be decoded.
+	timeErr error
+}
+
+// UnmarshalJSON decodes a game, parsing its UTC and Eastern times.
+func (g *ScoreboardGame) UnmarshalJSON(data []byte) error {
+	type plain ScoreboardGame
+	raw := struct {
+		*plain
+		GameTimeUTC string `json:"gameTimeUTC"`
+		GameEt      string `json:"gameEt"`
+	}{plain: (*plain)(g)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	// A bad time of one game must not fail the whole scoreboard: it is left
+	// zero, or taken from the UTC time, and reported by timeErr.
+	g.timeErr = nil
+	var err error
+	if g.GameTimeUTC, err = stats.ParseUTCTime(raw.GameTimeUTC); err != nil {
+		g.timeErr = fmt.Errorf("invalid gameTimeUTC: %w", err)
+	}
+	if g.GameEt, err = stats.ParseEasternTime(raw.GameEt, g.GameTimeUTC); err != nil {
+		g.GameEt = stats.InEastern(g.GameTimeUTC)
+		g.timeErr = errors.Join(g.timeErr, fmt.Errorf("invalid gameEt: %w", err))
+	}
+	return nil
+}
+
+// Scoreboard holds the games of a day. GameDate is formatted as
+// "2006-01-02".
+type Scoreboard struct {
+	GameDate   string           `json:"gameDate"`
+	LeagueID   string           `json:"leagueId"`
+	LeagueName string           `json:"leagueName"`
+	Games      []ScoreboardGame `json:"games"`
+}
+
+// ScoreboardV3Response is the response of the scoreboardv3 endpoint.
+type ScoreboardV3Response struct {
+	Meta       Meta       `json:"meta"`
+	Scoreboard Scoreboard `json:"scoreboard"`
+}
+
+// StatsResponse converts the scoreboard to the ScoreboardInfo, GameHeader,
+// LineScore and GameLeaders result sets. LineScore has a periodNScore
+// column for every period played, overtimes included.
+func (r *ScoreboardV3Response) StatsResponse() *StatsResponse {
+	sb := r.Scoreboard
+
+	info := ResultSet{
+		Name:    "ScoreboardInfo",
+		Headers: []string{"gameDate", "leagueId", "leagueName"},
+		RowSet:  [][]interface{}{{sb.GameDate, sb.LeagueID, sb.LeagueName}},
+	}
+
+	header := ResultSet{Name: "GameHeader", Headers: []string{
+		"gameId", "gameCode", "gameStatus", "gameStatusText", "period", "gameClock",
+		"gameTimeUTC", "gameEt", "regulationPeriods", "seriesGameNumber", "gameLabel",
+		"gameSubLabel", "seriesText", "ifNecessary", "seriesConference", "poRoundDesc",
+		"gameSubtype", "isNeutral",
+	}}
+	leaders := ResultSet{Name: "GameLeaders", Headers: []string{
+		"gameId", "teamId", "leaderType", "personId", "name", "playerSlug", "jerseyNum",
+		"position", "teamTricode", "points", "rebounds", "assists",
+	}}
+
+	periods := 0
+	for _, g := range sb.Games {
+		periods = max(periods, len(g.HomeTeam.Periods), len(g.AwayTeam.Periods))
+	}
+	lineScore := ResultSet{Name: "LineScore", Headers: []string{
+		"gameId", "teamId", "teamCity", "teamName", "teamTricode", "teamSlug", "wins", "losses",
+	}}
+	for i := 1; i <= periods; i++ {
+		lineScore.Headers = append(lineScore.Headers, "period"+strconv.Itoa(i)+"Score")
+	}
+	lineScore.Headers = append(lineScore.Headers, "score", "seed", "inBonus", "timeoutsRemaining")
+
+	for _, g := range sb.Games {
+		header.RowSet = append(header.RowSet, []interface{}{
+			g.GameID, g.GameCode, float64(g.GameStatus), g.GameStatusText, float64(g.Period), g.GameClock,
+			formatTime(g.GameTimeUTC), formatTime(g.GameEt), float64(g.RegulationPeriods), g.SeriesGameNumber, g.GameLabel,
+			g.GameSubLabel, g.SeriesText, g.IfNecessary, g.SeriesConference, g.PoRoundDesc,
+			g.GameSubtype, g.IsNeutral,
+		})
+
+		for _, team := range []ScoreboardTeam{g.HomeTeam, g.AwayTeam} {
+			values := []interface{}{
+				g.GameID, float64(team.TeamID), team.TeamCity, team.TeamName, team.TeamTricode, team.TeamSlug,
+				float64(team.Wins), float64(team.Losses),
+			}
+			for i := 0; i < periods; i++ {
+				if i < len(team.Periods) {
+					values = append(values, float64(team.Periods[i].Score))
+				} else {
+					values = append(values, nil)
+				}
+			}
+			values = append(values, float64(team.Score), float64(team.Seed), team.InBonus, float64(team.TimeoutsRemaining))
+			lineScore.RowSet = append(lineScore.RowSet, values)
+		}
+
+		for _, leader := range []struct {
+			teamID     int
+			leaderType string
+			GameLeader
+		}{
+			{g.HomeTeam.TeamID, "home", g.GameLeaders.HomeLeaders},
+			{g.AwayTeam.TeamID, "away", g.GameLeaders.AwayLeaders},
+		} {
+			leaders.RowSet = append(leaders.RowSet, []interface{}{
+				g.GameID, float64(leader.teamID), leader.leaderType, float64(leader.PersonID), leader.Name, leader.PlayerSlug, leader.JerseyNum,
+				leader.Position, leader.TeamTricode, float64(leader.Points), float64(leader.Rebounds), float64(leader.Assists),
+			})
+		}
+	}
+
+	return &StatsResponse{
+		Resource:   "scoreboardv3",
+		ResultSets: []ResultSet{info, header, lineScore, leaders},
+	}
+}
+
+// formatTime formats t as RFC 3339, or returns "" for the zero time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 // ScoreboardV3Params holds parameters for the ScoreboardV3 endpoint.
 type ScoreboardV3Params struct {
 	GameDate string
 	LeagueId string
 }
 
-// GetScoreboardV3 fetches data from the scoreboardv3 endpoint.
+// GetScoreboardV3 fetches data from the scoreboardv3 endpoint and converts it
+// to result sets. Use GetScoreboardV3Typed for the games.
 func (c *Client) GetScoreboardV3(ctx context.Context, params ScoreboardV3Params) (*StatsResponse, error) {
+	resp, err := c.GetScoreboardV3Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetScoreboardV3Typed fetches the games of a day from the scoreboardv3
+// endpoint.
+func (c *Client) GetScoreboardV3Typed(ctx context.Context, params ScoreboardV3Params) (*ScoreboardV3Response, error) {
 	c.logger.InfoContext(ctx, "Fetching scoreboardv3")
 
 	reqParams := map[string]string{
@@ -21,27 +262,23 @@ func (c *Client) GetScoreboardV3(ctx context.Context, params ScoreboardV3Params)
 		"LeagueID": params.LeagueId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "scoreboardv3", reqParams)
-	if err != nil {
+	var scoreboardResp ScoreboardV3Response
+	if _, err := c.httpClient.Get(ctx, "scoreboardv3", reqParams, &scoreboardResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch scoreboardv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch scoreboardv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from scoreboardv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal scoreboardv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	for _, g := range scoreboardResp.Scoreboard.Games {
+		if g.timeErr != nil {
+			c.logger.WarnContext(ctx, "Invalid game time in scoreboardv3, using the UTC time",
+				slog.String("game_id", g.GameID),
+				slog.String("error", g.timeErr.Error()))
+		}
 	}
 
 	c.logger.InfoContext(ctx, "Successfully fetched scoreboardv3",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("games_count", len(scoreboardResp.Scoreboard.Games)))
 
-	return &statsResp, nil
+	return &scoreboardResp, nil
 }
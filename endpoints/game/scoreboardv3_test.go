@@ -1,12 +1,15 @@
 package game
 
 import (
+	"bytes"
 	"context"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/utkonoser/nba-api-go/client"
 	"github.com/stretchr/testify/assert"
@@ -15,11 +18,34 @@
 
 func TestGetScoreboardV3(t *testing.T) {
 	mockResponse := `{
-		"resource": "scoreboardv3",
-		"parameters": {},
-		"resultSets": [
-
-		]
+		"meta": {"version": 1, "request": "http://nba.cloud/league/00/2023/10/24/scoreboard", "time": "2023-10-25 01:12:34.5634"},
+		"scoreboard": {
+			"gameDate": "2023-10-24",
+			"leagueId": "00",
+			"leagueName": "National Basketball Association",
+			"games": [{
+				"gameId": "0022300061", "gameCode": "20231024/LALDEN", "gameStatus": 3, "gameStatusText": "Final",
+				"period": 4, "gameClock": "", "gameTimeUTC": "2023-10-24T23:30:00Z", "gameEt": "2023-10-24T19:30:00Z",
+				"regulationPeriods": 4, "seriesGameNumber": "", "gameLabel": "", "gameSubLabel": "", "seriesText": "",
+				"ifNecessary": false, "seriesConference": "", "poRoundDesc": "", "gameSubtype": "", "isNeutral": false,
+				"gameLeaders": {
+					"homeLeaders": {"personId": 203999, "name": "Nikola Jokic", "playerSlug": "nikola-jokic", "jerseyNum": "15", "position": "C", "teamTricode": "DEN", "points": 29, "rebounds": 13, "assists": 11},
+					"awayLeaders": {"personId": 203076, "name": "Anthony Davis", "playerSlug": "anthony-davis", "jerseyNum": "3", "position": "F-C", "teamTricode": "LAL", "points": 17, "rebounds": 8, "assists": 4}
+				},
+				"broadcasters": {
+					"nationalBroadcasters": [{"broadcasterId": 1, "broadcastDisplay": "TNT", "broadcasterTeamId": -1, "broadcasterDescription": ""}],
+					"homeTvBroadcasters": [{"broadcasterId": 1466, "broadcastDisplay": "ALT", "broadcasterTeamId": 1610612743, "broadcasterDescription": ""}]
+				},
+				"homeTeam": {"teamId": 1610612743, "teamName": "Nuggets", "teamCity": "Denver", "teamTricode": "DEN", "teamSlug": "nuggets",
+					"wins": 1, "losses": 0, "score": 119, "seed": null, "inBonus": null, "timeoutsRemaining": 2,
+					"periods": [{"period": 1, "periodType": "REGULAR", "score": 29}, {"period": 2, "periodType": "REGULAR", "score": 30},
+						{"period": 3, "periodType": "REGULAR", "score": 30}, {"period": 4, "periodType": "REGULAR", "score": 30}]},
+				"awayTeam": {"teamId": 1610612747, "teamName": "Lakers", "teamCity": "Los Angeles", "teamTricode": "LAL", "teamSlug": "lakers",
+					"wins": 0, "losses": 1, "score": 107, "seed": null, "inBonus": null, "timeoutsRemaining": 1,
+					"periods": [{"period": 1, "periodType": "REGULAR", "score": 21}, {"period": 2, "periodType": "REGULAR", "score": 30},
+						{"period": 3, "periodType": "REGULAR", "score": 26}, {"period": 4, "periodType": "REGULAR", "score": 30}]}
+			}]
+		}
 	}`
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -38,11 +64,96 @@ func TestGetScoreboardV3(t *testing.T) {
 
 	params := ScoreboardV3Params{}
 
+	resp, err := c.GetScoreboardV3Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, resp.Scoreboard.Games, 1)
+	g := resp.Scoreboard.Games[0]
+	tipOff := time.Date(2023, time.October, 24, 23, 30, 0, 0, time.UTC)
+	assert.Equal(t, tipOff, g.GameTimeUTC)
+	assert.True(t, g.GameEt.Equal(tipOff))
+	assert.Equal(t, "19:30 EDT", g.GameEt.Format("15:04 MST"))
+	assert.Equal(t, "Nikola Jokic", g.GameLeaders.HomeLeaders.Name)
+	assert.Equal(t, "TNT", g.Broadcasters.NationalBroadcasters[0].BroadcastDisplay)
+	assert.Equal(t, 107, g.AwayTeam.Score)
+	require.Len(t, g.HomeTeam.Periods, 4)
+
 	response, err := c.GetScoreboardV3(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "scoreboardv3", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+	var names []string
+	for _, rs := range response.ResultSets {
+		names = append(names, rs.Name)
+	}
+	assert.Equal(t, []string{"ScoreboardInfo", "GameHeader", "LineScore", "GameLeaders"}, names)
+
+	header, err := response.GetDataSet("GameHeader")
+	require.NoError(t, err)
+	row, err := header.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-10-24T23:30:00Z", row["gameTimeUTC"])
+	assert.Equal(t, "2023-10-24T19:30:00-04:00", row["gameEt"])
+
+	lineScore, err := response.GetDataSet("LineScore")
+	require.NoError(t, err)
+	require.Equal(t, 2, lineScore.RowCount())
+	row, err = lineScore.GetRow(1)
+	require.NoError(t, err)
+	assert.Equal(t, "LAL", row["teamTricode"])
+	assert.Equal(t, float64(26), row["period3Score"])
+	assert.Equal(t, float64(107), row["score"])
+}
+
+func TestGetScoreboardV3_InvalidGameTime(t *testing.T) {
+	mockResponse := `{
+		"meta": {"version": 1, "request": "http://nba.cloud/league/00/2023/10/24/scoreboard", "time": "2023-10-25 01:12:34.5634"},
+		"scoreboard": {
+			"gameDate": "2023-10-24",
+			"leagueId": "00",
+			"leagueName": "National Basketball Association",
+			"games": [
+				{"gameId": "0022300061", "gameTimeUTC": "2023-10-24T23:30:00Z", "gameEt": "2023-10-24T20:30:00Z"},
+				{"gameId": "0022300062", "gameTimeUTC": "not a time", "gameEt": "2023-10-24T22:00:00Z"}
+			]
+		}
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(mockResponse))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	httpClient := client.NewHTTPClient(server.URL+"/%s", DefaultHeaders(), logger)
+	c := &Client{
+		httpClient: httpClient,
+		logger:     logger,
+	}
+
+	resp, err := c.GetScoreboardV3Typed(context.Background(), ScoreboardV3Params{})
+
+	require.NoError(t, err, "a mismatched game must not fail the scoreboard")
+	require.Len(t, resp.Scoreboard.Games, 2)
+	mismatched := resp.Scoreboard.Games[0]
+	tipOff := time.Date(2023, time.October, 24, 23, 30, 0, 0, time.UTC)
+	assert.True(t, mismatched.GameEt.Equal(tipOff), "falls back to the UTC time")
+	assert.Equal(t, "19:30 EDT", mismatched.GameEt.Format("15:04 MST"))
+	noUTC := resp.Scoreboard.Games[1]
+	assert.True(t, noUTC.GameTimeUTC.IsZero())
+	assert.Equal(t, "22:00 EDT", noUTC.GameEt.Format("15:04 MST"), "read in America/New_York without a UTC time")
+	assert.Equal(t, 2, strings.Count(logs.String(), "Invalid game time in scoreboardv3"))
+
+	response, err := c.GetScoreboardV3(context.Background(), ScoreboardV3Params{})
+
+	require.NoError(t, err)
+	header, err := response.GetDataSet("GameHeader")
+	require.NoError(t, err)
+	row, err := header.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-10-24T19:30:00-04:00", row["gameEt"])
 }
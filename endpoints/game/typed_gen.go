@@ -0,0 +1,53 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package game
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameRotationResult holds the decoded result sets of the gamerotation
+// endpoint.
+type GameRotationResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	AwayTeam []GameRotationTeamRow
+	HomeTeam []GameRotationTeamRow
+}
+
+// GetGameRotationTyped is like GetGameRotation but decodes the result sets
+// into a GameRotationResult.
+func (c *Client) GetGameRotationTyped(ctx context.Context, params GameRotationParams) (*GameRotationResult, error) {
+	resp, err := c.GetGameRotation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GameRotationResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"AwayTeam": &result.AwayTeam,
+		"HomeTeam": &result.HomeTeam,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode gamerotation: %w", err)
+	}
+	return result, nil
+}
+
+// GameRotationTeamRow is a row of the gamerotation AwayTeam and gamerotation
+// HomeTeam result sets.
+type GameRotationTeamRow struct {
+	GameID      string  `nba:"GAME_ID"`
+	TeamID      int     `nba:"TEAM_ID"`
+	TeamCity    string  `nba:"TEAM_CITY"`
+	TeamName    string  `nba:"TEAM_NAME"`
+	PersonID    int     `nba:"PERSON_ID"`
+	PlayerFirst string  `nba:"PLAYER_FIRST"`
+	PlayerLast  string  `nba:"PLAYER_LAST"`
+	InTimeReal  float64 `nba:"IN_TIME_REAL"`
+	OutTimeReal float64 `nba:"OUT_TIME_REAL"`
+	PlayerPTS   float64 `nba:"PLAYER_PTS"`
+	PtDiff      float64 `nba:"PT_DIFF"`
+	UsgPct      float64 `nba:"USG_PCT"`
+}
@@ -25,25 +25,13 @@ func (c *Client) GetAllTimeLeadersGrids(ctx context.Context, params AllTimeLeade
 		"TopX": params.Topx,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "alltimeleadersgrids", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "alltimeleadersgrids", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch alltimeleadersgrids",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch alltimeleadersgrids: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from alltimeleadersgrids endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal alltimeleadersgrids response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched alltimeleadersgrids",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -27,25 +27,13 @@ func (c *Client) GetAssistLeaders(ctx context.Context, params AssistLeadersParam
 		"SeasonType": params.SeasonTypePlayoffs,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "assistleaders", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "assistleaders", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch assistleaders",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch assistleaders: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from assistleaders endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal assistleaders response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched assistleaders",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
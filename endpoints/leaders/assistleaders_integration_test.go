@@ -9,6 +9,7 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/vcr"
 )
 
 func TestGetAssistLeaders_Integration(t *testing.T) {
@@ -25,6 +26,7 @@ func TestGetAssistLeaders_Integration(t *testing.T) {
 	response, err := client.GetAssistLeaders(ctx, params)
 
 	if err != nil {
+		require.NotErrorIs(t, err, vcr.ErrFixtureNotFound, "no replay fixture, run make test-record or make test-seed")
 		t.Logf("AssistLeaders endpoint error: %v", err)
 		t.Skip("Endpoint may be unavailable or parameters incorrect")
 		return
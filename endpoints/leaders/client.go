@@ -1,6 +1,8 @@
 // Package leaders provides access to NBA Stats API leaders endpoints.
 package leaders
 
+//go:generate go run ../../internal/gentyped
+
 import (
 	"log/slog"
 
@@ -13,20 +15,7 @@
 
 // DefaultHeaders returns the default headers for NBA Stats API requests.
 func DefaultHeaders() map[string]string {
-	return map[string]string{
-		"Host":            "stats.nba.com",
-		"User-Agent":      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Safari/537.36",
-		"Accept":          "application/json, text/plain, */*",
-		"Accept-Language": "en-US,en;q=0.5",
-		"Accept-Encoding": "gzip, deflate, br",
-		"Connection":      "keep-alive",
-		"Referer":         "https://stats.nba.com/",
-		"Pragma":          "no-cache",
-		"Cache-Control":   "no-cache",
-		"Sec-Ch-Ua":       `"Chromium";v="140", "Google Chrome";v="140", "Not;A=Brand";v="24"`,
-		"Sec-Ch-Ua-Mobile": "?0",
-		"Sec-Fetch-Dest":  "empty",
-	}
+	return client.DefaultStatsHeaders()
 }
 
 // Client provides access to NBA Stats API leaders endpoints.
@@ -36,12 +25,12 @@ type Client struct {
 }
 
 // NewClient creates a new NBA Stats API leaders client.
-func NewClient(logger *slog.Logger) *Client {
+func NewClient(logger *slog.Logger, opts ...client.Option) *Client {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
-	httpClient := client.NewHTTPClient(baseURL, DefaultHeaders(), logger)
+	httpClient := client.NewHTTPClient(baseURL, DefaultHeaders(), logger, opts...)
 
 	return &Client{
 		httpClient: httpClient,
@@ -50,15 +39,78 @@ func NewClient(logger *slog.Logger) *Client {
 }
 
 // NewClientWithHeaders creates a new NBA Stats API leaders client with custom headers.
-func NewClientWithHeaders(headers map[string]string, logger *slog.Logger) *Client {
+func NewClientWithHeaders(headers map[string]string, logger *slog.Logger, opts ...client.Option) *Client {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
-	httpClient := client.NewHTTPClient(baseURL, headers, logger)
+	httpClient := client.NewHTTPClient(baseURL, headers, logger, opts...)
+
+	return &Client{
+		httpClient: httpClient,
+		logger:     logger,
+	}
+}
+
+// NewClientWithHTTPClient creates a new NBA Stats API leaders client that sends requests
+// through an existing HTTP client, which may be shared with other clients.
+func NewClientWithHTTPClient(httpClient *client.HTTPClient, logger *slog.Logger) *Client {
+	if logger == nil {
+		logger = slog.Default()
+	}
 
 	return &Client{
 		httpClient: httpClient,
 		logger:     logger,
 	}
 }
+
+// SetRetryPolicy sets the policy used to retry failed requests.
+func (c *Client) SetRetryPolicy(policy client.RetryPolicy) {
+	c.httpClient.SetRetryPolicy(policy)
+}
+
+// SetRateLimiter sets a rate limiter that may be shared with other clients.
+func (c *Client) SetRateLimiter(limiter *client.RateLimiter) {
+	c.httpClient.SetRateLimiter(limiter)
+}
+
+// SetCache sets the cache consulted before sending requests; nil disables caching.
+func (c *Client) SetCache(cache client.Cache) {
+	c.httpClient.SetCache(cache)
+}
+
+// SetCachePolicy sets the policy deciding how long each endpoint is cached.
+func (c *Client) SetCachePolicy(policy client.CachePolicy) {
+	c.httpClient.SetCachePolicy(policy)
+}
+
+// Use adds interceptors that run around every request made by the client.
+func (c *Client) Use(interceptors ...client.Interceptor) {
+	c.httpClient.Use(interceptors...)
+}
+
+// SetInstrumentation sets the metrics and tracing hooks notified of every request.
+func (c *Client) SetInstrumentation(instrumentation client.Instrumentation) {
+	c.httpClient.SetInstrumentation(instrumentation)
+}
+
+// SetDeduplication enables or disables coalescing of concurrent identical requests.
+func (c *Client) SetDeduplication(enabled bool) {
+	c.httpClient.SetDeduplication(enabled)
+}
+
+// SetCircuitBreaker sets the circuit breaker consulted before every request; nil disables it.
+func (c *Client) SetCircuitBreaker(breaker *client.CircuitBreaker) {
+	c.httpClient.SetCircuitBreaker(breaker)
+}
+
+// SetHeaderRotator sets the rotator choosing the browser headers of every request; nil disables it.
+func (c *Client) SetHeaderRotator(rotator *client.HeaderRotator) {
+	c.httpClient.SetHeaderRotator(rotator)
+}
+
+// SetTimeoutPolicy sets the policy deciding the timeout of each endpoint.
+func (c *Client) SetTimeoutPolicy(policy client.TimeoutPolicy) {
+	c.httpClient.SetTimeoutPolicy(policy)
+}
@@ -0,0 +1,299 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package leaders
+
+import (
+	"context"
+	"fmt"
+)
+
+// AllTimeLeadersGridsResult holds the decoded result sets of the
+// alltimeleadersgrids endpoint.
+type AllTimeLeadersGridsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	ASTLeaders    []AllTimeLeadersGridsASTLeadersRow
+	BLKLeaders    []AllTimeLeadersGridsBLKLeadersRow
+	DREBLeaders   []AllTimeLeadersGridsDREBLeadersRow
+	FG3ALeaders   []AllTimeLeadersGridsFG3ALeadersRow
+	FG3MLeaders   []AllTimeLeadersGridsFG3MLeadersRow
+	FG3PCTLeaders []AllTimeLeadersGridsFG3PCTLeadersRow
+	FGALeaders    []AllTimeLeadersGridsFGALeadersRow
+	FGMLeaders    []AllTimeLeadersGridsFGMLeadersRow
+	FGPCTLeaders  []AllTimeLeadersGridsFGPCTLeadersRow
+	FTALeaders    []AllTimeLeadersGridsFTALeadersRow
+	FTMLeaders    []AllTimeLeadersGridsFTMLeadersRow
+	FTPCTLeaders  []AllTimeLeadersGridsFTPCTLeadersRow
+	GPLeaders     []AllTimeLeadersGridsGPLeadersRow
+	OREBLeaders   []AllTimeLeadersGridsOREBLeadersRow
+	PFLeaders     []AllTimeLeadersGridsPFLeadersRow
+	PTSLeaders    []AllTimeLeadersGridsPTSLeadersRow
+	REBLeaders    []AllTimeLeadersGridsREBLeadersRow
+	STLLeaders    []AllTimeLeadersGridsSTLLeadersRow
+	TOVLeaders    []AllTimeLeadersGridsTOVLeadersRow
+}
+
+// GetAllTimeLeadersGridsTyped is like GetAllTimeLeadersGrids but decodes the
+// result sets into a AllTimeLeadersGridsResult.
+func (c *Client) GetAllTimeLeadersGridsTyped(ctx context.Context, params AllTimeLeadersGridsParams) (*AllTimeLeadersGridsResult, error) {
+	resp, err := c.GetAllTimeLeadersGrids(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AllTimeLeadersGridsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"ASTLeaders":     &result.ASTLeaders,
+		"BLKLeaders":     &result.BLKLeaders,
+		"DREBLeaders":    &result.DREBLeaders,
+		"FG3ALeaders":    &result.FG3ALeaders,
+		"FG3MLeaders":    &result.FG3MLeaders,
+		"FG3_PCTLeaders": &result.FG3PCTLeaders,
+		"FGALeaders":     &result.FGALeaders,
+		"FGMLeaders":     &result.FGMLeaders,
+		"FG_PCTLeaders":  &result.FGPCTLeaders,
+		"FTALeaders":     &result.FTALeaders,
+		"FTMLeaders":     &result.FTMLeaders,
+		"FT_PCTLeaders":  &result.FTPCTLeaders,
+		"GPLeaders":      &result.GPLeaders,
+		"OREBLeaders":    &result.OREBLeaders,
+		"PFLeaders":      &result.PFLeaders,
+		"PTSLeaders":     &result.PTSLeaders,
+		"REBLeaders":     &result.REBLeaders,
+		"STLLeaders":     &result.STLLeaders,
+		"TOVLeaders":     &result.TOVLeaders,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode alltimeleadersgrids: %w", err)
+	}
+	return result, nil
+}
+
+// AssistLeadersResult holds the decoded result sets of the assistleaders
+// endpoint.
+type AssistLeadersResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	AssistLeaders []AssistLeadersRow
+}
+
+// GetAssistLeadersTyped is like GetAssistLeaders but decodes the result sets
+// into a AssistLeadersResult.
+func (c *Client) GetAssistLeadersTyped(ctx context.Context, params AssistLeadersParams) (*AssistLeadersResult, error) {
+	resp, err := c.GetAssistLeaders(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AssistLeadersResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"AssistLeaders": &result.AssistLeaders,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode assistleaders: %w", err)
+	}
+	return result, nil
+}
+
+// AllTimeLeadersGridsASTLeadersRow is a row of the alltimeleadersgrids
+// ASTLeaders result set.
+type AllTimeLeadersGridsASTLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	AST          float64 `nba:"AST"`
+	ASTRank      int     `nba:"AST_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsBLKLeadersRow is a row of the alltimeleadersgrids
+// BLKLeaders result set.
+type AllTimeLeadersGridsBLKLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	BLK          float64 `nba:"BLK"`
+	BLKRank      int     `nba:"BLK_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsDREBLeadersRow is a row of the alltimeleadersgrids
+// DREBLeaders result set.
+type AllTimeLeadersGridsDREBLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	DREB         float64 `nba:"DREB"`
+	DREBRank     int     `nba:"DREB_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFG3ALeadersRow is a row of the alltimeleadersgrids
+// FG3ALeaders result set.
+type AllTimeLeadersGridsFG3ALeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FG3A         float64 `nba:"FG3A"`
+	FG3ARank     int     `nba:"FG3A_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFG3MLeadersRow is a row of the alltimeleadersgrids
+// FG3MLeaders result set.
+type AllTimeLeadersGridsFG3MLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FG3M         float64 `nba:"FG3M"`
+	FG3MRank     int     `nba:"FG3M_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFG3PCTLeadersRow is a row of the alltimeleadersgrids
+// FG3_PCTLeaders result set.
+type AllTimeLeadersGridsFG3PCTLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FG3Pct       float64 `nba:"FG3_PCT"`
+	FG3PctRank   int     `nba:"FG3_PCT_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFGALeadersRow is a row of the alltimeleadersgrids
+// FGALeaders result set.
+type AllTimeLeadersGridsFGALeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FGA          float64 `nba:"FGA"`
+	FGARank      int     `nba:"FGA_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFGMLeadersRow is a row of the alltimeleadersgrids
+// FGMLeaders result set.
+type AllTimeLeadersGridsFGMLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FGM          float64 `nba:"FGM"`
+	FGMRank      int     `nba:"FGM_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFGPCTLeadersRow is a row of the alltimeleadersgrids
+// FG_PCTLeaders result set.
+type AllTimeLeadersGridsFGPCTLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FGPct        float64 `nba:"FG_PCT"`
+	FGPctRank    int     `nba:"FG_PCT_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFTALeadersRow is a row of the alltimeleadersgrids
+// FTALeaders result set.
+type AllTimeLeadersGridsFTALeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FTA          float64 `nba:"FTA"`
+	FTARank      int     `nba:"FTA_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFTMLeadersRow is a row of the alltimeleadersgrids
+// FTMLeaders result set.
+type AllTimeLeadersGridsFTMLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FTM          float64 `nba:"FTM"`
+	FTMRank      int     `nba:"FTM_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsFTPCTLeadersRow is a row of the alltimeleadersgrids
+// FT_PCTLeaders result set.
+type AllTimeLeadersGridsFTPCTLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	FTPct        float64 `nba:"FT_PCT"`
+	FTPctRank    int     `nba:"FT_PCT_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsGPLeadersRow is a row of the alltimeleadersgrids
+// GPLeaders result set.
+type AllTimeLeadersGridsGPLeadersRow struct {
+	PlayerID     int    `nba:"PLAYER_ID"`
+	PlayerName   string `nba:"PLAYER_NAME"`
+	GP           int    `nba:"GP"`
+	GPRank       int    `nba:"GP_RANK"`
+	IsActiveFlag string `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsOREBLeadersRow is a row of the alltimeleadersgrids
+// OREBLeaders result set.
+type AllTimeLeadersGridsOREBLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	OREB         float64 `nba:"OREB"`
+	OREBRank     int     `nba:"OREB_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsPFLeadersRow is a row of the alltimeleadersgrids
+// PFLeaders result set.
+type AllTimeLeadersGridsPFLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	PF           float64 `nba:"PF"`
+	PFRank       int     `nba:"PF_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsPTSLeadersRow is a row of the alltimeleadersgrids
+// PTSLeaders result set.
+type AllTimeLeadersGridsPTSLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	PTS          float64 `nba:"PTS"`
+	PTSRank      int     `nba:"PTS_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsREBLeadersRow is a row of the alltimeleadersgrids
+// REBLeaders result set.
+type AllTimeLeadersGridsREBLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	REB          float64 `nba:"REB"`
+	REBRank      int     `nba:"REB_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsSTLLeadersRow is a row of the alltimeleadersgrids
+// STLLeaders result set.
+type AllTimeLeadersGridsSTLLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	STL          float64 `nba:"STL"`
+	STLRank      int     `nba:"STL_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AllTimeLeadersGridsTOVLeadersRow is a row of the alltimeleadersgrids
+// TOVLeaders result set.
+type AllTimeLeadersGridsTOVLeadersRow struct {
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	TOV          float64 `nba:"TOV"`
+	TOVRank      int     `nba:"TOV_RANK"`
+	IsActiveFlag string  `nba:"IS_ACTIVE_FLAG"`
+}
+
+// AssistLeadersRow is a row of the assistleaders AssistLeaders result set.
+type AssistLeadersRow struct {
+	Rank             int     `nba:"RANK"`
+	PlayerID         int     `nba:"PLAYER_ID"`
+	Player           string  `nba:"PLAYER"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	JerseyNum        string  `nba:"JERSEY_NUM"`
+	PlayerPosition   string  `nba:"PLAYER_POSITION"`
+	AST              float64 `nba:"AST"`
+}
@@ -69,25 +69,13 @@ func (c *Client) GetLeagueDashOppPtShot(ctx context.Context, params LeagueDashOp
 		"VsDivision": params.VsDivisionNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguedashoppptshot", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguedashoppptshot", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguedashoppptshot",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguedashoppptshot: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguedashoppptshot endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguedashoppptshot response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguedashoppptshot",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
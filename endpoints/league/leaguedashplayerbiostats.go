@@ -79,25 +79,13 @@ func (c *Client) GetLeagueDashPlayerBioStats(ctx context.Context, params LeagueD
 		"Weight": params.WeightNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguedashplayerbiostats", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguedashplayerbiostats", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguedashplayerbiostats",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguedashplayerbiostats: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguedashplayerbiostats endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguedashplayerbiostats response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguedashplayerbiostats",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
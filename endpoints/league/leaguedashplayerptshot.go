@@ -87,25 +87,13 @@ func (c *Client) GetLeagueDashPlayerPtShot(ctx context.Context, params LeagueDas
 		"Weight": params.WeightNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguedashplayerptshot", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguedashplayerptshot", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguedashplayerptshot",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguedashplayerptshot: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguedashplayerptshot endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguedashplayerptshot response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguedashplayerptshot",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
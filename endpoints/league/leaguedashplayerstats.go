@@ -89,25 +89,13 @@ func (c *Client) GetLeagueDashPlayerStats(ctx context.Context, params LeagueDash
 		"Weight": params.WeightNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguedashplayerstats", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguedashplayerstats", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguedashplayerstats",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguedashplayerstats: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguedashplayerstats endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguedashplayerstats response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguedashplayerstats",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
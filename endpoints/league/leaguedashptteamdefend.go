@@ -59,25 +59,13 @@ func (c *Client) GetLeagueDashPtTeamDefend(ctx context.Context, params LeagueDas
 		"VsDivision": params.VsDivisionNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguedashptteamdefend", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguedashptteamdefend", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguedashptteamdefend",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguedashptteamdefend: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguedashptteamdefend endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguedashptteamdefend response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguedashptteamdefend",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
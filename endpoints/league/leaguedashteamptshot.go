@@ -69,25 +69,13 @@ func (c *Client) GetLeagueDashTeamPtShot(ctx context.Context, params LeagueDashT
 		"VsDivision": params.VsDivisionNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguedashteamptshot", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguedashteamptshot", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguedashteamptshot",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguedashteamptshot: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguedashteamptshot endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguedashteamptshot response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguedashteamptshot",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
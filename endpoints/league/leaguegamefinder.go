@@ -193,25 +193,13 @@ func (c *Client) GetLeagueGameFinder(ctx context.Context, params LeagueGameFinde
 		"YearsExperience": params.YearsExperienceNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguegamefinder", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguegamefinder", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguegamefinder",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguegamefinder: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguegamefinder endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguegamefinder response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguegamefinder",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -35,25 +35,13 @@ func (c *Client) GetLeagueGameLog(ctx context.Context, params LeagueGameLogParam
 		"DateTo": params.DateToNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguegamelog", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguegamelog", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguegamelog",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguegamelog: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguegamelog endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguegamelog response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguegamelog",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -31,25 +31,13 @@ func (c *Client) GetLeagueLeaders(ctx context.Context, params LeagueLeadersParam
 		"ActiveFlag": params.ActiveFlagNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leagueleaders", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leagueleaders", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leagueleaders",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leagueleaders: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leagueleaders endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leagueleaders response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leagueleaders",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
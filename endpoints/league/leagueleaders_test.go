@@ -50,3 +50,41 @@ func TestGetLeagueLeaders(t *testing.T) {
 	// Note: ResultSets may be empty for some endpoints
 	assert.NotNil(t, response.ResultSets)
 }
+
+func TestGetLeagueLeaders_SingleResultSet(t *testing.T) {
+	mockResponse := `{
+		"resource": "leagueleaders",
+		"parameters": {"LeagueID": "00", "PerMode": "PerGame", "StatCategory": "PTS", "Season": "2023-24", "SeasonType": "Regular Season", "Scope": "S", "ActiveFlag": null},
+		"resultSet": {
+			"name": "LeagueLeaders",
+			"headers": ["PLAYER_ID", "RANK", "PLAYER", "TEAM_ID", "TEAM", "GP", "MIN", "PTS"],
+			"rowSet": [
+				[1629029, 1, "Luka Doncic", 1610612742, "DAL", 70, 37.5, 33.9],
+				[203507, 2, "Giannis Antetokounmpo", 1610612749, "MIL", 73, 35.2, 30.4]
+			]
+		}
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(mockResponse))
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	c := NewClient(logger, client.WithBaseURL(server.URL+"/%s"))
+
+	response, err := c.GetLeagueLeaders(context.Background(), LeagueLeadersParams{})
+
+	require.NoError(t, err)
+	require.Len(t, response.ResultSets, 1)
+	dataset, err := response.GetDataSet("LeagueLeaders")
+	require.NoError(t, err)
+	assert.Equal(t, 2, dataset.RowCount())
+
+	typed, err := c.GetLeagueLeadersTyped(context.Background(), LeagueLeadersParams{})
+	require.NoError(t, err)
+	require.Len(t, typed.LeagueLeaders, 2)
+	assert.Equal(t, "Luka Doncic", typed.LeagueLeaders[0].Player)
+	assert.Equal(t, 33.9, typed.LeagueLeaders[0].PTS)
+}
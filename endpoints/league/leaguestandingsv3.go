@@ -25,25 +25,13 @@ func (c *Client) GetLeagueStandingsV3(ctx context.Context, params LeagueStanding
 		"SeasonYear": params.SeasonNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "leaguestandingsv3", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "leaguestandingsv3", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch leaguestandingsv3",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch leaguestandingsv3: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from leaguestandingsv3 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal leaguestandingsv3 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched leaguestandingsv3",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -0,0 +1,641 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package league
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LeagueDashOppPtShotResult holds the decoded result sets of the
+// leaguedashoppptshot endpoint.
+type LeagueDashOppPtShotResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueDashPTShots []LeagueDashOppPtShotLeagueDashPTShotsRow
+}
+
+// GetLeagueDashOppPtShotTyped is like GetLeagueDashOppPtShot but decodes the
+// result sets into a LeagueDashOppPtShotResult.
+func (c *Client) GetLeagueDashOppPtShotTyped(ctx context.Context, params LeagueDashOppPtShotParams) (*LeagueDashOppPtShotResult, error) {
+	resp, err := c.GetLeagueDashOppPtShot(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueDashOppPtShotResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueDashPTShots": &result.LeagueDashPTShots,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguedashoppptshot: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueDashPlayerBioStatsResult holds the decoded result sets of the
+// leaguedashplayerbiostats endpoint.
+type LeagueDashPlayerBioStatsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueDashPlayerBioStats []LeagueDashPlayerBioStatsRow
+}
+
+// GetLeagueDashPlayerBioStatsTyped is like GetLeagueDashPlayerBioStats but
+// decodes the result sets into a LeagueDashPlayerBioStatsResult.
+func (c *Client) GetLeagueDashPlayerBioStatsTyped(ctx context.Context, params LeagueDashPlayerBioStatsParams) (*LeagueDashPlayerBioStatsResult, error) {
+	resp, err := c.GetLeagueDashPlayerBioStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueDashPlayerBioStatsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueDashPlayerBioStats": &result.LeagueDashPlayerBioStats,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguedashplayerbiostats: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueDashPlayerPtShotResult holds the decoded result sets of the
+// leaguedashplayerptshot endpoint.
+type LeagueDashPlayerPtShotResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueDashPTShots []LeagueDashPlayerPtShotLeagueDashPTShotsRow
+}
+
+// GetLeagueDashPlayerPtShotTyped is like GetLeagueDashPlayerPtShot but
+// decodes the result sets into a LeagueDashPlayerPtShotResult.
+func (c *Client) GetLeagueDashPlayerPtShotTyped(ctx context.Context, params LeagueDashPlayerPtShotParams) (*LeagueDashPlayerPtShotResult, error) {
+	resp, err := c.GetLeagueDashPlayerPtShot(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueDashPlayerPtShotResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueDashPTShots": &result.LeagueDashPTShots,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguedashplayerptshot: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueDashPlayerStatsResult holds the decoded result sets of the
+// leaguedashplayerstats endpoint.
+type LeagueDashPlayerStatsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueDashPlayerStats []LeagueDashPlayerStatsRow
+}
+
+// GetLeagueDashPlayerStatsTyped is like GetLeagueDashPlayerStats but decodes
+// the result sets into a LeagueDashPlayerStatsResult.
+func (c *Client) GetLeagueDashPlayerStatsTyped(ctx context.Context, params LeagueDashPlayerStatsParams) (*LeagueDashPlayerStatsResult, error) {
+	resp, err := c.GetLeagueDashPlayerStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueDashPlayerStatsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueDashPlayerStats": &result.LeagueDashPlayerStats,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguedashplayerstats: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueDashPtTeamDefendResult holds the decoded result sets of the
+// leaguedashptteamdefend endpoint.
+type LeagueDashPtTeamDefendResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueDashPtTeamDefend []LeagueDashPtTeamDefendRow
+}
+
+// GetLeagueDashPtTeamDefendTyped is like GetLeagueDashPtTeamDefend but
+// decodes the result sets into a LeagueDashPtTeamDefendResult.
+func (c *Client) GetLeagueDashPtTeamDefendTyped(ctx context.Context, params LeagueDashPtTeamDefendParams) (*LeagueDashPtTeamDefendResult, error) {
+	resp, err := c.GetLeagueDashPtTeamDefend(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueDashPtTeamDefendResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueDashPtTeamDefend": &result.LeagueDashPtTeamDefend,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguedashptteamdefend: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueDashTeamPtShotResult holds the decoded result sets of the
+// leaguedashteamptshot endpoint.
+type LeagueDashTeamPtShotResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueDashPTShots []LeagueDashTeamPtShotLeagueDashPTShotsRow
+}
+
+// GetLeagueDashTeamPtShotTyped is like GetLeagueDashTeamPtShot but decodes
+// the result sets into a LeagueDashTeamPtShotResult.
+func (c *Client) GetLeagueDashTeamPtShotTyped(ctx context.Context, params LeagueDashTeamPtShotParams) (*LeagueDashTeamPtShotResult, error) {
+	resp, err := c.GetLeagueDashTeamPtShot(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueDashTeamPtShotResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueDashPTShots": &result.LeagueDashPTShots,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguedashteamptshot: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueGameFinderResult holds the decoded result sets of the
+// leaguegamefinder endpoint.
+type LeagueGameFinderResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueGameFinderResults []LeagueGameFinderResultsRow
+}
+
+// GetLeagueGameFinderTyped is like GetLeagueGameFinder but decodes the result
+// sets into a LeagueGameFinderResult.
+func (c *Client) GetLeagueGameFinderTyped(ctx context.Context, params LeagueGameFinderParams) (*LeagueGameFinderResult, error) {
+	resp, err := c.GetLeagueGameFinder(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueGameFinderResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueGameFinderResults": &result.LeagueGameFinderResults,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguegamefinder: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueGameLogResult holds the decoded result sets of the leaguegamelog
+// endpoint.
+type LeagueGameLogResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueGameLog []LeagueGameLogRow
+}
+
+// GetLeagueGameLogTyped is like GetLeagueGameLog but decodes the result sets
+// into a LeagueGameLogResult.
+func (c *Client) GetLeagueGameLogTyped(ctx context.Context, params LeagueGameLogParams) (*LeagueGameLogResult, error) {
+	resp, err := c.GetLeagueGameLog(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueGameLogResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueGameLog": &result.LeagueGameLog,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguegamelog: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueLeadersResult holds the decoded result sets of the leagueleaders
+// endpoint.
+type LeagueLeadersResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueLeaders []LeagueLeadersRow
+}
+
+// GetLeagueLeadersTyped is like GetLeagueLeaders but decodes the result sets
+// into a LeagueLeadersResult.
+func (c *Client) GetLeagueLeadersTyped(ctx context.Context, params LeagueLeadersParams) (*LeagueLeadersResult, error) {
+	resp, err := c.GetLeagueLeaders(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueLeadersResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueLeaders": &result.LeagueLeaders,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leagueleaders: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueStandingsV3Result holds the decoded result sets of the
+// leaguestandingsv3 endpoint.
+type LeagueStandingsV3Result struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Standings []LeagueStandingsV3StandingsRow
+}
+
+// GetLeagueStandingsV3Typed is like GetLeagueStandingsV3 but decodes the
+// result sets into a LeagueStandingsV3Result.
+func (c *Client) GetLeagueStandingsV3Typed(ctx context.Context, params LeagueStandingsV3Params) (*LeagueStandingsV3Result, error) {
+	resp, err := c.GetLeagueStandingsV3(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LeagueStandingsV3Result{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Standings": &result.Standings,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode leaguestandingsv3: %w", err)
+	}
+	return result, nil
+}
+
+// LeagueDashOppPtShotLeagueDashPTShotsRow is a row of the leaguedashoppptshot
+// LeagueDashPTShots result set.
+type LeagueDashOppPtShotLeagueDashPTShotsRow struct {
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	GP               int     `nba:"GP"`
+	G                float64 `nba:"G"`
+	FGAFrequency     float64 `nba:"FGA_FREQUENCY"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	EFGPct           float64 `nba:"EFG_PCT"`
+	Fg2aFrequency    float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m             float64 `nba:"FG2M"`
+	Fg2a             float64 `nba:"FG2A"`
+	Fg2Pct           float64 `nba:"FG2_PCT"`
+	FG3AFrequency    float64 `nba:"FG3A_FREQUENCY"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+}
+
+// LeagueDashPlayerBioStatsRow is a row of the leaguedashplayerbiostats
+// LeagueDashPlayerBioStats result set.
+type LeagueDashPlayerBioStatsRow struct {
+	PlayerID           int     `nba:"PLAYER_ID"`
+	PlayerName         string  `nba:"PLAYER_NAME"`
+	TeamID             int     `nba:"TEAM_ID"`
+	TeamAbbreviation   string  `nba:"TEAM_ABBREVIATION"`
+	Age                float64 `nba:"AGE"`
+	PlayerHeight       string  `nba:"PLAYER_HEIGHT"`
+	PlayerHeightInches float64 `nba:"PLAYER_HEIGHT_INCHES"`
+	PlayerWeight       string  `nba:"PLAYER_WEIGHT"`
+	College            string  `nba:"COLLEGE"`
+	Country            string  `nba:"COUNTRY"`
+	DraftYear          string  `nba:"DRAFT_YEAR"`
+	DraftRound         string  `nba:"DRAFT_ROUND"`
+	DraftNumber        string  `nba:"DRAFT_NUMBER"`
+	GP                 int     `nba:"GP"`
+	PTS                float64 `nba:"PTS"`
+	REB                float64 `nba:"REB"`
+	AST                float64 `nba:"AST"`
+	NetRating          float64 `nba:"NET_RATING"`
+	OREBPct            float64 `nba:"OREB_PCT"`
+	DREBPct            float64 `nba:"DREB_PCT"`
+	UsgPct             float64 `nba:"USG_PCT"`
+	TSPct              float64 `nba:"TS_PCT"`
+	ASTPct             float64 `nba:"AST_PCT"`
+}
+
+// LeagueDashPlayerPtShotLeagueDashPTShotsRow is a row of the
+// leaguedashplayerptshot LeagueDashPTShots result set.
+type LeagueDashPlayerPtShotLeagueDashPTShotsRow struct {
+	PlayerID                   int     `nba:"PLAYER_ID"`
+	PlayerName                 string  `nba:"PLAYER_NAME"`
+	PlayerLastTeamID           int     `nba:"PLAYER_LAST_TEAM_ID"`
+	PlayerLastTeamAbbreviation string  `nba:"PLAYER_LAST_TEAM_ABBREVIATION"`
+	Age                        float64 `nba:"AGE"`
+	GP                         int     `nba:"GP"`
+	G                          float64 `nba:"G"`
+	FGAFrequency               float64 `nba:"FGA_FREQUENCY"`
+	FGM                        float64 `nba:"FGM"`
+	FGA                        float64 `nba:"FGA"`
+	FGPct                      float64 `nba:"FG_PCT"`
+	EFGPct                     float64 `nba:"EFG_PCT"`
+	Fg2aFrequency              float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m                       float64 `nba:"FG2M"`
+	Fg2a                       float64 `nba:"FG2A"`
+	Fg2Pct                     float64 `nba:"FG2_PCT"`
+	FG3AFrequency              float64 `nba:"FG3A_FREQUENCY"`
+	FG3M                       float64 `nba:"FG3M"`
+	FG3A                       float64 `nba:"FG3A"`
+	FG3Pct                     float64 `nba:"FG3_PCT"`
+}
+
+// LeagueDashPlayerStatsRow is a row of the leaguedashplayerstats
+// LeagueDashPlayerStats result set.
+type LeagueDashPlayerStatsRow struct {
+	PlayerID           int     `nba:"PLAYER_ID"`
+	PlayerName         string  `nba:"PLAYER_NAME"`
+	Nickname           string  `nba:"NICKNAME"`
+	TeamID             int     `nba:"TEAM_ID"`
+	TeamAbbreviation   string  `nba:"TEAM_ABBREVIATION"`
+	Age                float64 `nba:"AGE"`
+	GP                 int     `nba:"GP"`
+	W                  int     `nba:"W"`
+	L                  int     `nba:"L"`
+	WPct               float64 `nba:"W_PCT"`
+	Min                float64 `nba:"MIN"`
+	FGM                float64 `nba:"FGM"`
+	FGA                float64 `nba:"FGA"`
+	FGPct              float64 `nba:"FG_PCT"`
+	FG3M               float64 `nba:"FG3M"`
+	FG3A               float64 `nba:"FG3A"`
+	FG3Pct             float64 `nba:"FG3_PCT"`
+	FTM                float64 `nba:"FTM"`
+	FTA                float64 `nba:"FTA"`
+	FTPct              float64 `nba:"FT_PCT"`
+	OREB               float64 `nba:"OREB"`
+	DREB               float64 `nba:"DREB"`
+	REB                float64 `nba:"REB"`
+	AST                float64 `nba:"AST"`
+	TOV                float64 `nba:"TOV"`
+	STL                float64 `nba:"STL"`
+	BLK                float64 `nba:"BLK"`
+	BLKA               float64 `nba:"BLKA"`
+	PF                 float64 `nba:"PF"`
+	PFD                float64 `nba:"PFD"`
+	PTS                float64 `nba:"PTS"`
+	PlusMinus          float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS      float64 `nba:"NBA_FANTASY_PTS"`
+	DD2                float64 `nba:"DD2"`
+	TD3                float64 `nba:"TD3"`
+	WnbaFantasyPTS     float64 `nba:"WNBA_FANTASY_PTS"`
+	GPRank             int     `nba:"GP_RANK"`
+	WRank              int     `nba:"W_RANK"`
+	LRank              int     `nba:"L_RANK"`
+	WPctRank           int     `nba:"W_PCT_RANK"`
+	MinRank            int     `nba:"MIN_RANK"`
+	FGMRank            int     `nba:"FGM_RANK"`
+	FGARank            int     `nba:"FGA_RANK"`
+	FGPctRank          int     `nba:"FG_PCT_RANK"`
+	FG3MRank           int     `nba:"FG3M_RANK"`
+	FG3ARank           int     `nba:"FG3A_RANK"`
+	FG3PctRank         int     `nba:"FG3_PCT_RANK"`
+	FTMRank            int     `nba:"FTM_RANK"`
+	FTARank            int     `nba:"FTA_RANK"`
+	FTPctRank          int     `nba:"FT_PCT_RANK"`
+	OREBRank           int     `nba:"OREB_RANK"`
+	DREBRank           int     `nba:"DREB_RANK"`
+	REBRank            int     `nba:"REB_RANK"`
+	ASTRank            int     `nba:"AST_RANK"`
+	TOVRank            int     `nba:"TOV_RANK"`
+	STLRank            int     `nba:"STL_RANK"`
+	BLKRank            int     `nba:"BLK_RANK"`
+	BLKARank           int     `nba:"BLKA_RANK"`
+	PFRank             int     `nba:"PF_RANK"`
+	PFDRank            int     `nba:"PFD_RANK"`
+	PTSRank            int     `nba:"PTS_RANK"`
+	PlusMinusRank      int     `nba:"PLUS_MINUS_RANK"`
+	NBAFantasyPTSRank  int     `nba:"NBA_FANTASY_PTS_RANK"`
+	DD2Rank            int     `nba:"DD2_RANK"`
+	TD3Rank            int     `nba:"TD3_RANK"`
+	WnbaFantasyPTSRank int     `nba:"WNBA_FANTASY_PTS_RANK"`
+}
+
+// LeagueDashPtTeamDefendRow is a row of the leaguedashptteamdefend
+// LeagueDashPtTeamDefend result set.
+type LeagueDashPtTeamDefendRow struct {
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	GP               int     `nba:"GP"`
+	G                float64 `nba:"G"`
+	Freq             float64 `nba:"FREQ"`
+	DFGM             float64 `nba:"D_FGM"`
+	DFGA             float64 `nba:"D_FGA"`
+	DFGPct           float64 `nba:"D_FG_PCT"`
+	NormalFGPct      float64 `nba:"NORMAL_FG_PCT"`
+	PctPlusminus     float64 `nba:"PCT_PLUSMINUS"`
+}
+
+// LeagueDashTeamPtShotLeagueDashPTShotsRow is a row of the
+// leaguedashteamptshot LeagueDashPTShots result set.
+type LeagueDashTeamPtShotLeagueDashPTShotsRow struct {
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	GP               int     `nba:"GP"`
+	G                float64 `nba:"G"`
+	FGAFrequency     float64 `nba:"FGA_FREQUENCY"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	EFGPct           float64 `nba:"EFG_PCT"`
+	Fg2aFrequency    float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m             float64 `nba:"FG2M"`
+	Fg2a             float64 `nba:"FG2A"`
+	Fg2Pct           float64 `nba:"FG2_PCT"`
+	FG3AFrequency    float64 `nba:"FG3A_FREQUENCY"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+}
+
+// LeagueGameFinderResultsRow is a row of the leaguegamefinder
+// LeagueGameFinderResults result set.
+type LeagueGameFinderResultsRow struct {
+	SeasonID         string    `nba:"SEASON_ID"`
+	TeamID           int       `nba:"TEAM_ID"`
+	TeamAbbreviation string    `nba:"TEAM_ABBREVIATION"`
+	TeamName         string    `nba:"TEAM_NAME"`
+	GameID           string    `nba:"GAME_ID"`
+	GameDate         time.Time `nba:"GAME_DATE"`
+	Matchup          string    `nba:"MATCHUP"`
+	WL               string    `nba:"WL"`
+	Min              float64   `nba:"MIN"`
+	PTS              float64   `nba:"PTS"`
+	FGM              float64   `nba:"FGM"`
+	FGA              float64   `nba:"FGA"`
+	FGPct            float64   `nba:"FG_PCT"`
+	FG3M             float64   `nba:"FG3M"`
+	FG3A             float64   `nba:"FG3A"`
+	FG3Pct           float64   `nba:"FG3_PCT"`
+	FTM              float64   `nba:"FTM"`
+	FTA              float64   `nba:"FTA"`
+	FTPct            float64   `nba:"FT_PCT"`
+	OREB             float64   `nba:"OREB"`
+	DREB             float64   `nba:"DREB"`
+	REB              float64   `nba:"REB"`
+	AST              float64   `nba:"AST"`
+	STL              float64   `nba:"STL"`
+	BLK              float64   `nba:"BLK"`
+	TOV              float64   `nba:"TOV"`
+	PF               float64   `nba:"PF"`
+	PlusMinus        float64   `nba:"PLUS_MINUS"`
+}
+
+// LeagueGameLogRow is a row of the leaguegamelog LeagueGameLog result set.
+type LeagueGameLogRow struct {
+	SeasonID         string    `nba:"SEASON_ID"`
+	TeamID           int       `nba:"TEAM_ID"`
+	TeamAbbreviation string    `nba:"TEAM_ABBREVIATION"`
+	TeamName         string    `nba:"TEAM_NAME"`
+	GameID           string    `nba:"GAME_ID"`
+	GameDate         time.Time `nba:"GAME_DATE"`
+	Matchup          string    `nba:"MATCHUP"`
+	WL               string    `nba:"WL"`
+	Min              float64   `nba:"MIN"`
+	FGM              float64   `nba:"FGM"`
+	FGA              float64   `nba:"FGA"`
+	FGPct            float64   `nba:"FG_PCT"`
+	FG3M             float64   `nba:"FG3M"`
+	FG3A             float64   `nba:"FG3A"`
+	FG3Pct           float64   `nba:"FG3_PCT"`
+	FTM              float64   `nba:"FTM"`
+	FTA              float64   `nba:"FTA"`
+	FTPct            float64   `nba:"FT_PCT"`
+	OREB             float64   `nba:"OREB"`
+	DREB             float64   `nba:"DREB"`
+	REB              float64   `nba:"REB"`
+	AST              float64   `nba:"AST"`
+	STL              float64   `nba:"STL"`
+	BLK              float64   `nba:"BLK"`
+	TOV              float64   `nba:"TOV"`
+	PF               float64   `nba:"PF"`
+	PTS              float64   `nba:"PTS"`
+	PlusMinus        float64   `nba:"PLUS_MINUS"`
+	VideoAvailable   int       `nba:"VIDEO_AVAILABLE"`
+}
+
+// LeagueLeadersRow is a row of the leagueleaders LeagueLeaders result set.
+type LeagueLeadersRow struct {
+	PlayerID int     `nba:"PLAYER_ID"`
+	Rank     int     `nba:"RANK"`
+	Player   string  `nba:"PLAYER"`
+	TeamID   int     `nba:"TEAM_ID"`
+	Team     string  `nba:"TEAM"`
+	GP       int     `nba:"GP"`
+	Min      float64 `nba:"MIN"`
+	FGM      float64 `nba:"FGM"`
+	FGA      float64 `nba:"FGA"`
+	FGPct    float64 `nba:"FG_PCT"`
+	FG3M     float64 `nba:"FG3M"`
+	FG3A     float64 `nba:"FG3A"`
+	FG3Pct   float64 `nba:"FG3_PCT"`
+	FTM      float64 `nba:"FTM"`
+	FTA      float64 `nba:"FTA"`
+	FTPct    float64 `nba:"FT_PCT"`
+	OREB     float64 `nba:"OREB"`
+	DREB     float64 `nba:"DREB"`
+	REB      float64 `nba:"REB"`
+	AST      float64 `nba:"AST"`
+	STL      float64 `nba:"STL"`
+	BLK      float64 `nba:"BLK"`
+	TOV      float64 `nba:"TOV"`
+	PF       float64 `nba:"PF"`
+	PTS      float64 `nba:"PTS"`
+	Eff      float64 `nba:"EFF"`
+	ASTTOV   float64 `nba:"AST_TOV"`
+	STLTOV   float64 `nba:"STL_TOV"`
+}
+
+// LeagueStandingsV3StandingsRow is a row of the leaguestandingsv3 Standings
+// result set.
+type LeagueStandingsV3StandingsRow struct {
+	LeagueID                string  `nba:"LeagueID"`
+	SeasonID                string  `nba:"SeasonID"`
+	TeamID                  int     `nba:"TeamID"`
+	TeamCity                string  `nba:"TeamCity"`
+	TeamName                string  `nba:"TeamName"`
+	TeamSlug                string  `nba:"TeamSlug"`
+	Conference              string  `nba:"Conference"`
+	ConferenceRecord        string  `nba:"ConferenceRecord"`
+	PlayoffRank             int     `nba:"PlayoffRank"`
+	ClinchIndicator         string  `nba:"ClinchIndicator"`
+	Division                string  `nba:"Division"`
+	DivisionRecord          string  `nba:"DivisionRecord"`
+	DivisionRank            int     `nba:"DivisionRank"`
+	Wins                    float64 `nba:"WINS"`
+	Losses                  float64 `nba:"LOSSES"`
+	WinPct                  float64 `nba:"WinPCT"`
+	LeagueRank              int     `nba:"LeagueRank"`
+	Record                  string  `nba:"Record"`
+	Home                    string  `nba:"HOME"`
+	Road                    string  `nba:"ROAD"`
+	L10                     string  `nba:"L10"`
+	Last10Home              string  `nba:"Last10Home"`
+	Last10Road              string  `nba:"Last10Road"`
+	Ot                      string  `nba:"OT"`
+	ThreePTSOrLess          string  `nba:"ThreePTSOrLess"`
+	TenPTSOrMore            string  `nba:"TenPTSOrMore"`
+	LongHomeStreak          float64 `nba:"LongHomeStreak"`
+	StrLongHomeStreak       string  `nba:"strLongHomeStreak"`
+	LongRoadStreak          float64 `nba:"LongRoadStreak"`
+	StrLongRoadStreak       string  `nba:"strLongRoadStreak"`
+	LongWinStreak           float64 `nba:"LongWinStreak"`
+	LongLossStreak          float64 `nba:"LongLossStreak"`
+	CurrentHomeStreak       float64 `nba:"CurrentHomeStreak"`
+	StrCurrentHomeStreak    string  `nba:"strCurrentHomeStreak"`
+	CurrentRoadStreak       float64 `nba:"CurrentRoadStreak"`
+	StrCurrentRoadStreak    string  `nba:"strCurrentRoadStreak"`
+	CurrentStreak           float64 `nba:"CurrentStreak"`
+	StrCurrentStreak        string  `nba:"strCurrentStreak"`
+	ConferenceGamesBack     float64 `nba:"ConferenceGamesBack"`
+	DivisionGamesBack       float64 `nba:"DivisionGamesBack"`
+	ClinchedConferenceTitle float64 `nba:"ClinchedConferenceTitle"`
+	ClinchedDivisionTitle   float64 `nba:"ClinchedDivisionTitle"`
+	ClinchedPlayoffBirth    float64 `nba:"ClinchedPlayoffBirth"`
+	ClinchedPlayIn          float64 `nba:"ClinchedPlayIn"`
+	EliminatedConference    float64 `nba:"EliminatedConference"`
+	EliminatedDivision      float64 `nba:"EliminatedDivision"`
+	AheadAtHalf             string  `nba:"AheadAtHalf"`
+	BehindAtHalf            string  `nba:"BehindAtHalf"`
+	TiedAtHalf              string  `nba:"TiedAtHalf"`
+	AheadAtThird            string  `nba:"AheadAtThird"`
+	BehindAtThird           string  `nba:"BehindAtThird"`
+	TiedAtThird             string  `nba:"TiedAtThird"`
+	Score100PTS             string  `nba:"Score100PTS"`
+	OppScore100PTS          string  `nba:"OppScore100PTS"`
+	OppOver500              string  `nba:"OppOver500"`
+	LeadInFgpct             string  `nba:"LeadInFGPCT"`
+	LeadInREB               string  `nba:"LeadInReb"`
+	FewerTurnovers          string  `nba:"FewerTurnovers"`
+	PointsPg                float64 `nba:"PointsPG"`
+	OppPointsPg             float64 `nba:"OppPointsPG"`
+	DiffPointsPg            float64 `nba:"DiffPointsPG"`
+	VsEast                  string  `nba:"vsEast"`
+	VsAtlantic              string  `nba:"vsAtlantic"`
+	VsCentral               string  `nba:"vsCentral"`
+	VsSoutheast             string  `nba:"vsSoutheast"`
+	VsWest                  string  `nba:"vsWest"`
+	VsNorthwest             string  `nba:"vsNorthwest"`
+	VsPacific               string  `nba:"vsPacific"`
+	VsSouthwest             string  `nba:"vsSouthwest"`
+	Jan                     string  `nba:"Jan"`
+	Feb                     string  `nba:"Feb"`
+	Mar                     string  `nba:"Mar"`
+	Apr                     string  `nba:"Apr"`
+	May                     string  `nba:"May"`
+	Jun                     string  `nba:"Jun"`
+	Jul                     string  `nba:"Jul"`
+	Aug                     string  `nba:"Aug"`
+	Sep                     string  `nba:"Sep"`
+	Oct                     string  `nba:"Oct"`
+	Nov                     string  `nba:"Nov"`
+	Dec                     string  `nba:"Dec"`
+	PreAs                   string  `nba:"PreAS"`
+	PostAs                  string  `nba:"PostAS"`
+}
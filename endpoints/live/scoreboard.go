@@ -94,28 +94,45 @@ type Meta struct {
 	Code    int    `json:"code"`
 }
 
+const scoreboardEndpoint = "scoreboard/todaysScoreboard_00.json"
+
 // GetScoreboard fetches today's scoreboard.
+// The client sends conditional requests, so when the scoreboard has not
+// changed since the previous call the previously decoded value is returned
+// without downloading it again. Callers must not modify the returned value.
 func (c *Client) GetScoreboard(ctx context.Context) (*ScoreboardResponse, error) {
 	c.logger.InfoContext(ctx, "Fetching NBA scoreboard")
 
-	resp, err := c.httpClient.SendRequest(ctx, "scoreboard/todaysScoreboard_00.json", nil)
+	var scoreboardResp ScoreboardResponse
+	resp, err := c.httpClient.Get(ctx, scoreboardEndpoint, nil, &scoreboardResp)
 	if err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch scoreboard",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch scoreboard: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from scoreboard endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
+	if resp.NotModified() {
+		c.mu.Lock()
+		previous := c.lastScoreboard
+		c.mu.Unlock()
+
+		if previous != nil {
+			c.logger.InfoContext(ctx, "Scoreboard not modified, reusing previous response")
+			return previous, nil
+		}
+
+		// The validators belong to a response we no longer have; fetch it again.
+		c.httpClient.ForgetValidators(scoreboardEndpoint, nil)
+		if _, err := c.httpClient.Get(ctx, scoreboardEndpoint, nil, &scoreboardResp); err != nil {
+			c.logger.ErrorContext(ctx, "Failed to fetch scoreboard",
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to fetch scoreboard: %w", err)
+		}
 	}
 
-	var scoreboardResp ScoreboardResponse
-	if err := resp.GetJSON(&scoreboardResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal scoreboard response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
+	c.mu.Lock()
+	c.lastScoreboard = &scoreboardResp
+	c.mu.Unlock()
 
 	c.logger.InfoContext(ctx, "Successfully fetched scoreboard",
 		slog.Int("games_count", len(scoreboardResp.Scoreboard.Games)))
@@ -173,3 +173,68 @@ func TestGetScoreboard_NetworkError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+
+func TestGetScoreboard_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<html><body>Not Found</body></html>`))
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	httpClient := client.NewHTTPClient(server.URL+"/%s", DefaultHeaders(), logger)
+	c := &Client{
+		httpClient: httpClient,
+		logger:     logger,
+	}
+
+	_, err := c.GetScoreboard(context.Background())
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "scoreboard/todaysScoreboard_00.json", apiErr.Endpoint)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestGetScoreboard_NotModified(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"abc"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"scoreboard": {"gameDate": "2024-01-01", "games": [{"gameId": "0022400001"}]}}`))
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	httpClient := client.NewHTTPClient(server.URL+"/%s", DefaultHeaders(), logger)
+	httpClient.SetConditionalRequests(true)
+	c := &Client{
+		httpClient: httpClient,
+		logger:     logger,
+	}
+
+	first, err := c.GetScoreboard(context.Background())
+	require.NoError(t, err)
+
+	second, err := c.GetScoreboard(context.Background())
+	require.NoError(t, err)
+
+	assert.Same(t, first, second)
+	assert.Equal(t, []string{"", `"abc"`}, requests)
+
+	// A fresh client without a previous value refetches the full scoreboard.
+	fresh := &Client{
+		httpClient: httpClient,
+		logger:     logger,
+	}
+	third, err := fresh.GetScoreboard(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01", third.Scoreboard.GameDate)
+	assert.Equal(t, []string{"", `"abc"`, `"abc"`, ""}, requests)
+}
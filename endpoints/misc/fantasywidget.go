@@ -55,25 +55,13 @@ func (c *Client) GetFantasyWidget(ctx context.Context, params FantasyWidgetParam
 		"VsDivision": params.VsDivisionNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "fantasywidget", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "fantasywidget", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch fantasywidget",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch fantasywidget: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from fantasywidget endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal fantasywidget response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched fantasywidget",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -31,25 +31,13 @@ func (c *Client) GetHomePageV2(ctx context.Context, params HomePageV2Params) (*S
 		"StatType": params.StatType,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "homepagev2", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "homepagev2", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch homepagev2",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch homepagev2: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from homepagev2 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal homepagev2 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched homepagev2",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
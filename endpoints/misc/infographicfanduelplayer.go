@@ -19,25 +19,13 @@ func (c *Client) GetInfographicFanDuelPlayer(ctx context.Context, params Infogra
 		"GameID": params.GameId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "infographicfanduelplayer", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "infographicfanduelplayer", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch infographicfanduelplayer",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch infographicfanduelplayer: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from infographicfanduelplayer endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal infographicfanduelplayer response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched infographicfanduelplayer",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
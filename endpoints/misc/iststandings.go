@@ -4,8 +4,123 @@
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 )
 
+// ISTGame is a group stage game of a team in the In-Season Tournament.
+// Location is "H" or "A" and Outcome is "W" or "L" once the game is final.
+type ISTGame struct {
+	GameID                   string `json:"gameId"`
+	OpponentTeamAbbreviation string `json:"opponentTeamAbbreviation"`
+	Location                 string `json:"location"`
+	GameStatus               int    `json:"gameStatus"`
+	GameStatusText           string `json:"gameStatusText"`
+	Outcome                  string `json:"outcome"`
+}
+
+// ISTTeamStanding is a team's In-Season Tournament standing. The ranks and
+// games behind are nil while they do not apply, e.g. ISTKnockoutRank for a
+// team that did not reach the knockout rounds.
+type ISTTeamStanding struct {
+	TeamID              int       `json:"teamId"`
+	TeamCity            string    `json:"teamCity"`
+	TeamName            string    `json:"teamName"`
+	TeamAbbreviation    string    `json:"teamAbbreviation"`
+	TeamSlug            string    `json:"teamSlug"`
+	Conference          string    `json:"conference"`
+	ISTGroup            string    `json:"istGroup"`
+	ClinchIndicator     string    `json:"clinchIndicator"`
+	ClinchedISTKnockout int       `json:"clinchedIstKnockout"`
+	ClinchedISTGroup    int       `json:"clinchedIstGroup"`
+	ClinchedISTWildcard int       `json:"clinchedIstWildcard"`
+	ISTWildcardRank     *int      `json:"istWildcardRank"`
+	ISTGroupRank        *int      `json:"istGroupRank"`
+	ISTKnockoutRank     *int      `json:"istKnockoutRank"`
+	Wins                int       `json:"wins"`
+	Losses              int       `json:"losses"`
+	Pct                 float64   `json:"pct"`
+	ISTGroupGB          *float64  `json:"istGroupGb"`
+	ISTWildcardGB       *float64  `json:"istWildcardGb"`
+	Diff                int       `json:"diff"`
+	Pts                 int       `json:"pts"`
+	OppPts              int       `json:"oppPts"`
+	Games               []ISTGame `json:"games"`
+}
+
+// ISTStandingsResponse is the response of the iststandings endpoint, which
+// returns nested JSON rather than result sets.
+type ISTStandingsResponse struct {
+	LeagueID      string            `json:"leagueId"`
+	SeasonYear    string            `json:"seasonYear"`
+	UnixTimeStamp int64             `json:"unixTimeStamp"`
+	TimeStampUTC  string            `json:"timeStampUtc"`
+	Teams         []ISTTeamStanding `json:"teams"`
+}
+
+// istGroupGames is the number of group stage games of every team.
+const istGroupGames = 4
+
+// StatsResponse converts the standings to the Standings result set with one
+// row per team. The group stage games are flattened into the columns gameId1
+// to outcome4.
+func (r *ISTStandingsResponse) StatsResponse() *StatsResponse {
+	headers := []string{
+		"leagueId", "seasonYear", "teamId", "teamCity", "teamName", "teamAbbreviation",
+		"teamSlug", "conference", "istGroup", "clinchIndicator", "clinchedIstKnockout",
+		"clinchedIstGroup", "clinchedIstWildcard", "istWildcardRank", "istGroupRank",
+		"istKnockoutRank", "wins", "losses", "pct", "istGroupGb", "istWildcardGb", "diff",
+		"pts", "oppPts",
+	}
+	for i := 1; i <= istGroupGames; i++ {
+		n := strconv.Itoa(i)
+		headers = append(headers, "gameId"+n, "opponentTeamAbbreviation"+n, "location"+n,
+			"gameStatus"+n, "gameStatusText"+n, "outcome"+n)
+	}
+
+	rs := ResultSet{Name: "Standings", Headers: headers}
+	for _, t := range r.Teams {
+		row := []interface{}{
+			r.LeagueID, r.SeasonYear, float64(t.TeamID), t.TeamCity, t.TeamName, t.TeamAbbreviation,
+			t.TeamSlug, t.Conference, t.ISTGroup, t.ClinchIndicator, float64(t.ClinchedISTKnockout),
+			float64(t.ClinchedISTGroup), float64(t.ClinchedISTWildcard), intCell(t.ISTWildcardRank),
+			intCell(t.ISTGroupRank), intCell(t.ISTKnockoutRank), float64(t.Wins), float64(t.Losses),
+			t.Pct, floatCell(t.ISTGroupGB), floatCell(t.ISTWildcardGB), float64(t.Diff),
+			float64(t.Pts), float64(t.OppPts),
+		}
+		for i := 0; i < istGroupGames; i++ {
+			if i >= len(t.Games) {
+				row = append(row, nil, nil, nil, nil, nil, nil)
+				continue
+			}
+			g := t.Games[i]
+			row = append(row, g.GameID, g.OpponentTeamAbbreviation, g.Location,
+				float64(g.GameStatus), g.GameStatusText, g.Outcome)
+		}
+		rs.RowSet = append(rs.RowSet, row)
+	}
+
+	return &StatsResponse{
+		Resource:   "iststandings",
+		ResultSets: []ResultSet{rs},
+	}
+}
+
+// intCell returns v as a result set cell, nil if v is nil.
+func intCell(v *int) interface{} {
+	if v == nil {
+		return nil
+	}
+	return float64(*v)
+}
+
+// floatCell returns v as a result set cell, nil if v is nil.
+func floatCell(v *float64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
 // ISTStandingsParams holds parameters for the ISTStandings endpoint.
 type ISTStandingsParams struct {
 	LeagueId string
@@ -13,8 +128,19 @@ type ISTStandingsParams struct {
 	Section string
 }
 
-// GetISTStandings fetches data from the iststandings endpoint.
+// GetISTStandings fetches data from the iststandings endpoint and converts it
+// to result sets. Use GetISTStandingsTyped for the nested standings.
 func (c *Client) GetISTStandings(ctx context.Context, params ISTStandingsParams) (*StatsResponse, error) {
+	resp, err := c.GetISTStandingsTyped(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetISTStandingsTyped fetches the nested standings from the iststandings
+// endpoint.
+func (c *Client) GetISTStandingsTyped(ctx context.Context, params ISTStandingsParams) (*ISTStandingsResponse, error) {
 	c.logger.InfoContext(ctx, "Fetching iststandings")
 
 	reqParams := map[string]string{
@@ -23,27 +149,15 @@ func (c *Client) GetISTStandings(ctx context.Context, params ISTStandingsParams)
 		"Section": params.Section,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "iststandings", reqParams)
-	if err != nil {
+	var standingsResp ISTStandingsResponse
+	if _, err := c.httpClient.Get(ctx, "iststandings", reqParams, &standingsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch iststandings",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch iststandings: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from iststandings endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal iststandings response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched iststandings",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("teams_count", len(standingsResp.Teams)))
 
-	return &statsResp, nil
+	return &standingsResp, nil
 }
@@ -15,15 +15,22 @@
 
 func TestGetISTStandings(t *testing.T) {
 	mockResponse := `{
-		"resource": "iststandings",
-		"parameters": {},
-		"resultSets": [
-{
-				"name": "Standings",
-				"headers": ["leagueId", "seasonYear", "teamId", "teamCity", "teamName"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
-			}
-		]
+		"leagueId": "00",
+		"seasonYear": "2023-24",
+		"unixTimeStamp": 1702004407,
+		"timeStampUtc": "2023-12-08T03:00:07Z",
+		"teams": [{
+			"teamId": 1610612747, "teamCity": "Los Angeles", "teamName": "Lakers", "teamAbbreviation": "LAL",
+			"teamSlug": "lakers", "conference": "West", "istGroup": "West Group A", "clinchIndicator": " - w",
+			"clinchedIstKnockout": 1, "clinchedIstGroup": 1, "clinchedIstWildcard": 0,
+			"istWildcardRank": null, "istGroupRank": 1, "istKnockoutRank": 1,
+			"wins": 4, "losses": 0, "pct": 1.0, "istGroupGb": 0.0, "istWildcardGb": null,
+			"diff": 40, "pts": 482, "oppPts": 442,
+			"games": [
+				{"gameId": "0022301177", "opponentTeamAbbreviation": "PHX", "location": "H", "gameStatus": 3, "gameStatusText": "Final", "outcome": "W"},
+				{"gameId": "0022301183", "opponentTeamAbbreviation": "MEM", "location": "A", "gameStatus": 3, "gameStatusText": "Final", "outcome": "W"}
+			]
+		}]
 	}`
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -42,11 +49,34 @@ func TestGetISTStandings(t *testing.T) {
 
 	params := ISTStandingsParams{}
 
+	resp, err := c.GetISTStandingsTyped(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, "2023-24", resp.SeasonYear)
+	require.Len(t, resp.Teams, 1)
+	lakers := resp.Teams[0]
+	assert.Equal(t, "West Group A", lakers.ISTGroup)
+	assert.Nil(t, lakers.ISTWildcardRank)
+	require.NotNil(t, lakers.ISTKnockoutRank)
+	assert.Equal(t, 1, *lakers.ISTKnockoutRank)
+	require.Len(t, lakers.Games, 2)
+	assert.Equal(t, "MEM", lakers.Games[1].OpponentTeamAbbreviation)
+
 	response, err := c.GetISTStandings(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "iststandings", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+
+	standings, err := response.GetDataSet("Standings")
+	require.NoError(t, err)
+	require.Equal(t, 1, standings.RowCount())
+	row, err := standings.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, float64(1610612747), row["teamId"])
+	assert.Nil(t, row["istWildcardRank"])
+	assert.Equal(t, "0022301183", row["gameId2"])
+	assert.Equal(t, "W", row["outcome2"])
+	assert.Nil(t, row["gameId3"])
 }
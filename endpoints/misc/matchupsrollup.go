@@ -33,25 +33,13 @@ func (c *Client) GetMatchupsRollup(ctx context.Context, params MatchupsRollupPar
 		"OffTeamID": params.OffTeamIdNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "matchupsrollup", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "matchupsrollup", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch matchupsrollup",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch matchupsrollup: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from matchupsrollup endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal matchupsrollup response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched matchupsrollup",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -31,25 +31,13 @@ func (c *Client) GetSynergyPlayTypes(ctx context.Context, params SynergyPlayType
 		"TypeGrouping": params.TypeGroupingNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "synergyplaytypes", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "synergyplaytypes", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch synergyplaytypes",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch synergyplaytypes: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from synergyplaytypes endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal synergyplaytypes response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched synergyplaytypes",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
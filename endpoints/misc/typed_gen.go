@@ -0,0 +1,347 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package misc
+
+import (
+	"context"
+	"fmt"
+)
+
+// FantasyWidgetResult holds the decoded result sets of the fantasywidget
+// endpoint.
+type FantasyWidgetResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	FantasyWidgetResult []FantasyWidgetResultRow
+}
+
+// GetFantasyWidgetTyped is like GetFantasyWidget but decodes the result sets
+// into a FantasyWidgetResult.
+func (c *Client) GetFantasyWidgetTyped(ctx context.Context, params FantasyWidgetParams) (*FantasyWidgetResult, error) {
+	resp, err := c.GetFantasyWidget(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FantasyWidgetResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"FantasyWidgetResult": &result.FantasyWidgetResult,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode fantasywidget: %w", err)
+	}
+	return result, nil
+}
+
+// HomePageV2Result holds the decoded result sets of the homepagev2 endpoint.
+type HomePageV2Result struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	HomePageStat1 []HomePageV2HomePageStat1Row
+	HomePageStat2 []HomePageV2HomePageStat2Row
+	HomePageStat3 []HomePageV2HomePageStat3Row
+	HomePageStat4 []HomePageV2HomePageStat4Row
+	HomePageStat5 []HomePageV2HomePageStat5Row
+	HomePageStat6 []HomePageV2HomePageStat6Row
+	HomePageStat7 []HomePageV2HomePageStat7Row
+	HomePageStat8 []HomePageV2HomePageStat8Row
+}
+
+// GetHomePageV2Typed is like GetHomePageV2 but decodes the result sets into a
+// HomePageV2Result.
+func (c *Client) GetHomePageV2Typed(ctx context.Context, params HomePageV2Params) (*HomePageV2Result, error) {
+	resp, err := c.GetHomePageV2(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &HomePageV2Result{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"HomePageStat1": &result.HomePageStat1,
+		"HomePageStat2": &result.HomePageStat2,
+		"HomePageStat3": &result.HomePageStat3,
+		"HomePageStat4": &result.HomePageStat4,
+		"HomePageStat5": &result.HomePageStat5,
+		"HomePageStat6": &result.HomePageStat6,
+		"HomePageStat7": &result.HomePageStat7,
+		"HomePageStat8": &result.HomePageStat8,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode homepagev2: %w", err)
+	}
+	return result, nil
+}
+
+// InfographicFanDuelPlayerResult holds the decoded result sets of the
+// infographicfanduelplayer endpoint.
+type InfographicFanDuelPlayerResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	FanDuelPlayer []InfographicFanDuelPlayerFanDuelPlayerRow
+}
+
+// GetInfographicFanDuelPlayerTyped is like GetInfographicFanDuelPlayer but
+// decodes the result sets into a InfographicFanDuelPlayerResult.
+func (c *Client) GetInfographicFanDuelPlayerTyped(ctx context.Context, params InfographicFanDuelPlayerParams) (*InfographicFanDuelPlayerResult, error) {
+	resp, err := c.GetInfographicFanDuelPlayer(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &InfographicFanDuelPlayerResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"FanDuelPlayer": &result.FanDuelPlayer,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode infographicfanduelplayer: %w", err)
+	}
+	return result, nil
+}
+
+// MatchupsRollupResult holds the decoded result sets of the matchupsrollup
+// endpoint.
+type MatchupsRollupResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	MatchupsRollup []MatchupsRollupRow
+}
+
+// GetMatchupsRollupTyped is like GetMatchupsRollup but decodes the result
+// sets into a MatchupsRollupResult.
+func (c *Client) GetMatchupsRollupTyped(ctx context.Context, params MatchupsRollupParams) (*MatchupsRollupResult, error) {
+	resp, err := c.GetMatchupsRollup(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MatchupsRollupResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"MatchupsRollup": &result.MatchupsRollup,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode matchupsrollup: %w", err)
+	}
+	return result, nil
+}
+
+// SynergyPlayTypesResult holds the decoded result sets of the
+// synergyplaytypes endpoint.
+type SynergyPlayTypesResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	SynergyPlayType []SynergyPlayTypesSynergyPlayTypeRow
+}
+
+// GetSynergyPlayTypesTyped is like GetSynergyPlayTypes but decodes the result
+// sets into a SynergyPlayTypesResult.
+func (c *Client) GetSynergyPlayTypesTyped(ctx context.Context, params SynergyPlayTypesParams) (*SynergyPlayTypesResult, error) {
+	resp, err := c.GetSynergyPlayTypes(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SynergyPlayTypesResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"SynergyPlayType": &result.SynergyPlayType,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode synergyplaytypes: %w", err)
+	}
+	return result, nil
+}
+
+// FantasyWidgetResultRow is a row of the fantasywidget FantasyWidgetResult
+// result set.
+type FantasyWidgetResultRow struct {
+	PlayerID         int     `nba:"PLAYER_ID"`
+	PlayerName       string  `nba:"PLAYER_NAME"`
+	PlayerPosition   string  `nba:"PLAYER_POSITION"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	GP               int     `nba:"GP"`
+	Min              float64 `nba:"MIN"`
+	FanDuelPTS       float64 `nba:"FAN_DUEL_PTS"`
+	NBAFantasyPTS    float64 `nba:"NBA_FANTASY_PTS"`
+	PTS              float64 `nba:"PTS"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	BLK              float64 `nba:"BLK"`
+	STL              float64 `nba:"STL"`
+	TOV              float64 `nba:"TOV"`
+	FG3M             float64 `nba:"FG3M"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+}
+
+// HomePageV2HomePageStat1Row is a row of the homepagev2 HomePageStat1 result
+// set.
+type HomePageV2HomePageStat1Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	PTS              float64 `nba:"PTS"`
+}
+
+// HomePageV2HomePageStat2Row is a row of the homepagev2 HomePageStat2 result
+// set.
+type HomePageV2HomePageStat2Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	REB              float64 `nba:"REB"`
+}
+
+// HomePageV2HomePageStat3Row is a row of the homepagev2 HomePageStat3 result
+// set.
+type HomePageV2HomePageStat3Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	AST              float64 `nba:"AST"`
+}
+
+// HomePageV2HomePageStat4Row is a row of the homepagev2 HomePageStat4 result
+// set.
+type HomePageV2HomePageStat4Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	STL              float64 `nba:"STL"`
+}
+
+// HomePageV2HomePageStat5Row is a row of the homepagev2 HomePageStat5 result
+// set.
+type HomePageV2HomePageStat5Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	FGPct            float64 `nba:"FG_PCT"`
+}
+
+// HomePageV2HomePageStat6Row is a row of the homepagev2 HomePageStat6 result
+// set.
+type HomePageV2HomePageStat6Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	FTPct            float64 `nba:"FT_PCT"`
+}
+
+// HomePageV2HomePageStat7Row is a row of the homepagev2 HomePageStat7 result
+// set.
+type HomePageV2HomePageStat7Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+}
+
+// HomePageV2HomePageStat8Row is a row of the homepagev2 HomePageStat8 result
+// set.
+type HomePageV2HomePageStat8Row struct {
+	Rank             int     `nba:"RANK"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	BLK              float64 `nba:"BLK"`
+}
+
+// InfographicFanDuelPlayerFanDuelPlayerRow is a row of the
+// infographicfanduelplayer FanDuelPlayer result set.
+type InfographicFanDuelPlayerFanDuelPlayerRow struct {
+	PlayerID         int     `nba:"PLAYER_ID"`
+	PlayerName       string  `nba:"PLAYER_NAME"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	JerseyNum        string  `nba:"JERSEY_NUM"`
+	PlayerPosition   string  `nba:"PLAYER_POSITION"`
+	Location         string  `nba:"LOCATION"`
+	FanDuelPTS       float64 `nba:"FAN_DUEL_PTS"`
+	NBAFantasyPTS    float64 `nba:"NBA_FANTASY_PTS"`
+	UsgPct           float64 `nba:"USG_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+}
+
+// MatchupsRollupRow is a row of the matchupsrollup MatchupsRollup result set.
+type MatchupsRollupRow struct {
+	SeasonID      string  `nba:"SEASON_ID"`
+	Position      string  `nba:"POSITION"`
+	PercentOfTime float64 `nba:"PERCENT_OF_TIME"`
+	DefPlayerID   int     `nba:"DEF_PLAYER_ID"`
+	DefPlayerName string  `nba:"DEF_PLAYER_NAME"`
+	GP            int     `nba:"GP"`
+	MatchupMin    float64 `nba:"MATCHUP_MIN"`
+	PartialPoss   float64 `nba:"PARTIAL_POSS"`
+	PlayerPTS     float64 `nba:"PLAYER_PTS"`
+	TeamPTS       float64 `nba:"TEAM_PTS"`
+	MatchupAST    float64 `nba:"MATCHUP_AST"`
+	MatchupTOV    float64 `nba:"MATCHUP_TOV"`
+	MatchupBLK    float64 `nba:"MATCHUP_BLK"`
+	MatchupFGM    float64 `nba:"MATCHUP_FGM"`
+	MatchupFGA    float64 `nba:"MATCHUP_FGA"`
+	MatchupFGPct  float64 `nba:"MATCHUP_FG_PCT"`
+	MatchupFG3M   float64 `nba:"MATCHUP_FG3M"`
+	MatchupFG3A   float64 `nba:"MATCHUP_FG3A"`
+	MatchupFG3Pct float64 `nba:"MATCHUP_FG3_PCT"`
+	MatchupFTM    float64 `nba:"MATCHUP_FTM"`
+	MatchupFTA    float64 `nba:"MATCHUP_FTA"`
+	Sfl           float64 `nba:"SFL"`
+}
+
+// SynergyPlayTypesSynergyPlayTypeRow is a row of the synergyplaytypes
+// SynergyPlayType result set.
+type SynergyPlayTypesSynergyPlayTypeRow struct {
+	SeasonID         string  `nba:"SEASON_ID"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	PlayType         string  `nba:"PLAY_TYPE"`
+	TypeGrouping     string  `nba:"TYPE_GROUPING"`
+	Percentile       float64 `nba:"PERCENTILE"`
+	GP               int     `nba:"GP"`
+	PossPct          float64 `nba:"POSS_PCT"`
+	Ppp              float64 `nba:"PPP"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FTPossPct        float64 `nba:"FT_POSS_PCT"`
+	TOVPossPct       float64 `nba:"TOV_POSS_PCT"`
+	SfPossPct        float64 `nba:"SF_POSS_PCT"`
+	PlusonePossPct   float64 `nba:"PLUSONE_POSS_PCT"`
+	ScorePossPct     float64 `nba:"SCORE_POSS_PCT"`
+	EFGPct           float64 `nba:"EFG_PCT"`
+	Poss             float64 `nba:"POSS"`
+	PTS              float64 `nba:"PTS"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	Fgmx             float64 `nba:"FGMX"`
+}
@@ -0,0 +1,31 @@
+package player
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/client"
+)
+
+func TestNewClient_WithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stats/commonplayerinfo", r.URL.Path)
+		assert.Equal(t, "test-agent", r.Header.Get("User-Agent"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"resource": "commonplayerinfo", "resultSets": []}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(nil,
+		client.WithBaseURL(server.URL+"/stats/%s"),
+		client.WithUserAgent("test-agent"))
+
+	response, err := c.GetCommonPlayerInfo(context.Background(), CommonPlayerInfoParams{PlayerId: "2544"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "commonplayerinfo", response.Resource)
+}
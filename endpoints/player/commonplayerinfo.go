@@ -21,25 +21,13 @@ func (c *Client) GetCommonPlayerInfo(ctx context.Context, params CommonPlayerInf
 		"LeagueID": params.LeagueIdNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "commonplayerinfo", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "commonplayerinfo", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch commonplayerinfo",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch commonplayerinfo: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from commonplayerinfo endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal commonplayerinfo response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched commonplayerinfo",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -23,25 +23,13 @@ func (c *Client) GetPlayerCareerStats(ctx context.Context, params PlayerCareerSt
 		"LeagueID": params.LeagueIdNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playercareerstats", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playercareerstats", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playercareerstats",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playercareerstats: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playercareerstats endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playercareerstats response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playercareerstats",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -130,25 +130,13 @@ func (c *Client) GetPlayerCompare(ctx context.Context, params PlayerCompareParam
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playercompare", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playercompare", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playercompare",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playercompare: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playercompare endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playercompare response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playercompare",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
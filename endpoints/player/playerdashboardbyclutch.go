@@ -133,25 +133,13 @@ func (c *Client) GetPlayerDashboardByClutch(ctx context.Context, params PlayerDa
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerdashboardbyclutch", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerdashboardbyclutch", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerdashboardbyclutch",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerdashboardbyclutch: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerdashboardbyclutch endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerdashboardbyclutch response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerdashboardbyclutch",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
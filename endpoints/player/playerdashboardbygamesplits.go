@@ -132,25 +132,13 @@ func (c *Client) GetPlayerDashboardByGameSplits(ctx context.Context, params Play
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerdashboardbygamesplits", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerdashboardbygamesplits", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerdashboardbygamesplits",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerdashboardbygamesplits: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerdashboardbygamesplits endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerdashboardbygamesplits response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerdashboardbygamesplits",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -109,25 +109,13 @@ func (c *Client) GetPlayerDashboardByGeneralSplits(ctx context.Context, params P
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerdashboardbygeneralsplits", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerdashboardbygeneralsplits", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerdashboardbygeneralsplits",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerdashboardbygeneralsplits: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerdashboardbygeneralsplits endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerdashboardbygeneralsplits response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerdashboardbygeneralsplits",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
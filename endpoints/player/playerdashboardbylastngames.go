@@ -133,25 +133,13 @@ func (c *Client) GetPlayerDashboardByLastNGames(ctx context.Context, params Play
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerdashboardbylastngames", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerdashboardbylastngames", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerdashboardbylastngames",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerdashboardbylastngames: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerdashboardbylastngames endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerdashboardbylastngames response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerdashboardbylastngames",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
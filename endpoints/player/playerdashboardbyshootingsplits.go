@@ -132,25 +132,13 @@ func (c *Client) GetPlayerDashboardByShootingSplits(ctx context.Context, params
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerdashboardbyshootingsplits", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerdashboardbyshootingsplits", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerdashboardbyshootingsplits",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerdashboardbyshootingsplits: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerdashboardbyshootingsplits endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerdashboardbyshootingsplits response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerdashboardbyshootingsplits",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
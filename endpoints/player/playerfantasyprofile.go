@@ -68,25 +68,13 @@ func (c *Client) GetPlayerFantasyProfile(ctx context.Context, params PlayerFanta
 		reqParams["LeagueID"] = params.LeagueIdNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerfantasyprofile", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerfantasyprofile", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerfantasyprofile",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerfantasyprofile: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerfantasyprofile endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerfantasyprofile response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerfantasyprofile",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
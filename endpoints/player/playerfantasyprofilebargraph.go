@@ -33,25 +33,13 @@ func (c *Client) GetPlayerFantasyProfileBarGraph(ctx context.Context, params Pla
 		reqParams["SeasonType"] = params.SeasonTypeAllStarNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerfantasyprofilebargraph", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerfantasyprofilebargraph", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerfantasyprofilebargraph",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerfantasyprofilebargraph: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerfantasyprofilebargraph endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerfantasyprofilebargraph response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerfantasyprofilebargraph",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
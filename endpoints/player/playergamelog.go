@@ -29,25 +29,13 @@ func (c *Client) GetPlayerGameLog(ctx context.Context, params PlayerGameLogParam
 		"LeagueID": params.LeagueIdNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playergamelog", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playergamelog", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playergamelog",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playergamelog: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playergamelog endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playergamelog response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playergamelog",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -94,25 +94,13 @@ func (c *Client) GetPlayerGameLogs(ctx context.Context, params PlayerGameLogsPar
 		reqParams["TeamID"] = params.TeamIdNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playergamelogs", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playergamelogs", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playergamelogs",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playergamelogs: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playergamelogs endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playergamelogs response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playergamelogs",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -346,25 +346,13 @@ func (c *Client) GetPlayerGameStreakFinder(ctx context.Context, params PlayerGam
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playergamestreakfinder", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playergamestreakfinder", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playergamestreakfinder",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playergamestreakfinder: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playergamestreakfinder endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playergamestreakfinder response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playergamestreakfinder",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -77,25 +77,13 @@ func (c *Client) GetPlayerIndex(ctx context.Context, params PlayerIndexParams) (
 		reqParams["Weight"] = params.WeightNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerindex", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerindex", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerindex",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerindex: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerindex endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerindex response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerindex",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -23,25 +23,13 @@ func (c *Client) GetPlayerProfileV2(ctx context.Context, params PlayerProfileV2P
 		"LeagueID": params.LeagueIdNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playerprofilev2", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playerprofilev2", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playerprofilev2",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playerprofilev2: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playerprofilev2 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playerprofilev2 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playerprofilev2",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
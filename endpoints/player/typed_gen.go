@@ -0,0 +1,2131 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package player
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CommonAllPlayersResult holds the decoded result sets of the
+// commonallplayers endpoint.
+type CommonAllPlayersResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	CommonAllPlayers []CommonAllPlayersRow
+}
+
+// GetCommonAllPlayersTyped is like GetCommonAllPlayers but decodes the result
+// sets into a CommonAllPlayersResult.
+func (c *Client) GetCommonAllPlayersTyped(ctx context.Context, params CommonAllPlayersParams) (*CommonAllPlayersResult, error) {
+	resp, err := c.GetCommonAllPlayers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CommonAllPlayersResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"CommonAllPlayers": &result.CommonAllPlayers,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode commonallplayers: %w", err)
+	}
+	return result, nil
+}
+
+// CommonPlayerInfoResult holds the decoded result sets of the
+// commonplayerinfo endpoint.
+type CommonPlayerInfoResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	CommonPlayerInfo    []CommonPlayerInfoRow
+	PlayerHeadlineStats []CommonPlayerInfoPlayerHeadlineStatsRow
+	AvailableSeasons    []CommonPlayerInfoAvailableSeasonsRow
+}
+
+// GetCommonPlayerInfoTyped is like GetCommonPlayerInfo but decodes the result
+// sets into a CommonPlayerInfoResult.
+func (c *Client) GetCommonPlayerInfoTyped(ctx context.Context, params CommonPlayerInfoParams) (*CommonPlayerInfoResult, error) {
+	resp, err := c.GetCommonPlayerInfo(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CommonPlayerInfoResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"CommonPlayerInfo":    &result.CommonPlayerInfo,
+		"PlayerHeadlineStats": &result.PlayerHeadlineStats,
+		"AvailableSeasons":    &result.AvailableSeasons,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode commonplayerinfo: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerAwardsResult holds the decoded result sets of the playerawards
+// endpoint.
+type PlayerAwardsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayerAwards []PlayerAwardsRow
+}
+
+// GetPlayerAwardsTyped is like GetPlayerAwards but decodes the result sets
+// into a PlayerAwardsResult.
+func (c *Client) GetPlayerAwardsTyped(ctx context.Context, params PlayerAwardsParams) (*PlayerAwardsResult, error) {
+	resp, err := c.GetPlayerAwards(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerAwardsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayerAwards": &result.PlayerAwards,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerawards: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerCareerByCollegeRollupResult holds the decoded result sets of the
+// playercareerbycollegerollup endpoint.
+type PlayerCareerByCollegeRollupResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	East    []PlayerCareerByCollegeRollupRow
+	Midwest []PlayerCareerByCollegeRollupRow
+	South   []PlayerCareerByCollegeRollupRow
+	West    []PlayerCareerByCollegeRollupRow
+}
+
+// GetPlayerCareerByCollegeRollupTyped is like GetPlayerCareerByCollegeRollup
+// but decodes the result sets into a PlayerCareerByCollegeRollupResult.
+func (c *Client) GetPlayerCareerByCollegeRollupTyped(ctx context.Context, params PlayerCareerByCollegeRollupParams) (*PlayerCareerByCollegeRollupResult, error) {
+	resp, err := c.GetPlayerCareerByCollegeRollup(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerCareerByCollegeRollupResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"East":    &result.East,
+		"Midwest": &result.Midwest,
+		"South":   &result.South,
+		"West":    &result.West,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playercareerbycollegerollup: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerCareerStatsResult holds the decoded result sets of the
+// playercareerstats endpoint.
+type PlayerCareerStatsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	CareerTotalsAllStarSeason   []PlayerCareerTotalsRow
+	CareerTotalsCollegeSeason   []PlayerCollegeCareerTotalsRow
+	CareerTotalsPostSeason      []PlayerCareerTotalsRow
+	CareerTotalsRegularSeason   []PlayerCareerTotalsRow
+	SeasonRankingsPostSeason    []PlayerSeasonRankingsRow
+	SeasonRankingsRegularSeason []PlayerSeasonRankingsRow
+	SeasonTotalsAllStarSeason   []PlayerSeasonTotalsRow
+	SeasonTotalsCollegeSeason   []PlayerCollegeSeasonTotalsRow
+	SeasonTotalsPostSeason      []PlayerSeasonTotalsRow
+	SeasonTotalsRegularSeason   []PlayerSeasonTotalsRow
+}
+
+// GetPlayerCareerStatsTyped is like GetPlayerCareerStats but decodes the
+// result sets into a PlayerCareerStatsResult.
+func (c *Client) GetPlayerCareerStatsTyped(ctx context.Context, params PlayerCareerStatsParams) (*PlayerCareerStatsResult, error) {
+	resp, err := c.GetPlayerCareerStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerCareerStatsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"CareerTotalsAllStarSeason":   &result.CareerTotalsAllStarSeason,
+		"CareerTotalsCollegeSeason":   &result.CareerTotalsCollegeSeason,
+		"CareerTotalsPostSeason":      &result.CareerTotalsPostSeason,
+		"CareerTotalsRegularSeason":   &result.CareerTotalsRegularSeason,
+		"SeasonRankingsPostSeason":    &result.SeasonRankingsPostSeason,
+		"SeasonRankingsRegularSeason": &result.SeasonRankingsRegularSeason,
+		"SeasonTotalsAllStarSeason":   &result.SeasonTotalsAllStarSeason,
+		"SeasonTotalsCollegeSeason":   &result.SeasonTotalsCollegeSeason,
+		"SeasonTotalsPostSeason":      &result.SeasonTotalsPostSeason,
+		"SeasonTotalsRegularSeason":   &result.SeasonTotalsRegularSeason,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playercareerstats: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerCompareResult holds the decoded result sets of the playercompare
+// endpoint.
+type PlayerCompareResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallCompare []PlayerCompareOverallCompareRow
+	Individual     []PlayerCompareIndividualRow
+}
+
+// GetPlayerCompareTyped is like GetPlayerCompare but decodes the result sets
+// into a PlayerCompareResult.
+func (c *Client) GetPlayerCompareTyped(ctx context.Context, params PlayerCompareParams) (*PlayerCompareResult, error) {
+	resp, err := c.GetPlayerCompare(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerCompareResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallCompare": &result.OverallCompare,
+		"Individual":     &result.Individual,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playercompare: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashPtPassResult holds the decoded result sets of the
+// playerdashptpass endpoint.
+type PlayerDashPtPassResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PassesMade     []PlayerDashPtPassPassesMadeRow
+	PassesReceived []PlayerDashPtPassPassesReceivedRow
+}
+
+// GetPlayerDashPtPassTyped is like GetPlayerDashPtPass but decodes the result
+// sets into a PlayerDashPtPassResult.
+func (c *Client) GetPlayerDashPtPassTyped(ctx context.Context, params PlayerDashPtPassParams) (*PlayerDashPtPassResult, error) {
+	resp, err := c.GetPlayerDashPtPass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashPtPassResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PassesMade":     &result.PassesMade,
+		"PassesReceived": &result.PassesReceived,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashptpass: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashPtRebResult holds the decoded result sets of the playerdashptreb
+// endpoint.
+type PlayerDashPtRebResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallRebounding      []PlayerDashPtRebOverallReboundingRow
+	NumContestedRebounding []PlayerDashPtRebNumContestedReboundingRow
+	ShotTypeRebounding     []PlayerDashPtRebShotTypeReboundingRow
+	ShotDistanceRebounding []PlayerDashPtRebShotDistanceReboundingRow
+	RebDistanceRebounding  []PlayerDashPtRebRebDistanceReboundingRow
+}
+
+// GetPlayerDashPtRebTyped is like GetPlayerDashPtReb but decodes the result
+// sets into a PlayerDashPtRebResult.
+func (c *Client) GetPlayerDashPtRebTyped(ctx context.Context, params PlayerDashPtRebParams) (*PlayerDashPtRebResult, error) {
+	resp, err := c.GetPlayerDashPtReb(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashPtRebResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallRebounding":      &result.OverallRebounding,
+		"NumContestedRebounding": &result.NumContestedRebounding,
+		"ShotTypeRebounding":     &result.ShotTypeRebounding,
+		"ShotDistanceRebounding": &result.ShotDistanceRebounding,
+		"RebDistanceRebounding":  &result.RebDistanceRebounding,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashptreb: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashPtShotDefendResult holds the decoded result sets of the
+// playerdashptshotdefend endpoint.
+type PlayerDashPtShotDefendResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	DefendingShots []PlayerDashPtShotDefendDefendingShotsRow
+}
+
+// GetPlayerDashPtShotDefendTyped is like GetPlayerDashPtShotDefend but
+// decodes the result sets into a PlayerDashPtShotDefendResult.
+func (c *Client) GetPlayerDashPtShotDefendTyped(ctx context.Context, params PlayerDashPtShotDefendParams) (*PlayerDashPtShotDefendResult, error) {
+	resp, err := c.GetPlayerDashPtShotDefend(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashPtShotDefendResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"DefendingShots": &result.DefendingShots,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashptshotdefend: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashPtShotsResult holds the decoded result sets of the
+// playerdashptshots endpoint.
+type PlayerDashPtShotsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Overall                         []PlayerDashPtShotsRow
+	GeneralShooting                 []PlayerDashPtShotsRow
+	ShotClockShooting               []PlayerDashPtShotsShotClockShootingRow
+	DribbleShooting                 []PlayerDashPtShotsDribbleShootingRow
+	ClosestDefenderShooting         []PlayerDashPtShotsClosestRow
+	ClosestDefender10ftPlusShooting []PlayerDashPtShotsClosestRow
+	TouchTimeShooting               []PlayerDashPtShotsTouchTimeShootingRow
+}
+
+// GetPlayerDashPtShotsTyped is like GetPlayerDashPtShots but decodes the
+// result sets into a PlayerDashPtShotsResult.
+func (c *Client) GetPlayerDashPtShotsTyped(ctx context.Context, params PlayerDashPtShotsParams) (*PlayerDashPtShotsResult, error) {
+	resp, err := c.GetPlayerDashPtShots(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashPtShotsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Overall":                         &result.Overall,
+		"GeneralShooting":                 &result.GeneralShooting,
+		"ShotClockShooting":               &result.ShotClockShooting,
+		"DribbleShooting":                 &result.DribbleShooting,
+		"ClosestDefenderShooting":         &result.ClosestDefenderShooting,
+		"ClosestDefender10ftPlusShooting": &result.ClosestDefender10ftPlusShooting,
+		"TouchTimeShooting":               &result.TouchTimeShooting,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashptshots: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashboardByClutchResult holds the decoded result sets of the
+// playerdashboardbyclutch endpoint.
+type PlayerDashboardByClutchResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallPlayerDashboard         []PlayerDashboardRow
+	Last5Min5PointPlayerDashboard  []PlayerDashboardRow
+	Last3Min5PointPlayerDashboard  []PlayerDashboardRow
+	Last1Min5PointPlayerDashboard  []PlayerDashboardRow
+	Last30Sec3PointPlayerDashboard []PlayerDashboardRow
+}
+
+// GetPlayerDashboardByClutchTyped is like GetPlayerDashboardByClutch but
+// decodes the result sets into a PlayerDashboardByClutchResult.
+func (c *Client) GetPlayerDashboardByClutchTyped(ctx context.Context, params PlayerDashboardByClutchParams) (*PlayerDashboardByClutchResult, error) {
+	resp, err := c.GetPlayerDashboardByClutch(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashboardByClutchResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallPlayerDashboard":         &result.OverallPlayerDashboard,
+		"Last5Min5PointPlayerDashboard":  &result.Last5Min5PointPlayerDashboard,
+		"Last3Min5PointPlayerDashboard":  &result.Last3Min5PointPlayerDashboard,
+		"Last1Min5PointPlayerDashboard":  &result.Last1Min5PointPlayerDashboard,
+		"Last30Sec3PointPlayerDashboard": &result.Last30Sec3PointPlayerDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashboardbyclutch: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashboardByGameSplitsResult holds the decoded result sets of the
+// playerdashboardbygamesplits endpoint.
+type PlayerDashboardByGameSplitsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallPlayerDashboard        []PlayerDashboardRow
+	ByHalfPlayerDashboard         []PlayerDashboardRow
+	ByPeriodPlayerDashboard       []PlayerDashboardRow
+	ByScoreMarginPlayerDashboard  []PlayerDashboardRow
+	ByActualMarginPlayerDashboard []PlayerDashboardRow
+}
+
+// GetPlayerDashboardByGameSplitsTyped is like GetPlayerDashboardByGameSplits
+// but decodes the result sets into a PlayerDashboardByGameSplitsResult.
+func (c *Client) GetPlayerDashboardByGameSplitsTyped(ctx context.Context, params PlayerDashboardByGameSplitsParams) (*PlayerDashboardByGameSplitsResult, error) {
+	resp, err := c.GetPlayerDashboardByGameSplits(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashboardByGameSplitsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallPlayerDashboard":        &result.OverallPlayerDashboard,
+		"ByHalfPlayerDashboard":         &result.ByHalfPlayerDashboard,
+		"ByPeriodPlayerDashboard":       &result.ByPeriodPlayerDashboard,
+		"ByScoreMarginPlayerDashboard":  &result.ByScoreMarginPlayerDashboard,
+		"ByActualMarginPlayerDashboard": &result.ByActualMarginPlayerDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashboardbygamesplits: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashboardByGeneralSplitsResult holds the decoded result sets of the
+// playerdashboardbygeneralsplits endpoint.
+type PlayerDashboardByGeneralSplitsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	DaysRestPlayerDashboard       []PlayerDashboardRow
+	LocationPlayerDashboard       []PlayerDashboardRow
+	MonthPlayerDashboard          []PlayerDashboardRow
+	OverallPlayerDashboard        []PlayerDashboardRow
+	PrePostAllStarPlayerDashboard []PlayerDashboardRow
+	StartingPosition              []PlayerDashboardRow
+	WinsLossesPlayerDashboard     []PlayerDashboardRow
+}
+
+// GetPlayerDashboardByGeneralSplitsTyped is like
+// GetPlayerDashboardByGeneralSplits but decodes the result sets into a
+// PlayerDashboardByGeneralSplitsResult.
+func (c *Client) GetPlayerDashboardByGeneralSplitsTyped(ctx context.Context, params PlayerDashboardByGeneralSplitsParams) (*PlayerDashboardByGeneralSplitsResult, error) {
+	resp, err := c.GetPlayerDashboardByGeneralSplits(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashboardByGeneralSplitsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"DaysRestPlayerDashboard":       &result.DaysRestPlayerDashboard,
+		"LocationPlayerDashboard":       &result.LocationPlayerDashboard,
+		"MonthPlayerDashboard":          &result.MonthPlayerDashboard,
+		"OverallPlayerDashboard":        &result.OverallPlayerDashboard,
+		"PrePostAllStarPlayerDashboard": &result.PrePostAllStarPlayerDashboard,
+		"StartingPosition":              &result.StartingPosition,
+		"WinsLossesPlayerDashboard":     &result.WinsLossesPlayerDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashboardbygeneralsplits: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashboardByLastNGamesResult holds the decoded result sets of the
+// playerdashboardbylastngames endpoint.
+type PlayerDashboardByLastNGamesResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallPlayerDashboard    []PlayerDashboardRow
+	Last5PlayerDashboard      []PlayerDashboardRow
+	Last10PlayerDashboard     []PlayerDashboardRow
+	Last15PlayerDashboard     []PlayerDashboardRow
+	Last20PlayerDashboard     []PlayerDashboardRow
+	GameNumberPlayerDashboard []PlayerDashboardRow
+}
+
+// GetPlayerDashboardByLastNGamesTyped is like GetPlayerDashboardByLastNGames
+// but decodes the result sets into a PlayerDashboardByLastNGamesResult.
+func (c *Client) GetPlayerDashboardByLastNGamesTyped(ctx context.Context, params PlayerDashboardByLastNGamesParams) (*PlayerDashboardByLastNGamesResult, error) {
+	resp, err := c.GetPlayerDashboardByLastNGames(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashboardByLastNGamesResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallPlayerDashboard":    &result.OverallPlayerDashboard,
+		"Last5PlayerDashboard":      &result.Last5PlayerDashboard,
+		"Last10PlayerDashboard":     &result.Last10PlayerDashboard,
+		"Last15PlayerDashboard":     &result.Last15PlayerDashboard,
+		"Last20PlayerDashboard":     &result.Last20PlayerDashboard,
+		"GameNumberPlayerDashboard": &result.GameNumberPlayerDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashboardbylastngames: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashboardByShootingSplitsResult holds the decoded result sets of the
+// playerdashboardbyshootingsplits endpoint.
+type PlayerDashboardByShootingSplitsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallPlayerDashboard         []PlayerDashboardByShootingSplitsPlayerDashboardRow
+	Shot5FTPlayerDashboard         []PlayerDashboardByShootingSplitsPlayerDashboardRow
+	Shot8FTPlayerDashboard         []PlayerDashboardByShootingSplitsPlayerDashboardRow
+	ShotAreaPlayerDashboard        []PlayerDashboardByShootingSplitsPlayerDashboardRow
+	AssitedShotPlayerDashboard     []PlayerDashboardByShootingSplitsPlayerDashboardRow
+	ShotTypeSummaryPlayerDashboard []PlayerDashboardByShootingSplitsShotTypeSummaryPlayerDashboardRow
+}
+
+// GetPlayerDashboardByShootingSplitsTyped is like
+// GetPlayerDashboardByShootingSplits but decodes the result sets into a
+// PlayerDashboardByShootingSplitsResult.
+func (c *Client) GetPlayerDashboardByShootingSplitsTyped(ctx context.Context, params PlayerDashboardByShootingSplitsParams) (*PlayerDashboardByShootingSplitsResult, error) {
+	resp, err := c.GetPlayerDashboardByShootingSplits(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashboardByShootingSplitsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallPlayerDashboard":         &result.OverallPlayerDashboard,
+		"Shot5FTPlayerDashboard":         &result.Shot5FTPlayerDashboard,
+		"Shot8FTPlayerDashboard":         &result.Shot8FTPlayerDashboard,
+		"ShotAreaPlayerDashboard":        &result.ShotAreaPlayerDashboard,
+		"AssitedShotPlayerDashboard":     &result.AssitedShotPlayerDashboard,
+		"ShotTypeSummaryPlayerDashboard": &result.ShotTypeSummaryPlayerDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashboardbyshootingsplits: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashboardByTeamPerformanceResult holds the decoded result sets of the
+// playerdashboardbyteamperformance endpoint.
+type PlayerDashboardByTeamPerformanceResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallPlayerDashboard           []PlayerDashboardRow
+	ScoreDifferentialPlayerDashboard []PlayerDashboardRow
+	PointsScoredPlayerDashboard      []PlayerDashboardRow
+	PontsAgainstPlayerDashboard      []PlayerDashboardRow
+}
+
+// GetPlayerDashboardByTeamPerformanceTyped is like
+// GetPlayerDashboardByTeamPerformance but decodes the result sets into a
+// PlayerDashboardByTeamPerformanceResult.
+func (c *Client) GetPlayerDashboardByTeamPerformanceTyped(ctx context.Context, params PlayerDashboardByTeamPerformanceParams) (*PlayerDashboardByTeamPerformanceResult, error) {
+	resp, err := c.GetPlayerDashboardByTeamPerformance(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashboardByTeamPerformanceResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallPlayerDashboard":           &result.OverallPlayerDashboard,
+		"ScoreDifferentialPlayerDashboard": &result.ScoreDifferentialPlayerDashboard,
+		"PointsScoredPlayerDashboard":      &result.PointsScoredPlayerDashboard,
+		"PontsAgainstPlayerDashboard":      &result.PontsAgainstPlayerDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashboardbyteamperformance: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerDashboardByYearOverYearResult holds the decoded result sets of the
+// playerdashboardbyyearoveryear endpoint.
+type PlayerDashboardByYearOverYearResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallPlayerDashboard []PlayerDashboardByYearOverYearPlayerDashboardRow
+	ByYearPlayerDashboard  []PlayerDashboardByYearOverYearPlayerDashboardRow
+}
+
+// GetPlayerDashboardByYearOverYearTyped is like
+// GetPlayerDashboardByYearOverYear but decodes the result sets into a
+// PlayerDashboardByYearOverYearResult.
+func (c *Client) GetPlayerDashboardByYearOverYearTyped(ctx context.Context, params PlayerDashboardByYearOverYearParams) (*PlayerDashboardByYearOverYearResult, error) {
+	resp, err := c.GetPlayerDashboardByYearOverYear(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerDashboardByYearOverYearResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallPlayerDashboard": &result.OverallPlayerDashboard,
+		"ByYearPlayerDashboard":  &result.ByYearPlayerDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerdashboardbyyearoveryear: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerEstimatedMetricsResult holds the decoded result sets of the
+// playerestimatedmetrics endpoint.
+type PlayerEstimatedMetricsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayerEstimatedMetrics []PlayerEstimatedMetricsRow
+}
+
+// GetPlayerEstimatedMetricsTyped is like GetPlayerEstimatedMetrics but
+// decodes the result sets into a PlayerEstimatedMetricsResult.
+func (c *Client) GetPlayerEstimatedMetricsTyped(ctx context.Context, params PlayerEstimatedMetricsParams) (*PlayerEstimatedMetricsResult, error) {
+	resp, err := c.GetPlayerEstimatedMetrics(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerEstimatedMetricsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayerEstimatedMetrics": &result.PlayerEstimatedMetrics,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerestimatedmetrics: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerFantasyProfileResult holds the decoded result sets of the
+// playerfantasyprofile endpoint.
+type PlayerFantasyProfileResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Overall    []PlayerFantasyProfileRow
+	LastNGames []PlayerFantasyProfileRow
+	Location   []PlayerFantasyProfileRow
+	Opponent   []PlayerFantasyProfileRow
+}
+
+// GetPlayerFantasyProfileTyped is like GetPlayerFantasyProfile but decodes
+// the result sets into a PlayerFantasyProfileResult.
+func (c *Client) GetPlayerFantasyProfileTyped(ctx context.Context, params PlayerFantasyProfileParams) (*PlayerFantasyProfileResult, error) {
+	resp, err := c.GetPlayerFantasyProfile(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerFantasyProfileResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Overall":    &result.Overall,
+		"LastNGames": &result.LastNGames,
+		"Location":   &result.Location,
+		"Opponent":   &result.Opponent,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerfantasyprofile: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerFantasyProfileBarGraphResult holds the decoded result sets of the
+// playerfantasyprofilebargraph endpoint.
+type PlayerFantasyProfileBarGraphResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LastFiveGamesAvg []PlayerFantasyProfileBarGraphAvgRow
+	SeasonAvg        []PlayerFantasyProfileBarGraphAvgRow
+}
+
+// GetPlayerFantasyProfileBarGraphTyped is like
+// GetPlayerFantasyProfileBarGraph but decodes the result sets into a
+// PlayerFantasyProfileBarGraphResult.
+func (c *Client) GetPlayerFantasyProfileBarGraphTyped(ctx context.Context, params PlayerFantasyProfileBarGraphParams) (*PlayerFantasyProfileBarGraphResult, error) {
+	resp, err := c.GetPlayerFantasyProfileBarGraph(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerFantasyProfileBarGraphResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LastFiveGamesAvg": &result.LastFiveGamesAvg,
+		"SeasonAvg":        &result.SeasonAvg,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerfantasyprofilebargraph: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerGameLogResult holds the decoded result sets of the playergamelog
+// endpoint.
+type PlayerGameLogResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayerGameLog []PlayerGameLogRow
+}
+
+// GetPlayerGameLogTyped is like GetPlayerGameLog but decodes the result sets
+// into a PlayerGameLogResult.
+func (c *Client) GetPlayerGameLogTyped(ctx context.Context, params PlayerGameLogParams) (*PlayerGameLogResult, error) {
+	resp, err := c.GetPlayerGameLog(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerGameLogResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayerGameLog": &result.PlayerGameLog,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playergamelog: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerGameLogsResult holds the decoded result sets of the playergamelogs
+// endpoint.
+type PlayerGameLogsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayerGameLogs []PlayerGameLogsRow
+}
+
+// GetPlayerGameLogsTyped is like GetPlayerGameLogs but decodes the result
+// sets into a PlayerGameLogsResult.
+func (c *Client) GetPlayerGameLogsTyped(ctx context.Context, params PlayerGameLogsParams) (*PlayerGameLogsResult, error) {
+	resp, err := c.GetPlayerGameLogs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerGameLogsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayerGameLogs": &result.PlayerGameLogs,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playergamelogs: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerGameStreakFinderResult holds the decoded result sets of the
+// playergamestreakfinder endpoint.
+type PlayerGameStreakFinderResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayerGameStreakFinderResults []PlayerGameStreakFinderResultsRow
+}
+
+// GetPlayerGameStreakFinderTyped is like GetPlayerGameStreakFinder but
+// decodes the result sets into a PlayerGameStreakFinderResult.
+func (c *Client) GetPlayerGameStreakFinderTyped(ctx context.Context, params PlayerGameStreakFinderParams) (*PlayerGameStreakFinderResult, error) {
+	resp, err := c.GetPlayerGameStreakFinder(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerGameStreakFinderResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayerGameStreakFinderResults": &result.PlayerGameStreakFinderResults,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playergamestreakfinder: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerIndexResult holds the decoded result sets of the playerindex
+// endpoint.
+type PlayerIndexResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayerIndex []PlayerIndexRow
+}
+
+// GetPlayerIndexTyped is like GetPlayerIndex but decodes the result sets into
+// a PlayerIndexResult.
+func (c *Client) GetPlayerIndexTyped(ctx context.Context, params PlayerIndexParams) (*PlayerIndexResult, error) {
+	resp, err := c.GetPlayerIndex(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerIndexResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayerIndex": &result.PlayerIndex,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerindex: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerProfileV2Result holds the decoded result sets of the playerprofilev2
+// endpoint.
+type PlayerProfileV2Result struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	CareerHighs                 []PlayerProfileV2HighsRow
+	CareerTotalsAllStarSeason   []PlayerCareerTotalsRow
+	CareerTotalsCollegeSeason   []PlayerCollegeCareerTotalsRow
+	CareerTotalsPostSeason      []PlayerCareerTotalsRow
+	CareerTotalsPreseason       []PlayerCareerTotalsRow
+	CareerTotalsRegularSeason   []PlayerCareerTotalsRow
+	NextGame                    []PlayerProfileV2NextGameRow
+	SeasonHighs                 []PlayerProfileV2HighsRow
+	SeasonRankingsPostSeason    []PlayerSeasonRankingsRow
+	SeasonRankingsRegularSeason []PlayerSeasonRankingsRow
+	SeasonTotalsAllStarSeason   []PlayerSeasonTotalsRow
+	SeasonTotalsCollegeSeason   []PlayerCollegeSeasonTotalsRow
+	SeasonTotalsPostSeason      []PlayerSeasonTotalsRow
+	SeasonTotalsPreseason       []PlayerSeasonTotalsRow
+	SeasonTotalsRegularSeason   []PlayerSeasonTotalsRow
+}
+
+// GetPlayerProfileV2Typed is like GetPlayerProfileV2 but decodes the result
+// sets into a PlayerProfileV2Result.
+func (c *Client) GetPlayerProfileV2Typed(ctx context.Context, params PlayerProfileV2Params) (*PlayerProfileV2Result, error) {
+	resp, err := c.GetPlayerProfileV2(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerProfileV2Result{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"CareerHighs":                 &result.CareerHighs,
+		"CareerTotalsAllStarSeason":   &result.CareerTotalsAllStarSeason,
+		"CareerTotalsCollegeSeason":   &result.CareerTotalsCollegeSeason,
+		"CareerTotalsPostSeason":      &result.CareerTotalsPostSeason,
+		"CareerTotalsPreseason":       &result.CareerTotalsPreseason,
+		"CareerTotalsRegularSeason":   &result.CareerTotalsRegularSeason,
+		"NextGame":                    &result.NextGame,
+		"SeasonHighs":                 &result.SeasonHighs,
+		"SeasonRankingsPostSeason":    &result.SeasonRankingsPostSeason,
+		"SeasonRankingsRegularSeason": &result.SeasonRankingsRegularSeason,
+		"SeasonTotalsAllStarSeason":   &result.SeasonTotalsAllStarSeason,
+		"SeasonTotalsCollegeSeason":   &result.SeasonTotalsCollegeSeason,
+		"SeasonTotalsPostSeason":      &result.SeasonTotalsPostSeason,
+		"SeasonTotalsPreseason":       &result.SeasonTotalsPreseason,
+		"SeasonTotalsRegularSeason":   &result.SeasonTotalsRegularSeason,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playerprofilev2: %w", err)
+	}
+	return result, nil
+}
+
+// PlayerVsPlayerResult holds the decoded result sets of the playervsplayer
+// endpoint.
+type PlayerVsPlayerResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OnOffCourt           []PlayerVsPlayerOnOffCourtRow
+	Overall              []PlayerVsPlayerOverallRow
+	PlayerInfo           []PlayerVsPlayerPlayerInfoRow
+	ShotAreaOffCourt     []PlayerVsPlayerShotRow
+	ShotAreaOnCourt      []PlayerVsPlayerShotRow
+	ShotAreaOverall      []PlayerVsPlayerShotOverallRow
+	ShotDistanceOffCourt []PlayerVsPlayerShotRow
+	ShotDistanceOnCourt  []PlayerVsPlayerShotRow
+	ShotDistanceOverall  []PlayerVsPlayerShotOverallRow
+	VsPlayerInfo         []PlayerVsPlayerPlayerInfoRow
+}
+
+// GetPlayerVsPlayerTyped is like GetPlayerVsPlayer but decodes the result
+// sets into a PlayerVsPlayerResult.
+func (c *Client) GetPlayerVsPlayerTyped(ctx context.Context, params PlayerVsPlayerParams) (*PlayerVsPlayerResult, error) {
+	resp, err := c.GetPlayerVsPlayer(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayerVsPlayerResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OnOffCourt":           &result.OnOffCourt,
+		"Overall":              &result.Overall,
+		"PlayerInfo":           &result.PlayerInfo,
+		"ShotAreaOffCourt":     &result.ShotAreaOffCourt,
+		"ShotAreaOnCourt":      &result.ShotAreaOnCourt,
+		"ShotAreaOverall":      &result.ShotAreaOverall,
+		"ShotDistanceOffCourt": &result.ShotDistanceOffCourt,
+		"ShotDistanceOnCourt":  &result.ShotDistanceOnCourt,
+		"ShotDistanceOverall":  &result.ShotDistanceOverall,
+		"VsPlayerInfo":         &result.VsPlayerInfo,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playervsplayer: %w", err)
+	}
+	return result, nil
+}
+
+// CommonAllPlayersRow is a row of the commonallplayers CommonAllPlayers
+// result set.
+type CommonAllPlayersRow struct {
+	PersonID                int    `nba:"PERSON_ID"`
+	DisplayLastCommaFirst   string `nba:"DISPLAY_LAST_COMMA_FIRST"`
+	DisplayFirstLast        string `nba:"DISPLAY_FIRST_LAST"`
+	Rosterstatus            int    `nba:"ROSTERSTATUS"`
+	FromYear                string `nba:"FROM_YEAR"`
+	ToYear                  string `nba:"TO_YEAR"`
+	Playercode              string `nba:"PLAYERCODE"`
+	PlayerSlug              string `nba:"PLAYER_SLUG"`
+	TeamID                  int    `nba:"TEAM_ID"`
+	TeamCity                string `nba:"TEAM_CITY"`
+	TeamName                string `nba:"TEAM_NAME"`
+	TeamAbbreviation        string `nba:"TEAM_ABBREVIATION"`
+	TeamSlug                string `nba:"TEAM_SLUG"`
+	TeamCode                string `nba:"TEAM_CODE"`
+	GamesPlayedFlag         string `nba:"GAMES_PLAYED_FLAG"`
+	OtherleagueExperienceCh string `nba:"OTHERLEAGUE_EXPERIENCE_CH"`
+}
+
+// CommonPlayerInfoAvailableSeasonsRow is a row of the commonplayerinfo
+// AvailableSeasons result set.
+type CommonPlayerInfoAvailableSeasonsRow struct {
+	SeasonID string `nba:"SEASON_ID"`
+}
+
+// CommonPlayerInfoPlayerHeadlineStatsRow is a row of the commonplayerinfo
+// PlayerHeadlineStats result set.
+type CommonPlayerInfoPlayerHeadlineStatsRow struct {
+	PlayerID   int     `nba:"PLAYER_ID"`
+	PlayerName string  `nba:"PLAYER_NAME"`
+	TimeFrame  string  `nba:"TimeFrame"`
+	PTS        float64 `nba:"PTS"`
+	AST        float64 `nba:"AST"`
+	REB        float64 `nba:"REB"`
+	PIE        float64 `nba:"PIE"`
+}
+
+// CommonPlayerInfoRow is a row of the commonplayerinfo CommonPlayerInfo
+// result set.
+type CommonPlayerInfoRow struct {
+	PersonID                     int       `nba:"PERSON_ID"`
+	FirstName                    string    `nba:"FIRST_NAME"`
+	LastName                     string    `nba:"LAST_NAME"`
+	DisplayFirstLast             string    `nba:"DISPLAY_FIRST_LAST"`
+	DisplayLastCommaFirst        string    `nba:"DISPLAY_LAST_COMMA_FIRST"`
+	DisplayFiLast                string    `nba:"DISPLAY_FI_LAST"`
+	PlayerSlug                   string    `nba:"PLAYER_SLUG"`
+	Birthdate                    time.Time `nba:"BIRTHDATE"`
+	School                       string    `nba:"SCHOOL"`
+	Country                      string    `nba:"COUNTRY"`
+	LastAffiliation              string    `nba:"LAST_AFFILIATION"`
+	Height                       string    `nba:"HEIGHT"`
+	Weight                       string    `nba:"WEIGHT"`
+	SeasonExp                    int       `nba:"SEASON_EXP"`
+	Jersey                       string    `nba:"JERSEY"`
+	Position                     string    `nba:"POSITION"`
+	Rosterstatus                 string    `nba:"ROSTERSTATUS"`
+	GamesPlayedCurrentSeasonFlag string    `nba:"GAMES_PLAYED_CURRENT_SEASON_FLAG"`
+	TeamID                       int       `nba:"TEAM_ID"`
+	TeamName                     string    `nba:"TEAM_NAME"`
+	TeamAbbreviation             string    `nba:"TEAM_ABBREVIATION"`
+	TeamCode                     string    `nba:"TEAM_CODE"`
+	TeamCity                     string    `nba:"TEAM_CITY"`
+	Playercode                   string    `nba:"PLAYERCODE"`
+	FromYear                     int       `nba:"FROM_YEAR"`
+	ToYear                       int       `nba:"TO_YEAR"`
+	DleagueFlag                  string    `nba:"DLEAGUE_FLAG"`
+	NBAFlag                      string    `nba:"NBA_FLAG"`
+	GamesPlayedFlag              string    `nba:"GAMES_PLAYED_FLAG"`
+	DraftYear                    string    `nba:"DRAFT_YEAR"`
+	DraftRound                   string    `nba:"DRAFT_ROUND"`
+	DraftNumber                  string    `nba:"DRAFT_NUMBER"`
+	Greatest75Flag               string    `nba:"GREATEST_75_FLAG"`
+}
+
+// PlayerAwardsRow is a row of the playerawards PlayerAwards result set.
+type PlayerAwardsRow struct {
+	PersonID         int    `nba:"PERSON_ID"`
+	FirstName        string `nba:"FIRST_NAME"`
+	LastName         string `nba:"LAST_NAME"`
+	Team             string `nba:"TEAM"`
+	Description      string `nba:"DESCRIPTION"`
+	AllNBATeamNumber string `nba:"ALL_NBA_TEAM_NUMBER"`
+	Season           string `nba:"SEASON"`
+	Month            string `nba:"MONTH"`
+	Week             string `nba:"WEEK"`
+	Conference       string `nba:"CONFERENCE"`
+	Type             string `nba:"TYPE"`
+	Subtype1         string `nba:"SUBTYPE1"`
+	Subtype2         string `nba:"SUBTYPE2"`
+	Subtype3         string `nba:"SUBTYPE3"`
+}
+
+// PlayerCareerByCollegeRollupRow is a row of the playercareerbycollegerollup
+// East, playercareerbycollegerollup Midwest, playercareerbycollegerollup
+// South and playercareerbycollegerollup West result sets.
+type PlayerCareerByCollegeRollupRow struct {
+	Region  string  `nba:"REGION"`
+	Seed    string  `nba:"SEED"`
+	College string  `nba:"COLLEGE"`
+	Players float64 `nba:"PLAYERS"`
+	GP      int     `nba:"GP"`
+	Min     float64 `nba:"MIN"`
+	FGM     float64 `nba:"FGM"`
+	FGA     float64 `nba:"FGA"`
+	FGPct   float64 `nba:"FG_PCT"`
+	FG3M    float64 `nba:"FG3M"`
+	FG3A    float64 `nba:"FG3A"`
+	FG3Pct  float64 `nba:"FG3_PCT"`
+	FTM     float64 `nba:"FTM"`
+	FTA     float64 `nba:"FTA"`
+	FTPct   float64 `nba:"FT_PCT"`
+	OREB    float64 `nba:"OREB"`
+	DREB    float64 `nba:"DREB"`
+	REB     float64 `nba:"REB"`
+	AST     float64 `nba:"AST"`
+	STL     float64 `nba:"STL"`
+	BLK     float64 `nba:"BLK"`
+	TOV     float64 `nba:"TOV"`
+	PF      float64 `nba:"PF"`
+	PTS     float64 `nba:"PTS"`
+}
+
+// PlayerCareerTotalsRow is a row of the playercareerstats
+// CareerTotalsAllStarSeason, playercareerstats CareerTotalsPostSeason,
+// playercareerstats CareerTotalsRegularSeason, playerprofilev2
+// CareerTotalsAllStarSeason, playerprofilev2 CareerTotalsPostSeason,
+// playerprofilev2 CareerTotalsPreseason and playerprofilev2
+// CareerTotalsRegularSeason result sets.
+type PlayerCareerTotalsRow struct {
+	PlayerID int     `nba:"PLAYER_ID"`
+	LeagueID string  `nba:"LEAGUE_ID"`
+	TeamID   int     `nba:"Team_ID"`
+	GP       int     `nba:"GP"`
+	GS       int     `nba:"GS"`
+	Min      float64 `nba:"MIN"`
+	FGM      float64 `nba:"FGM"`
+	FGA      float64 `nba:"FGA"`
+	FGPct    float64 `nba:"FG_PCT"`
+	FG3M     float64 `nba:"FG3M"`
+	FG3A     float64 `nba:"FG3A"`
+	FG3Pct   float64 `nba:"FG3_PCT"`
+	FTM      float64 `nba:"FTM"`
+	FTA      float64 `nba:"FTA"`
+	FTPct    float64 `nba:"FT_PCT"`
+	OREB     float64 `nba:"OREB"`
+	DREB     float64 `nba:"DREB"`
+	REB      float64 `nba:"REB"`
+	AST      float64 `nba:"AST"`
+	STL      float64 `nba:"STL"`
+	BLK      float64 `nba:"BLK"`
+	TOV      float64 `nba:"TOV"`
+	PF       float64 `nba:"PF"`
+	PTS      float64 `nba:"PTS"`
+}
+
+// PlayerCollegeCareerTotalsRow is a row of the playercareerstats
+// CareerTotalsCollegeSeason and playerprofilev2 CareerTotalsCollegeSeason
+// result sets.
+type PlayerCollegeCareerTotalsRow struct {
+	PlayerID       int     `nba:"PLAYER_ID"`
+	LeagueID       string  `nba:"LEAGUE_ID"`
+	OrganizationID int     `nba:"ORGANIZATION_ID"`
+	GP             int     `nba:"GP"`
+	GS             int     `nba:"GS"`
+	Min            float64 `nba:"MIN"`
+	FGM            float64 `nba:"FGM"`
+	FGA            float64 `nba:"FGA"`
+	FGPct          float64 `nba:"FG_PCT"`
+	FG3M           float64 `nba:"FG3M"`
+	FG3A           float64 `nba:"FG3A"`
+	FG3Pct         float64 `nba:"FG3_PCT"`
+	FTM            float64 `nba:"FTM"`
+	FTA            float64 `nba:"FTA"`
+	FTPct          float64 `nba:"FT_PCT"`
+	OREB           float64 `nba:"OREB"`
+	DREB           float64 `nba:"DREB"`
+	REB            float64 `nba:"REB"`
+	AST            float64 `nba:"AST"`
+	STL            float64 `nba:"STL"`
+	BLK            float64 `nba:"BLK"`
+	TOV            float64 `nba:"TOV"`
+	PF             float64 `nba:"PF"`
+	PTS            float64 `nba:"PTS"`
+}
+
+// PlayerCollegeSeasonTotalsRow is a row of the playercareerstats
+// SeasonTotalsCollegeSeason and playerprofilev2 SeasonTotalsCollegeSeason
+// result sets.
+type PlayerCollegeSeasonTotalsRow struct {
+	PlayerID       int     `nba:"PLAYER_ID"`
+	SeasonID       string  `nba:"SEASON_ID"`
+	LeagueID       string  `nba:"LEAGUE_ID"`
+	OrganizationID int     `nba:"ORGANIZATION_ID"`
+	SchoolName     string  `nba:"SCHOOL_NAME"`
+	PlayerAge      float64 `nba:"PLAYER_AGE"`
+	GP             int     `nba:"GP"`
+	GS             int     `nba:"GS"`
+	Min            float64 `nba:"MIN"`
+	FGM            float64 `nba:"FGM"`
+	FGA            float64 `nba:"FGA"`
+	FGPct          float64 `nba:"FG_PCT"`
+	FG3M           float64 `nba:"FG3M"`
+	FG3A           float64 `nba:"FG3A"`
+	FG3Pct         float64 `nba:"FG3_PCT"`
+	FTM            float64 `nba:"FTM"`
+	FTA            float64 `nba:"FTA"`
+	FTPct          float64 `nba:"FT_PCT"`
+	OREB           float64 `nba:"OREB"`
+	DREB           float64 `nba:"DREB"`
+	REB            float64 `nba:"REB"`
+	AST            float64 `nba:"AST"`
+	STL            float64 `nba:"STL"`
+	BLK            float64 `nba:"BLK"`
+	TOV            float64 `nba:"TOV"`
+	PF             float64 `nba:"PF"`
+	PTS            float64 `nba:"PTS"`
+}
+
+// PlayerCompareIndividualRow is a row of the playercompare Individual result
+// set.
+type PlayerCompareIndividualRow struct {
+	GroupSet    string  `nba:"GROUP_SET"`
+	Description string  `nba:"DESCRIPTION"`
+	PlayerID    int     `nba:"PLAYER_ID"`
+	FirstName   string  `nba:"FIRST_NAME"`
+	LastName    string  `nba:"LAST_NAME"`
+	Min         float64 `nba:"MIN"`
+	FGM         float64 `nba:"FGM"`
+	FGA         float64 `nba:"FGA"`
+	FGPct       float64 `nba:"FG_PCT"`
+	FG3M        float64 `nba:"FG3M"`
+	FG3A        float64 `nba:"FG3A"`
+	FG3Pct      float64 `nba:"FG3_PCT"`
+	FTM         float64 `nba:"FTM"`
+	FTA         float64 `nba:"FTA"`
+	FTPct       float64 `nba:"FT_PCT"`
+	OREB        float64 `nba:"OREB"`
+	DREB        float64 `nba:"DREB"`
+	REB         float64 `nba:"REB"`
+	AST         float64 `nba:"AST"`
+	TOV         float64 `nba:"TOV"`
+	STL         float64 `nba:"STL"`
+	BLK         float64 `nba:"BLK"`
+	BLKA        float64 `nba:"BLKA"`
+	PF          float64 `nba:"PF"`
+	PFD         float64 `nba:"PFD"`
+	PTS         float64 `nba:"PTS"`
+	PlusMinus   float64 `nba:"PLUS_MINUS"`
+}
+
+// PlayerCompareOverallCompareRow is a row of the playercompare OverallCompare
+// result set.
+type PlayerCompareOverallCompareRow struct {
+	GroupSet    string  `nba:"GROUP_SET"`
+	Description string  `nba:"DESCRIPTION"`
+	Min         float64 `nba:"MIN"`
+	FGM         float64 `nba:"FGM"`
+	FGA         float64 `nba:"FGA"`
+	FGPct       float64 `nba:"FG_PCT"`
+	FG3M        float64 `nba:"FG3M"`
+	FG3A        float64 `nba:"FG3A"`
+	FG3Pct      float64 `nba:"FG3_PCT"`
+	FTM         float64 `nba:"FTM"`
+	FTA         float64 `nba:"FTA"`
+	FTPct       float64 `nba:"FT_PCT"`
+	OREB        float64 `nba:"OREB"`
+	DREB        float64 `nba:"DREB"`
+	REB         float64 `nba:"REB"`
+	AST         float64 `nba:"AST"`
+	TOV         float64 `nba:"TOV"`
+	STL         float64 `nba:"STL"`
+	BLK         float64 `nba:"BLK"`
+	BLKA        float64 `nba:"BLKA"`
+	PF          float64 `nba:"PF"`
+	PFD         float64 `nba:"PFD"`
+	PTS         float64 `nba:"PTS"`
+	PlusMinus   float64 `nba:"PLUS_MINUS"`
+}
+
+// PlayerDashPtPassPassesMadeRow is a row of the playerdashptpass PassesMade
+// result set.
+type PlayerDashPtPassPassesMadeRow struct {
+	PlayerID             int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst  string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	TeamName             string  `nba:"TEAM_NAME"`
+	TeamID               int     `nba:"TEAM_ID"`
+	TeamAbbreviation     string  `nba:"TEAM_ABBREVIATION"`
+	PassType             string  `nba:"PASS_TYPE"`
+	G                    float64 `nba:"G"`
+	PassTo               string  `nba:"PASS_TO"`
+	PassTeammatePlayerID int     `nba:"PASS_TEAMMATE_PLAYER_ID"`
+	Frequency            float64 `nba:"FREQUENCY"`
+	Pass                 float64 `nba:"PASS"`
+	AST                  float64 `nba:"AST"`
+	FGM                  float64 `nba:"FGM"`
+	FGA                  float64 `nba:"FGA"`
+	FGPct                float64 `nba:"FG_PCT"`
+	Fg2m                 float64 `nba:"FG2M"`
+	Fg2a                 float64 `nba:"FG2A"`
+	Fg2Pct               float64 `nba:"FG2_PCT"`
+	FG3M                 float64 `nba:"FG3M"`
+	FG3A                 float64 `nba:"FG3A"`
+	FG3Pct               float64 `nba:"FG3_PCT"`
+}
+
+// PlayerDashPtPassPassesReceivedRow is a row of the playerdashptpass
+// PassesReceived result set.
+type PlayerDashPtPassPassesReceivedRow struct {
+	PlayerID             int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst  string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	TeamName             string  `nba:"TEAM_NAME"`
+	TeamID               int     `nba:"TEAM_ID"`
+	TeamAbbreviation     string  `nba:"TEAM_ABBREVIATION"`
+	PassType             string  `nba:"PASS_TYPE"`
+	G                    float64 `nba:"G"`
+	PassFrom             string  `nba:"PASS_FROM"`
+	PassTeammatePlayerID int     `nba:"PASS_TEAMMATE_PLAYER_ID"`
+	Frequency            float64 `nba:"FREQUENCY"`
+	Pass                 float64 `nba:"PASS"`
+	AST                  float64 `nba:"AST"`
+	FGM                  float64 `nba:"FGM"`
+	FGA                  float64 `nba:"FGA"`
+	FGPct                float64 `nba:"FG_PCT"`
+	Fg2m                 float64 `nba:"FG2M"`
+	Fg2a                 float64 `nba:"FG2A"`
+	Fg2Pct               float64 `nba:"FG2_PCT"`
+	FG3M                 float64 `nba:"FG3M"`
+	FG3A                 float64 `nba:"FG3A"`
+	FG3Pct               float64 `nba:"FG3_PCT"`
+}
+
+// PlayerDashPtRebNumContestedReboundingRow is a row of the playerdashptreb
+// NumContestedRebounding result set.
+type PlayerDashPtRebNumContestedReboundingRow struct {
+	PlayerID              int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst   string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder             float64 `nba:"SORT_ORDER"`
+	G                     float64 `nba:"G"`
+	REBNumContestingRange string  `nba:"REB_NUM_CONTESTING_RANGE"`
+	REBFrequency          float64 `nba:"REB_FREQUENCY"`
+	OREB                  float64 `nba:"OREB"`
+	DREB                  float64 `nba:"DREB"`
+	REB                   float64 `nba:"REB"`
+	COREB                 float64 `nba:"C_OREB"`
+	CDREB                 float64 `nba:"C_DREB"`
+	CREB                  float64 `nba:"C_REB"`
+	CREBPct               float64 `nba:"C_REB_PCT"`
+	UcOREB                float64 `nba:"UC_OREB"`
+	UcDREB                float64 `nba:"UC_DREB"`
+	UcREB                 float64 `nba:"UC_REB"`
+	UcREBPct              float64 `nba:"UC_REB_PCT"`
+}
+
+// PlayerDashPtRebOverallReboundingRow is a row of the playerdashptreb
+// OverallRebounding result set.
+type PlayerDashPtRebOverallReboundingRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	G                   float64 `nba:"G"`
+	Overall             string  `nba:"OVERALL"`
+	REBFrequency        float64 `nba:"REB_FREQUENCY"`
+	OREB                float64 `nba:"OREB"`
+	DREB                float64 `nba:"DREB"`
+	REB                 float64 `nba:"REB"`
+	COREB               float64 `nba:"C_OREB"`
+	CDREB               float64 `nba:"C_DREB"`
+	CREB                float64 `nba:"C_REB"`
+	CREBPct             float64 `nba:"C_REB_PCT"`
+	UcOREB              float64 `nba:"UC_OREB"`
+	UcDREB              float64 `nba:"UC_DREB"`
+	UcREB               float64 `nba:"UC_REB"`
+	UcREBPct            float64 `nba:"UC_REB_PCT"`
+}
+
+// PlayerDashPtRebRebDistanceReboundingRow is a row of the playerdashptreb
+// RebDistanceRebounding result set.
+type PlayerDashPtRebRebDistanceReboundingRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	G                   float64 `nba:"G"`
+	REBDistRange        string  `nba:"REB_DIST_RANGE"`
+	REBFrequency        float64 `nba:"REB_FREQUENCY"`
+	OREB                float64 `nba:"OREB"`
+	DREB                float64 `nba:"DREB"`
+	REB                 float64 `nba:"REB"`
+	COREB               float64 `nba:"C_OREB"`
+	CDREB               float64 `nba:"C_DREB"`
+	CREB                float64 `nba:"C_REB"`
+	CREBPct             float64 `nba:"C_REB_PCT"`
+	UcOREB              float64 `nba:"UC_OREB"`
+	UcDREB              float64 `nba:"UC_DREB"`
+	UcREB               float64 `nba:"UC_REB"`
+	UcREBPct            float64 `nba:"UC_REB_PCT"`
+}
+
+// PlayerDashPtRebShotDistanceReboundingRow is a row of the playerdashptreb
+// ShotDistanceRebounding result set.
+type PlayerDashPtRebShotDistanceReboundingRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	G                   float64 `nba:"G"`
+	ShotDistRange       string  `nba:"SHOT_DIST_RANGE"`
+	REBFrequency        float64 `nba:"REB_FREQUENCY"`
+	OREB                float64 `nba:"OREB"`
+	DREB                float64 `nba:"DREB"`
+	REB                 float64 `nba:"REB"`
+	COREB               float64 `nba:"C_OREB"`
+	CDREB               float64 `nba:"C_DREB"`
+	CREB                float64 `nba:"C_REB"`
+	CREBPct             float64 `nba:"C_REB_PCT"`
+	UcOREB              float64 `nba:"UC_OREB"`
+	UcDREB              float64 `nba:"UC_DREB"`
+	UcREB               float64 `nba:"UC_REB"`
+	UcREBPct            float64 `nba:"UC_REB_PCT"`
+}
+
+// PlayerDashPtRebShotTypeReboundingRow is a row of the playerdashptreb
+// ShotTypeRebounding result set.
+type PlayerDashPtRebShotTypeReboundingRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	G                   float64 `nba:"G"`
+	ShotTypeRange       string  `nba:"SHOT_TYPE_RANGE"`
+	REBFrequency        float64 `nba:"REB_FREQUENCY"`
+	OREB                float64 `nba:"OREB"`
+	DREB                float64 `nba:"DREB"`
+	REB                 float64 `nba:"REB"`
+	COREB               float64 `nba:"C_OREB"`
+	CDREB               float64 `nba:"C_DREB"`
+	CREB                float64 `nba:"C_REB"`
+	CREBPct             float64 `nba:"C_REB_PCT"`
+	UcOREB              float64 `nba:"UC_OREB"`
+	UcDREB              float64 `nba:"UC_DREB"`
+	UcREB               float64 `nba:"UC_REB"`
+	UcREBPct            float64 `nba:"UC_REB_PCT"`
+}
+
+// PlayerDashPtShotDefendDefendingShotsRow is a row of the
+// playerdashptshotdefend DefendingShots result set.
+type PlayerDashPtShotDefendDefendingShotsRow struct {
+	CloseDefPersonID int     `nba:"CLOSE_DEF_PERSON_ID"`
+	GP               int     `nba:"GP"`
+	G                float64 `nba:"G"`
+	DefenseCategory  string  `nba:"DEFENSE_CATEGORY"`
+	Freq             float64 `nba:"FREQ"`
+	DFGM             float64 `nba:"D_FGM"`
+	DFGA             float64 `nba:"D_FGA"`
+	DFGPct           float64 `nba:"D_FG_PCT"`
+	NormalFGPct      float64 `nba:"NORMAL_FG_PCT"`
+	PctPlusminus     float64 `nba:"PCT_PLUSMINUS"`
+}
+
+// PlayerDashPtShotsClosestRow is a row of the playerdashptshots
+// ClosestDefenderShooting and playerdashptshots
+// ClosestDefender10ftPlusShooting result sets.
+type PlayerDashPtShotsClosestRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	GP                  int     `nba:"GP"`
+	G                   float64 `nba:"G"`
+	CloseDefDistRange   string  `nba:"CLOSE_DEF_DIST_RANGE"`
+	FGAFrequency        float64 `nba:"FGA_FREQUENCY"`
+	FGM                 float64 `nba:"FGM"`
+	FGA                 float64 `nba:"FGA"`
+	FGPct               float64 `nba:"FG_PCT"`
+	EFGPct              float64 `nba:"EFG_PCT"`
+	Fg2aFrequency       float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m                float64 `nba:"FG2M"`
+	Fg2a                float64 `nba:"FG2A"`
+	Fg2Pct              float64 `nba:"FG2_PCT"`
+	FG3AFrequency       float64 `nba:"FG3A_FREQUENCY"`
+	FG3M                float64 `nba:"FG3M"`
+	FG3A                float64 `nba:"FG3A"`
+	FG3Pct              float64 `nba:"FG3_PCT"`
+}
+
+// PlayerDashPtShotsDribbleShootingRow is a row of the playerdashptshots
+// DribbleShooting result set.
+type PlayerDashPtShotsDribbleShootingRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	GP                  int     `nba:"GP"`
+	G                   float64 `nba:"G"`
+	DribbleRange        string  `nba:"DRIBBLE_RANGE"`
+	FGAFrequency        float64 `nba:"FGA_FREQUENCY"`
+	FGM                 float64 `nba:"FGM"`
+	FGA                 float64 `nba:"FGA"`
+	FGPct               float64 `nba:"FG_PCT"`
+	EFGPct              float64 `nba:"EFG_PCT"`
+	Fg2aFrequency       float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m                float64 `nba:"FG2M"`
+	Fg2a                float64 `nba:"FG2A"`
+	Fg2Pct              float64 `nba:"FG2_PCT"`
+	FG3AFrequency       float64 `nba:"FG3A_FREQUENCY"`
+	FG3M                float64 `nba:"FG3M"`
+	FG3A                float64 `nba:"FG3A"`
+	FG3Pct              float64 `nba:"FG3_PCT"`
+}
+
+// PlayerDashPtShotsRow is a row of the playerdashptshots Overall and
+// playerdashptshots GeneralShooting result sets.
+type PlayerDashPtShotsRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	GP                  int     `nba:"GP"`
+	G                   float64 `nba:"G"`
+	ShotType            string  `nba:"SHOT_TYPE"`
+	FGAFrequency        float64 `nba:"FGA_FREQUENCY"`
+	FGM                 float64 `nba:"FGM"`
+	FGA                 float64 `nba:"FGA"`
+	FGPct               float64 `nba:"FG_PCT"`
+	EFGPct              float64 `nba:"EFG_PCT"`
+	Fg2aFrequency       float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m                float64 `nba:"FG2M"`
+	Fg2a                float64 `nba:"FG2A"`
+	Fg2Pct              float64 `nba:"FG2_PCT"`
+	FG3AFrequency       float64 `nba:"FG3A_FREQUENCY"`
+	FG3M                float64 `nba:"FG3M"`
+	FG3A                float64 `nba:"FG3A"`
+	FG3Pct              float64 `nba:"FG3_PCT"`
+}
+
+// PlayerDashPtShotsShotClockShootingRow is a row of the playerdashptshots
+// ShotClockShooting result set.
+type PlayerDashPtShotsShotClockShootingRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	GP                  int     `nba:"GP"`
+	G                   float64 `nba:"G"`
+	ShotClockRange      string  `nba:"SHOT_CLOCK_RANGE"`
+	FGAFrequency        float64 `nba:"FGA_FREQUENCY"`
+	FGM                 float64 `nba:"FGM"`
+	FGA                 float64 `nba:"FGA"`
+	FGPct               float64 `nba:"FG_PCT"`
+	EFGPct              float64 `nba:"EFG_PCT"`
+	Fg2aFrequency       float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m                float64 `nba:"FG2M"`
+	Fg2a                float64 `nba:"FG2A"`
+	Fg2Pct              float64 `nba:"FG2_PCT"`
+	FG3AFrequency       float64 `nba:"FG3A_FREQUENCY"`
+	FG3M                float64 `nba:"FG3M"`
+	FG3A                float64 `nba:"FG3A"`
+	FG3Pct              float64 `nba:"FG3_PCT"`
+}
+
+// PlayerDashPtShotsTouchTimeShootingRow is a row of the playerdashptshots
+// TouchTimeShooting result set.
+type PlayerDashPtShotsTouchTimeShootingRow struct {
+	PlayerID            int     `nba:"PLAYER_ID"`
+	PlayerNameLastFirst string  `nba:"PLAYER_NAME_LAST_FIRST"`
+	SortOrder           float64 `nba:"SORT_ORDER"`
+	GP                  int     `nba:"GP"`
+	G                   float64 `nba:"G"`
+	TouchTimeRange      string  `nba:"TOUCH_TIME_RANGE"`
+	FGAFrequency        float64 `nba:"FGA_FREQUENCY"`
+	FGM                 float64 `nba:"FGM"`
+	FGA                 float64 `nba:"FGA"`
+	FGPct               float64 `nba:"FG_PCT"`
+	EFGPct              float64 `nba:"EFG_PCT"`
+	Fg2aFrequency       float64 `nba:"FG2A_FREQUENCY"`
+	Fg2m                float64 `nba:"FG2M"`
+	Fg2a                float64 `nba:"FG2A"`
+	Fg2Pct              float64 `nba:"FG2_PCT"`
+	FG3AFrequency       float64 `nba:"FG3A_FREQUENCY"`
+	FG3M                float64 `nba:"FG3M"`
+	FG3A                float64 `nba:"FG3A"`
+	FG3Pct              float64 `nba:"FG3_PCT"`
+}
+
+// PlayerDashboardByShootingSplitsPlayerDashboardRow is a row of the
+// playerdashboardbyshootingsplits OverallPlayerDashboard,
+// playerdashboardbyshootingsplits Shot5FTPlayerDashboard,
+// playerdashboardbyshootingsplits Shot8FTPlayerDashboard,
+// playerdashboardbyshootingsplits ShotAreaPlayerDashboard and
+// playerdashboardbyshootingsplits AssitedShotPlayerDashboard result sets.
+type PlayerDashboardByShootingSplitsPlayerDashboardRow struct {
+	GroupSet       string  `nba:"GROUP_SET"`
+	GroupValue     string  `nba:"GROUP_VALUE"`
+	FGM            float64 `nba:"FGM"`
+	FGA            float64 `nba:"FGA"`
+	FGPct          float64 `nba:"FG_PCT"`
+	FG3M           float64 `nba:"FG3M"`
+	FG3A           float64 `nba:"FG3A"`
+	FG3Pct         float64 `nba:"FG3_PCT"`
+	EFGPct         float64 `nba:"EFG_PCT"`
+	BLKA           float64 `nba:"BLKA"`
+	PctAST2pm      float64 `nba:"PCT_AST_2PM"`
+	PctUast2pm     float64 `nba:"PCT_UAST_2PM"`
+	PctAST3pm      float64 `nba:"PCT_AST_3PM"`
+	PctUast3pm     float64 `nba:"PCT_UAST_3PM"`
+	PctASTFGM      float64 `nba:"PCT_AST_FGM"`
+	PctUastFGM     float64 `nba:"PCT_UAST_FGM"`
+	FGMRank        int     `nba:"FGM_RANK"`
+	FGARank        int     `nba:"FGA_RANK"`
+	FGPctRank      int     `nba:"FG_PCT_RANK"`
+	FG3MRank       int     `nba:"FG3M_RANK"`
+	FG3ARank       int     `nba:"FG3A_RANK"`
+	FG3PctRank     int     `nba:"FG3_PCT_RANK"`
+	EFGPctRank     int     `nba:"EFG_PCT_RANK"`
+	BLKARank       int     `nba:"BLKA_RANK"`
+	PctAST2pmRank  int     `nba:"PCT_AST_2PM_RANK"`
+	PctUast2pmRank int     `nba:"PCT_UAST_2PM_RANK"`
+	PctAST3pmRank  int     `nba:"PCT_AST_3PM_RANK"`
+	PctUast3pmRank int     `nba:"PCT_UAST_3PM_RANK"`
+	PctASTFGMRank  int     `nba:"PCT_AST_FGM_RANK"`
+	PctUastFGMRank int     `nba:"PCT_UAST_FGM_RANK"`
+	Cfid           int     `nba:"CFID"`
+	Cfparams       string  `nba:"CFPARAMS"`
+}
+
+// PlayerDashboardByShootingSplitsShotTypeSummaryPlayerDashboardRow is a row
+// of the playerdashboardbyshootingsplits ShotTypeSummaryPlayerDashboard
+// result set.
+type PlayerDashboardByShootingSplitsShotTypeSummaryPlayerDashboardRow struct {
+	GroupSet   string  `nba:"GROUP_SET"`
+	GroupValue string  `nba:"GROUP_VALUE"`
+	FGM        float64 `nba:"FGM"`
+	FGA        float64 `nba:"FGA"`
+	FGPct      float64 `nba:"FG_PCT"`
+	FG3M       float64 `nba:"FG3M"`
+	FG3A       float64 `nba:"FG3A"`
+	FG3Pct     float64 `nba:"FG3_PCT"`
+	EFGPct     float64 `nba:"EFG_PCT"`
+	BLKA       float64 `nba:"BLKA"`
+	PctAST2pm  float64 `nba:"PCT_AST_2PM"`
+	PctUast2pm float64 `nba:"PCT_UAST_2PM"`
+	PctAST3pm  float64 `nba:"PCT_AST_3PM"`
+	PctUast3pm float64 `nba:"PCT_UAST_3PM"`
+	PctASTFGM  float64 `nba:"PCT_AST_FGM"`
+	PctUastFGM float64 `nba:"PCT_UAST_FGM"`
+	Cfid       int     `nba:"CFID"`
+	Cfparams   string  `nba:"CFPARAMS"`
+}
+
+// PlayerDashboardByYearOverYearPlayerDashboardRow is a row of the
+// playerdashboardbyyearoveryear OverallPlayerDashboard and
+// playerdashboardbyyearoveryear ByYearPlayerDashboard result sets.
+type PlayerDashboardByYearOverYearPlayerDashboardRow struct {
+	GroupSet           string  `nba:"GROUP_SET"`
+	GroupValue         string  `nba:"GROUP_VALUE"`
+	TeamID             int     `nba:"TEAM_ID"`
+	TeamAbbreviation   string  `nba:"TEAM_ABBREVIATION"`
+	MaxGameDate        string  `nba:"MAX_GAME_DATE"`
+	GP                 int     `nba:"GP"`
+	W                  int     `nba:"W"`
+	L                  int     `nba:"L"`
+	WPct               float64 `nba:"W_PCT"`
+	Min                float64 `nba:"MIN"`
+	FGM                float64 `nba:"FGM"`
+	FGA                float64 `nba:"FGA"`
+	FGPct              float64 `nba:"FG_PCT"`
+	FG3M               float64 `nba:"FG3M"`
+	FG3A               float64 `nba:"FG3A"`
+	FG3Pct             float64 `nba:"FG3_PCT"`
+	FTM                float64 `nba:"FTM"`
+	FTA                float64 `nba:"FTA"`
+	FTPct              float64 `nba:"FT_PCT"`
+	OREB               float64 `nba:"OREB"`
+	DREB               float64 `nba:"DREB"`
+	REB                float64 `nba:"REB"`
+	AST                float64 `nba:"AST"`
+	TOV                float64 `nba:"TOV"`
+	STL                float64 `nba:"STL"`
+	BLK                float64 `nba:"BLK"`
+	BLKA               float64 `nba:"BLKA"`
+	PF                 float64 `nba:"PF"`
+	PFD                float64 `nba:"PFD"`
+	PTS                float64 `nba:"PTS"`
+	PlusMinus          float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS      float64 `nba:"NBA_FANTASY_PTS"`
+	DD2                float64 `nba:"DD2"`
+	TD3                float64 `nba:"TD3"`
+	WnbaFantasyPTS     float64 `nba:"WNBA_FANTASY_PTS"`
+	GPRank             int     `nba:"GP_RANK"`
+	WRank              int     `nba:"W_RANK"`
+	LRank              int     `nba:"L_RANK"`
+	WPctRank           int     `nba:"W_PCT_RANK"`
+	MinRank            int     `nba:"MIN_RANK"`
+	FGMRank            int     `nba:"FGM_RANK"`
+	FGARank            int     `nba:"FGA_RANK"`
+	FGPctRank          int     `nba:"FG_PCT_RANK"`
+	FG3MRank           int     `nba:"FG3M_RANK"`
+	FG3ARank           int     `nba:"FG3A_RANK"`
+	FG3PctRank         int     `nba:"FG3_PCT_RANK"`
+	FTMRank            int     `nba:"FTM_RANK"`
+	FTARank            int     `nba:"FTA_RANK"`
+	FTPctRank          int     `nba:"FT_PCT_RANK"`
+	OREBRank           int     `nba:"OREB_RANK"`
+	DREBRank           int     `nba:"DREB_RANK"`
+	REBRank            int     `nba:"REB_RANK"`
+	ASTRank            int     `nba:"AST_RANK"`
+	TOVRank            int     `nba:"TOV_RANK"`
+	STLRank            int     `nba:"STL_RANK"`
+	BLKRank            int     `nba:"BLK_RANK"`
+	BLKARank           int     `nba:"BLKA_RANK"`
+	PFRank             int     `nba:"PF_RANK"`
+	PFDRank            int     `nba:"PFD_RANK"`
+	PTSRank            int     `nba:"PTS_RANK"`
+	PlusMinusRank      int     `nba:"PLUS_MINUS_RANK"`
+	NBAFantasyPTSRank  int     `nba:"NBA_FANTASY_PTS_RANK"`
+	DD2Rank            int     `nba:"DD2_RANK"`
+	TD3Rank            int     `nba:"TD3_RANK"`
+	WnbaFantasyPTSRank int     `nba:"WNBA_FANTASY_PTS_RANK"`
+	TeamCount          float64 `nba:"TEAM_COUNT"`
+}
+
+// PlayerDashboardRow is a row of the playerdashboardbyclutch
+// OverallPlayerDashboard, playerdashboardbyclutch
+// Last5Min5PointPlayerDashboard, playerdashboardbyclutch
+// Last3Min5PointPlayerDashboard, playerdashboardbyclutch
+// Last1Min5PointPlayerDashboard, playerdashboardbyclutch
+// Last30Sec3PointPlayerDashboard, playerdashboardbygamesplits
+// OverallPlayerDashboard, playerdashboardbygamesplits ByHalfPlayerDashboard,
+// playerdashboardbygamesplits ByPeriodPlayerDashboard,
+// playerdashboardbygamesplits ByScoreMarginPlayerDashboard,
+// playerdashboardbygamesplits ByActualMarginPlayerDashboard,
+// playerdashboardbygeneralsplits DaysRestPlayerDashboard,
+// playerdashboardbygeneralsplits LocationPlayerDashboard,
+// playerdashboardbygeneralsplits MonthPlayerDashboard,
+// playerdashboardbygeneralsplits OverallPlayerDashboard,
+// playerdashboardbygeneralsplits PrePostAllStarPlayerDashboard,
+// playerdashboardbygeneralsplits StartingPosition,
+// playerdashboardbygeneralsplits WinsLossesPlayerDashboard,
+// playerdashboardbylastngames OverallPlayerDashboard,
+// playerdashboardbylastngames Last5PlayerDashboard,
+// playerdashboardbylastngames Last10PlayerDashboard,
+// playerdashboardbylastngames Last15PlayerDashboard,
+// playerdashboardbylastngames Last20PlayerDashboard,
+// playerdashboardbylastngames GameNumberPlayerDashboard,
+// playerdashboardbyteamperformance OverallPlayerDashboard,
+// playerdashboardbyteamperformance ScoreDifferentialPlayerDashboard,
+// playerdashboardbyteamperformance PointsScoredPlayerDashboard and
+// playerdashboardbyteamperformance PontsAgainstPlayerDashboard result sets.
+type PlayerDashboardRow struct {
+	GroupSet           string  `nba:"GROUP_SET"`
+	GroupValue         string  `nba:"GROUP_VALUE"`
+	GP                 int     `nba:"GP"`
+	W                  int     `nba:"W"`
+	L                  int     `nba:"L"`
+	WPct               float64 `nba:"W_PCT"`
+	Min                float64 `nba:"MIN"`
+	FGM                float64 `nba:"FGM"`
+	FGA                float64 `nba:"FGA"`
+	FGPct              float64 `nba:"FG_PCT"`
+	FG3M               float64 `nba:"FG3M"`
+	FG3A               float64 `nba:"FG3A"`
+	FG3Pct             float64 `nba:"FG3_PCT"`
+	FTM                float64 `nba:"FTM"`
+	FTA                float64 `nba:"FTA"`
+	FTPct              float64 `nba:"FT_PCT"`
+	OREB               float64 `nba:"OREB"`
+	DREB               float64 `nba:"DREB"`
+	REB                float64 `nba:"REB"`
+	AST                float64 `nba:"AST"`
+	TOV                float64 `nba:"TOV"`
+	STL                float64 `nba:"STL"`
+	BLK                float64 `nba:"BLK"`
+	BLKA               float64 `nba:"BLKA"`
+	PF                 float64 `nba:"PF"`
+	PFD                float64 `nba:"PFD"`
+	PTS                float64 `nba:"PTS"`
+	PlusMinus          float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS      float64 `nba:"NBA_FANTASY_PTS"`
+	DD2                float64 `nba:"DD2"`
+	TD3                float64 `nba:"TD3"`
+	WnbaFantasyPTS     float64 `nba:"WNBA_FANTASY_PTS"`
+	GPRank             int     `nba:"GP_RANK"`
+	WRank              int     `nba:"W_RANK"`
+	LRank              int     `nba:"L_RANK"`
+	WPctRank           int     `nba:"W_PCT_RANK"`
+	MinRank            int     `nba:"MIN_RANK"`
+	FGMRank            int     `nba:"FGM_RANK"`
+	FGARank            int     `nba:"FGA_RANK"`
+	FGPctRank          int     `nba:"FG_PCT_RANK"`
+	FG3MRank           int     `nba:"FG3M_RANK"`
+	FG3ARank           int     `nba:"FG3A_RANK"`
+	FG3PctRank         int     `nba:"FG3_PCT_RANK"`
+	FTMRank            int     `nba:"FTM_RANK"`
+	FTARank            int     `nba:"FTA_RANK"`
+	FTPctRank          int     `nba:"FT_PCT_RANK"`
+	OREBRank           int     `nba:"OREB_RANK"`
+	DREBRank           int     `nba:"DREB_RANK"`
+	REBRank            int     `nba:"REB_RANK"`
+	ASTRank            int     `nba:"AST_RANK"`
+	TOVRank            int     `nba:"TOV_RANK"`
+	STLRank            int     `nba:"STL_RANK"`
+	BLKRank            int     `nba:"BLK_RANK"`
+	BLKARank           int     `nba:"BLKA_RANK"`
+	PFRank             int     `nba:"PF_RANK"`
+	PFDRank            int     `nba:"PFD_RANK"`
+	PTSRank            int     `nba:"PTS_RANK"`
+	PlusMinusRank      int     `nba:"PLUS_MINUS_RANK"`
+	NBAFantasyPTSRank  int     `nba:"NBA_FANTASY_PTS_RANK"`
+	DD2Rank            int     `nba:"DD2_RANK"`
+	TD3Rank            int     `nba:"TD3_RANK"`
+	WnbaFantasyPTSRank int     `nba:"WNBA_FANTASY_PTS_RANK"`
+	TeamCount          float64 `nba:"TEAM_COUNT"`
+}
+
+// PlayerEstimatedMetricsRow is a row of the playerestimatedmetrics
+// PlayerEstimatedMetrics result set.
+type PlayerEstimatedMetricsRow struct {
+	PlayerID       int     `nba:"PLAYER_ID"`
+	PlayerName     string  `nba:"PLAYER_NAME"`
+	GP             int     `nba:"GP"`
+	W              int     `nba:"W"`
+	L              int     `nba:"L"`
+	WPct           float64 `nba:"W_PCT"`
+	Min            float64 `nba:"MIN"`
+	EOffRating     float64 `nba:"E_OFF_RATING"`
+	EDefRating     float64 `nba:"E_DEF_RATING"`
+	ENetRating     float64 `nba:"E_NET_RATING"`
+	EASTRatio      float64 `nba:"E_AST_RATIO"`
+	EOREBPct       float64 `nba:"E_OREB_PCT"`
+	EDREBPct       float64 `nba:"E_DREB_PCT"`
+	EREBPct        float64 `nba:"E_REB_PCT"`
+	ETOVPct        float64 `nba:"E_TOV_PCT"`
+	EUsgPct        float64 `nba:"E_USG_PCT"`
+	EPace          float64 `nba:"E_PACE"`
+	GPRank         int     `nba:"GP_RANK"`
+	WRank          int     `nba:"W_RANK"`
+	LRank          int     `nba:"L_RANK"`
+	WPctRank       int     `nba:"W_PCT_RANK"`
+	MinRank        int     `nba:"MIN_RANK"`
+	EOffRatingRank int     `nba:"E_OFF_RATING_RANK"`
+	EDefRatingRank int     `nba:"E_DEF_RATING_RANK"`
+	ENetRatingRank int     `nba:"E_NET_RATING_RANK"`
+	EASTRatioRank  int     `nba:"E_AST_RATIO_RANK"`
+	EOREBPctRank   int     `nba:"E_OREB_PCT_RANK"`
+	EDREBPctRank   int     `nba:"E_DREB_PCT_RANK"`
+	EREBPctRank    int     `nba:"E_REB_PCT_RANK"`
+	ETOVPctRank    int     `nba:"E_TOV_PCT_RANK"`
+	EUsgPctRank    int     `nba:"E_USG_PCT_RANK"`
+	EPaceRank      int     `nba:"E_PACE_RANK"`
+}
+
+// PlayerFantasyProfileBarGraphAvgRow is a row of the
+// playerfantasyprofilebargraph LastFiveGamesAvg and
+// playerfantasyprofilebargraph SeasonAvg result sets.
+type PlayerFantasyProfileBarGraphAvgRow struct {
+	PlayerID         int     `nba:"PLAYER_ID"`
+	PlayerName       string  `nba:"PLAYER_NAME"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	FanDuelPTS       float64 `nba:"FAN_DUEL_PTS"`
+	NBAFantasyPTS    float64 `nba:"NBA_FANTASY_PTS"`
+	PTS              float64 `nba:"PTS"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	FG3M             float64 `nba:"FG3M"`
+	FTPct            float64 `nba:"FT_PCT"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	TOV              float64 `nba:"TOV"`
+	FGPct            float64 `nba:"FG_PCT"`
+}
+
+// PlayerFantasyProfileRow is a row of the playerfantasyprofile Overall,
+// playerfantasyprofile LastNGames, playerfantasyprofile Location and
+// playerfantasyprofile Opponent result sets.
+type PlayerFantasyProfileRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	GroupValue    string  `nba:"GROUP_VALUE"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	DD2           float64 `nba:"DD2"`
+	TD3           float64 `nba:"TD3"`
+	FanDuelPTS    float64 `nba:"FAN_DUEL_PTS"`
+	NBAFantasyPTS float64 `nba:"NBA_FANTASY_PTS"`
+}
+
+// PlayerGameLogRow is a row of the playergamelog PlayerGameLog result set.
+type PlayerGameLogRow struct {
+	SeasonID       string    `nba:"SEASON_ID"`
+	PlayerID       int       `nba:"Player_ID"`
+	GameID         string    `nba:"Game_ID"`
+	GameDate       time.Time `nba:"GAME_DATE"`
+	Matchup        string    `nba:"MATCHUP"`
+	WL             string    `nba:"WL"`
+	Min            float64   `nba:"MIN"`
+	FGM            float64   `nba:"FGM"`
+	FGA            float64   `nba:"FGA"`
+	FGPct          float64   `nba:"FG_PCT"`
+	FG3M           float64   `nba:"FG3M"`
+	FG3A           float64   `nba:"FG3A"`
+	FG3Pct         float64   `nba:"FG3_PCT"`
+	FTM            float64   `nba:"FTM"`
+	FTA            float64   `nba:"FTA"`
+	FTPct          float64   `nba:"FT_PCT"`
+	OREB           float64   `nba:"OREB"`
+	DREB           float64   `nba:"DREB"`
+	REB            float64   `nba:"REB"`
+	AST            float64   `nba:"AST"`
+	STL            float64   `nba:"STL"`
+	BLK            float64   `nba:"BLK"`
+	TOV            float64   `nba:"TOV"`
+	PF             float64   `nba:"PF"`
+	PTS            float64   `nba:"PTS"`
+	PlusMinus      float64   `nba:"PLUS_MINUS"`
+	VideoAvailable int       `nba:"VIDEO_AVAILABLE"`
+}
+
+// PlayerGameLogsRow is a row of the playergamelogs PlayerGameLogs result set.
+type PlayerGameLogsRow struct {
+	SeasonYear         string    `nba:"SEASON_YEAR"`
+	PlayerID           int       `nba:"PLAYER_ID"`
+	PlayerName         string    `nba:"PLAYER_NAME"`
+	Nickname           string    `nba:"NICKNAME"`
+	TeamID             int       `nba:"TEAM_ID"`
+	TeamAbbreviation   string    `nba:"TEAM_ABBREVIATION"`
+	TeamName           string    `nba:"TEAM_NAME"`
+	GameID             string    `nba:"GAME_ID"`
+	GameDate           time.Time `nba:"GAME_DATE"`
+	Matchup            string    `nba:"MATCHUP"`
+	WL                 string    `nba:"WL"`
+	Min                float64   `nba:"MIN"`
+	FGM                float64   `nba:"FGM"`
+	FGA                float64   `nba:"FGA"`
+	FGPct              float64   `nba:"FG_PCT"`
+	FG3M               float64   `nba:"FG3M"`
+	FG3A               float64   `nba:"FG3A"`
+	FG3Pct             float64   `nba:"FG3_PCT"`
+	FTM                float64   `nba:"FTM"`
+	FTA                float64   `nba:"FTA"`
+	FTPct              float64   `nba:"FT_PCT"`
+	OREB               float64   `nba:"OREB"`
+	DREB               float64   `nba:"DREB"`
+	REB                float64   `nba:"REB"`
+	AST                float64   `nba:"AST"`
+	TOV                float64   `nba:"TOV"`
+	STL                float64   `nba:"STL"`
+	BLK                float64   `nba:"BLK"`
+	BLKA               float64   `nba:"BLKA"`
+	PF                 float64   `nba:"PF"`
+	PFD                float64   `nba:"PFD"`
+	PTS                float64   `nba:"PTS"`
+	PlusMinus          float64   `nba:"PLUS_MINUS"`
+	NBAFantasyPTS      float64   `nba:"NBA_FANTASY_PTS"`
+	DD2                float64   `nba:"DD2"`
+	TD3                float64   `nba:"TD3"`
+	WnbaFantasyPTS     float64   `nba:"WNBA_FANTASY_PTS"`
+	GPRank             int       `nba:"GP_RANK"`
+	WRank              int       `nba:"W_RANK"`
+	LRank              int       `nba:"L_RANK"`
+	WPctRank           int       `nba:"W_PCT_RANK"`
+	MinRank            int       `nba:"MIN_RANK"`
+	FGMRank            int       `nba:"FGM_RANK"`
+	FGARank            int       `nba:"FGA_RANK"`
+	FGPctRank          int       `nba:"FG_PCT_RANK"`
+	FG3MRank           int       `nba:"FG3M_RANK"`
+	FG3ARank           int       `nba:"FG3A_RANK"`
+	FG3PctRank         int       `nba:"FG3_PCT_RANK"`
+	FTMRank            int       `nba:"FTM_RANK"`
+	FTARank            int       `nba:"FTA_RANK"`
+	FTPctRank          int       `nba:"FT_PCT_RANK"`
+	OREBRank           int       `nba:"OREB_RANK"`
+	DREBRank           int       `nba:"DREB_RANK"`
+	REBRank            int       `nba:"REB_RANK"`
+	ASTRank            int       `nba:"AST_RANK"`
+	TOVRank            int       `nba:"TOV_RANK"`
+	STLRank            int       `nba:"STL_RANK"`
+	BLKRank            int       `nba:"BLK_RANK"`
+	BLKARank           int       `nba:"BLKA_RANK"`
+	PFRank             int       `nba:"PF_RANK"`
+	PFDRank            int       `nba:"PFD_RANK"`
+	PTSRank            int       `nba:"PTS_RANK"`
+	PlusMinusRank      int       `nba:"PLUS_MINUS_RANK"`
+	NBAFantasyPTSRank  int       `nba:"NBA_FANTASY_PTS_RANK"`
+	DD2Rank            int       `nba:"DD2_RANK"`
+	TD3Rank            int       `nba:"TD3_RANK"`
+	WnbaFantasyPTSRank int       `nba:"WNBA_FANTASY_PTS_RANK"`
+	AvailableFlag      float64   `nba:"AVAILABLE_FLAG"`
+}
+
+// PlayerGameStreakFinderResultsRow is a row of the playergamestreakfinder
+// PlayerGameStreakFinderResults result set.
+type PlayerGameStreakFinderResultsRow struct {
+	PlayerNameLastFirst string    `nba:"PLAYER_NAME_LAST_FIRST"`
+	PlayerID            int       `nba:"PLAYER_ID"`
+	Gamestreak          float64   `nba:"GAMESTREAK"`
+	Startdate           time.Time `nba:"STARTDATE"`
+	Enddate             time.Time `nba:"ENDDATE"`
+	Activestreak        float64   `nba:"ACTIVESTREAK"`
+	Numseasons          int       `nba:"NUMSEASONS"`
+	Lastseason          string    `nba:"LASTSEASON"`
+	Firstseason         string    `nba:"FIRSTSEASON"`
+}
+
+// PlayerIndexRow is a row of the playerindex PlayerIndex result set.
+type PlayerIndexRow struct {
+	PersonID         int     `nba:"PERSON_ID"`
+	PlayerLastName   string  `nba:"PLAYER_LAST_NAME"`
+	PlayerFirstName  string  `nba:"PLAYER_FIRST_NAME"`
+	PlayerSlug       string  `nba:"PLAYER_SLUG"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamSlug         string  `nba:"TEAM_SLUG"`
+	IsDefunct        float64 `nba:"IS_DEFUNCT"`
+	TeamCity         string  `nba:"TEAM_CITY"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	JerseyNumber     string  `nba:"JERSEY_NUMBER"`
+	Position         string  `nba:"POSITION"`
+	Height           string  `nba:"HEIGHT"`
+	Weight           string  `nba:"WEIGHT"`
+	College          string  `nba:"COLLEGE"`
+	Country          string  `nba:"COUNTRY"`
+	DraftYear        int     `nba:"DRAFT_YEAR"`
+	DraftRound       float64 `nba:"DRAFT_ROUND"`
+	DraftNumber      float64 `nba:"DRAFT_NUMBER"`
+	RosterStatus     int     `nba:"ROSTER_STATUS"`
+	PTS              float64 `nba:"PTS"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	StatsTimeframe   string  `nba:"STATS_TIMEFRAME"`
+	FromYear         string  `nba:"FROM_YEAR"`
+	ToYear           string  `nba:"TO_YEAR"`
+}
+
+// PlayerProfileV2HighsRow is a row of the playerprofilev2 CareerHighs and
+// playerprofilev2 SeasonHighs result sets.
+type PlayerProfileV2HighsRow struct {
+	PlayerID           int       `nba:"PLAYER_ID"`
+	GameID             string    `nba:"GAME_ID"`
+	GameDate           time.Time `nba:"GAME_DATE"`
+	VsTeamID           int       `nba:"VS_TEAM_ID"`
+	VsTeamCity         string    `nba:"VS_TEAM_CITY"`
+	VsTeamName         string    `nba:"VS_TEAM_NAME"`
+	VsTeamAbbreviation string    `nba:"VS_TEAM_ABBREVIATION"`
+	Stat               string    `nba:"STAT"`
+	StatsValue         float64   `nba:"STATS_VALUE"`
+	StatOrder          float64   `nba:"STAT_ORDER"`
+	DateEST            time.Time `nba:"DATE_EST"`
+}
+
+// PlayerProfileV2NextGameRow is a row of the playerprofilev2 NextGame result
+// set.
+type PlayerProfileV2NextGameRow struct {
+	GameID                 string    `nba:"GAME_ID"`
+	GameDate               time.Time `nba:"GAME_DATE"`
+	GameTime               string    `nba:"GAME_TIME"`
+	Location               string    `nba:"LOCATION"`
+	PlayerTeamID           int       `nba:"PLAYER_TEAM_ID"`
+	PlayerTeamCity         string    `nba:"PLAYER_TEAM_CITY"`
+	PlayerTeamNickname     string    `nba:"PLAYER_TEAM_NICKNAME"`
+	PlayerTeamAbbreviation string    `nba:"PLAYER_TEAM_ABBREVIATION"`
+	VsTeamID               int       `nba:"VS_TEAM_ID"`
+	VsTeamCity             string    `nba:"VS_TEAM_CITY"`
+	VsTeamNickname         string    `nba:"VS_TEAM_NICKNAME"`
+	VsTeamAbbreviation     string    `nba:"VS_TEAM_ABBREVIATION"`
+}
+
+// PlayerSeasonRankingsRow is a row of the playercareerstats
+// SeasonRankingsPostSeason, playercareerstats SeasonRankingsRegularSeason,
+// playerprofilev2 SeasonRankingsPostSeason and playerprofilev2
+// SeasonRankingsRegularSeason result sets.
+type PlayerSeasonRankingsRow struct {
+	PlayerID         int     `nba:"PLAYER_ID"`
+	SeasonID         string  `nba:"SEASON_ID"`
+	LeagueID         string  `nba:"LEAGUE_ID"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	PlayerAge        float64 `nba:"PLAYER_AGE"`
+	GP               int     `nba:"GP"`
+	GS               int     `nba:"GS"`
+	RankMin          float64 `nba:"RANK_MIN"`
+	RankFGM          float64 `nba:"RANK_FGM"`
+	RankFGA          float64 `nba:"RANK_FGA"`
+	RankFGPct        float64 `nba:"RANK_FG_PCT"`
+	RankFG3M         float64 `nba:"RANK_FG3M"`
+	RankFG3A         float64 `nba:"RANK_FG3A"`
+	RankFG3Pct       float64 `nba:"RANK_FG3_PCT"`
+	RankFTM          float64 `nba:"RANK_FTM"`
+	RankFTA          float64 `nba:"RANK_FTA"`
+	RankFTPct        float64 `nba:"RANK_FT_PCT"`
+	RankOREB         float64 `nba:"RANK_OREB"`
+	RankDREB         float64 `nba:"RANK_DREB"`
+	RankREB          float64 `nba:"RANK_REB"`
+	RankAST          float64 `nba:"RANK_AST"`
+	RankSTL          float64 `nba:"RANK_STL"`
+	RankBLK          float64 `nba:"RANK_BLK"`
+	RankTOV          float64 `nba:"RANK_TOV"`
+	RankPTS          float64 `nba:"RANK_PTS"`
+	RankEff          float64 `nba:"RANK_EFF"`
+}
+
+// PlayerSeasonTotalsRow is a row of the playercareerstats
+// SeasonTotalsAllStarSeason, playercareerstats SeasonTotalsPostSeason,
+// playercareerstats SeasonTotalsRegularSeason, playerprofilev2
+// SeasonTotalsAllStarSeason, playerprofilev2 SeasonTotalsPostSeason,
+// playerprofilev2 SeasonTotalsPreseason and playerprofilev2
+// SeasonTotalsRegularSeason result sets.
+type PlayerSeasonTotalsRow struct {
+	PlayerID         int     `nba:"PLAYER_ID"`
+	SeasonID         string  `nba:"SEASON_ID"`
+	LeagueID         string  `nba:"LEAGUE_ID"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	PlayerAge        float64 `nba:"PLAYER_AGE"`
+	GP               int     `nba:"GP"`
+	GS               int     `nba:"GS"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	TOV              float64 `nba:"TOV"`
+	PF               float64 `nba:"PF"`
+	PTS              float64 `nba:"PTS"`
+}
+
+// PlayerVsPlayerOnOffCourtRow is a row of the playervsplayer OnOffCourt
+// result set.
+type PlayerVsPlayerOnOffCourtRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	PlayerID      int     `nba:"PLAYER_ID"`
+	PlayerName    string  `nba:"PLAYER_NAME"`
+	VsPlayerID    int     `nba:"VS_PLAYER_ID"`
+	VsPlayerName  string  `nba:"VS_PLAYER_NAME"`
+	CourtStatus   string  `nba:"COURT_STATUS"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS float64 `nba:"NBA_FANTASY_PTS"`
+	Cfid          int     `nba:"CFID"`
+	Cfparams      string  `nba:"CFPARAMS"`
+}
+
+// PlayerVsPlayerOverallRow is a row of the playervsplayer Overall result set.
+type PlayerVsPlayerOverallRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	GroupValue    string  `nba:"GROUP_VALUE"`
+	PlayerID      int     `nba:"PLAYER_ID"`
+	PlayerName    string  `nba:"PLAYER_NAME"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS float64 `nba:"NBA_FANTASY_PTS"`
+	Cfid          int     `nba:"CFID"`
+	Cfparams      string  `nba:"CFPARAMS"`
+}
+
+// PlayerVsPlayerPlayerInfoRow is a row of the playervsplayer PlayerInfo and
+// playervsplayer VsPlayerInfo result sets.
+type PlayerVsPlayerPlayerInfoRow struct {
+	PersonID              int       `nba:"PERSON_ID"`
+	FirstName             string    `nba:"FIRST_NAME"`
+	LastName              string    `nba:"LAST_NAME"`
+	DisplayFirstLast      string    `nba:"DISPLAY_FIRST_LAST"`
+	DisplayLastCommaFirst string    `nba:"DISPLAY_LAST_COMMA_FIRST"`
+	DisplayFiLast         string    `nba:"DISPLAY_FI_LAST"`
+	Birthdate             time.Time `nba:"BIRTHDATE"`
+	School                string    `nba:"SCHOOL"`
+	Country               string    `nba:"COUNTRY"`
+	LastAffiliation       string    `nba:"LAST_AFFILIATION"`
+}
+
+// PlayerVsPlayerShotOverallRow is a row of the playervsplayer ShotAreaOverall
+// and playervsplayer ShotDistanceOverall result sets.
+type PlayerVsPlayerShotOverallRow struct {
+	GroupSet   string  `nba:"GROUP_SET"`
+	GroupValue string  `nba:"GROUP_VALUE"`
+	PlayerID   int     `nba:"PLAYER_ID"`
+	PlayerName string  `nba:"PLAYER_NAME"`
+	FGM        float64 `nba:"FGM"`
+	FGA        float64 `nba:"FGA"`
+	FGPct      float64 `nba:"FG_PCT"`
+	Cfid       int     `nba:"CFID"`
+	Cfparams   string  `nba:"CFPARAMS"`
+}
+
+// PlayerVsPlayerShotRow is a row of the playervsplayer ShotAreaOffCourt,
+// playervsplayer ShotAreaOnCourt, playervsplayer ShotDistanceOffCourt and
+// playervsplayer ShotDistanceOnCourt result sets.
+type PlayerVsPlayerShotRow struct {
+	GroupSet     string  `nba:"GROUP_SET"`
+	PlayerID     int     `nba:"PLAYER_ID"`
+	PlayerName   string  `nba:"PLAYER_NAME"`
+	VsPlayerID   int     `nba:"VS_PLAYER_ID"`
+	VsPlayerName string  `nba:"VS_PLAYER_NAME"`
+	CourtStatus  string  `nba:"COURT_STATUS"`
+	GroupValue   string  `nba:"GROUP_VALUE"`
+	FGM          float64 `nba:"FGM"`
+	FGA          float64 `nba:"FGA"`
+	FGPct        float64 `nba:"FG_PCT"`
+	Cfid         int     `nba:"CFID"`
+	Cfparams     string  `nba:"CFPARAMS"`
+}
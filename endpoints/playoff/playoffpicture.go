@@ -24,25 +24,13 @@ func (c *Client) GetPlayoffPicture(ctx context.Context, params PlayoffPicturePar
 		reqParams["SeasonID"] = params.SeasonId
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "playoffpicture", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "playoffpicture", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch playoffpicture",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch playoffpicture: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from playoffpicture endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal playoffpicture response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched playoffpicture",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
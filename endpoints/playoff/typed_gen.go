@@ -0,0 +1,139 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package playoff
+
+import (
+	"context"
+	"fmt"
+)
+
+// CommonPlayoffSeriesResult holds the decoded result sets of the
+// commonplayoffseries endpoint.
+type CommonPlayoffSeriesResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayoffSeries []CommonPlayoffSeriesPlayoffSeriesRow
+}
+
+// GetCommonPlayoffSeriesTyped is like GetCommonPlayoffSeries but decodes the
+// result sets into a CommonPlayoffSeriesResult.
+func (c *Client) GetCommonPlayoffSeriesTyped(ctx context.Context, params CommonPlayoffSeriesParams) (*CommonPlayoffSeriesResult, error) {
+	resp, err := c.GetCommonPlayoffSeries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CommonPlayoffSeriesResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayoffSeries": &result.PlayoffSeries,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode commonplayoffseries: %w", err)
+	}
+	return result, nil
+}
+
+// PlayoffPictureResult holds the decoded result sets of the playoffpicture
+// endpoint.
+type PlayoffPictureResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	EastConfPlayoffPicture []PlayoffPictureConfPlayoffPictureRow
+	EastConfRemainingGames []PlayoffPictureConfRemainingGamesRow
+	EastConfStandings      []PlayoffPictureConfStandingsRow
+	WestConfPlayoffPicture []PlayoffPictureConfPlayoffPictureRow
+	WestConfRemainingGames []PlayoffPictureConfRemainingGamesRow
+	WestConfStandings      []PlayoffPictureConfStandingsRow
+}
+
+// GetPlayoffPictureTyped is like GetPlayoffPicture but decodes the result
+// sets into a PlayoffPictureResult.
+func (c *Client) GetPlayoffPictureTyped(ctx context.Context, params PlayoffPictureParams) (*PlayoffPictureResult, error) {
+	resp, err := c.GetPlayoffPicture(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlayoffPictureResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"EastConfPlayoffPicture": &result.EastConfPlayoffPicture,
+		"EastConfRemainingGames": &result.EastConfRemainingGames,
+		"EastConfStandings":      &result.EastConfStandings,
+		"WestConfPlayoffPicture": &result.WestConfPlayoffPicture,
+		"WestConfRemainingGames": &result.WestConfRemainingGames,
+		"WestConfStandings":      &result.WestConfStandings,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode playoffpicture: %w", err)
+	}
+	return result, nil
+}
+
+// CommonPlayoffSeriesPlayoffSeriesRow is a row of the commonplayoffseries
+// PlayoffSeries result set.
+type CommonPlayoffSeriesPlayoffSeriesRow struct {
+	GameID        string `nba:"GAME_ID"`
+	HomeTeamID    int    `nba:"HOME_TEAM_ID"`
+	VisitorTeamID int    `nba:"VISITOR_TEAM_ID"`
+	SeriesID      string `nba:"SERIES_ID"`
+	GameNum       int    `nba:"GAME_NUM"`
+}
+
+// PlayoffPictureConfPlayoffPictureRow is a row of the playoffpicture
+// EastConfPlayoffPicture and playoffpicture WestConfPlayoffPicture result
+// sets.
+type PlayoffPictureConfPlayoffPictureRow struct {
+	Conference                   string  `nba:"CONFERENCE"`
+	HighSeedRank                 int     `nba:"HIGH_SEED_RANK"`
+	HighSeedTeam                 string  `nba:"HIGH_SEED_TEAM"`
+	HighSeedTeamID               int     `nba:"HIGH_SEED_TEAM_ID"`
+	LowSeedRank                  int     `nba:"LOW_SEED_RANK"`
+	LowSeedTeam                  string  `nba:"LOW_SEED_TEAM"`
+	LowSeedTeamID                int     `nba:"LOW_SEED_TEAM_ID"`
+	HighSeedSeriesW              float64 `nba:"HIGH_SEED_SERIES_W"`
+	HighSeedSeriesL              float64 `nba:"HIGH_SEED_SERIES_L"`
+	HighSeedSeriesRemainingG     float64 `nba:"HIGH_SEED_SERIES_REMAINING_G"`
+	HighSeedSeriesRemainingHomeG float64 `nba:"HIGH_SEED_SERIES_REMAINING_HOME_G"`
+	HighSeedSeriesRemainingAwayG float64 `nba:"HIGH_SEED_SERIES_REMAINING_AWAY_G"`
+}
+
+// PlayoffPictureConfRemainingGamesRow is a row of the playoffpicture
+// EastConfRemainingGames and playoffpicture WestConfRemainingGames result
+// sets.
+type PlayoffPictureConfRemainingGamesRow struct {
+	Team           string  `nba:"TEAM"`
+	TeamID         int     `nba:"TEAM_ID"`
+	RemainingG     float64 `nba:"REMAINING_G"`
+	RemainingHomeG float64 `nba:"REMAINING_HOME_G"`
+	RemainingAwayG float64 `nba:"REMAINING_AWAY_G"`
+}
+
+// PlayoffPictureConfStandingsRow is a row of the playoffpicture
+// EastConfStandings and playoffpicture WestConfStandings result sets.
+type PlayoffPictureConfStandingsRow struct {
+	Conference         string  `nba:"CONFERENCE"`
+	Rank               int     `nba:"RANK"`
+	Team               string  `nba:"TEAM"`
+	TeamSlug           string  `nba:"TEAM_SLUG"`
+	TeamID             int     `nba:"TEAM_ID"`
+	Wins               float64 `nba:"WINS"`
+	Losses             float64 `nba:"LOSSES"`
+	Pct                float64 `nba:"PCT"`
+	Div                string  `nba:"DIV"`
+	Conf               string  `nba:"CONF"`
+	Home               string  `nba:"HOME"`
+	Away               string  `nba:"AWAY"`
+	Gb                 float64 `nba:"GB"`
+	GrOver500          float64 `nba:"GR_OVER_500"`
+	GrOver500Home      float64 `nba:"GR_OVER_500_HOME"`
+	GrOver500Away      float64 `nba:"GR_OVER_500_AWAY"`
+	GrUnder500         float64 `nba:"GR_UNDER_500"`
+	GrUnder500Home     float64 `nba:"GR_UNDER_500_HOME"`
+	GrUnder500Away     float64 `nba:"GR_UNDER_500_AWAY"`
+	RankingCriteria    float64 `nba:"RANKING_CRITERIA"`
+	ClinchedPlayoffs   float64 `nba:"CLINCHED_PLAYOFFS"`
+	ClinchedConference float64 `nba:"CLINCHED_CONFERENCE"`
+	ClinchedDivision   float64 `nba:"CLINCHED_DIVISION"`
+	EliminatedPlayoffs float64 `nba:"ELIMINATED_PLAYOFFS"`
+	SosaRemaining      float64 `nba:"SOSA_REMAINING"`
+}
@@ -2,18 +2,357 @@
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/utkonoser/nba-api-go/endpoints/stats"
 )
 
+// Meta holds the metadata of a schedule response.
+type Meta struct {
+	Version int    `json:"version"`
+	Request string `json:"request"`
+	Time    string `json:"time"`
+}
+
+// Broadcaster is a TV, radio or streaming broadcaster of a game. Scope is
+// "natl" or "local" and Media is "tv", "radio" or "ott".
+type Broadcaster struct {
+	BroadcasterScope        string `json:"broadcasterScope"`
+	BroadcasterMedia        string `json:"broadcasterMedia"`
+	BroadcasterID           int    `json:"broadcasterId"`
+	BroadcasterDisplay      string `json:"broadcasterDisplay"`
+	BroadcasterAbbreviation string `json:"broadcasterAbbreviation"`
+	BroadcasterDescription  string `json:"broadcasterDescription"`
+	TapeDelayComments       string `json:"tapeDelayComments"`
+	BroadcasterVideoLink    string `json:"broadcasterVideoLink"`
+	BroadcasterTeamID       int    `json:"broadcasterTeamId"`
+}
+
+// Broadcasters lists the broadcasters of a game by reach and medium.
+type Broadcasters struct {
+	NationalBroadcasters      []Broadcaster `json:"nationalBroadcasters"`
+	NationalRadioBroadcasters []Broadcaster `json:"nationalRadioBroadcasters"`
+	NationalOttBroadcasters   []Broadcaster `json:"nationalOttBroadcasters"`
+	HomeTvBroadcasters        []Broadcaster `json:"homeTvBroadcasters"`
+	HomeRadioBroadcasters     []Broadcaster `json:"homeRadioBroadcasters"`
+	HomeOttBroadcasters       []Broadcaster `json:"homeOttBroadcasters"`
+	AwayTvBroadcasters        []Broadcaster `json:"awayTvBroadcasters"`
+	AwayRadioBroadcasters     []Broadcaster `json:"awayRadioBroadcasters"`
+	AwayOttBroadcasters       []Broadcaster `json:"awayOttBroadcasters"`
+	IntlRadioBroadcasters     []Broadcaster `json:"intlRadioBroadcasters"`
+	IntlTvBroadcasters        []Broadcaster `json:"intlTvBroadcasters"`
+	IntlOttBroadcasters       []Broadcaster `json:"intlOttBroadcasters"`
+}
+
+// All returns every broadcaster of the game, national ones first.
+func (b Broadcasters) All() []Broadcaster {
+	var all []Broadcaster
+	for _, list := range [][]Broadcaster{
+		b.NationalBroadcasters, b.NationalRadioBroadcasters, b.NationalOttBroadcasters,
+		b.HomeTvBroadcasters, b.HomeRadioBroadcasters, b.HomeOttBroadcasters,
+		b.AwayTvBroadcasters, b.AwayRadioBroadcasters, b.AwayOttBroadcasters,
+		b.IntlRadioBroadcasters, b.IntlTvBroadcasters, b.IntlOttBroadcasters,
+	} {
+		all = append(all, list...)
+	}
+	return all
+}
+
+// Team is a team of a scheduled game. Score is zero until the game starts.
+type Team struct {
+	TeamID      int    `json:"teamId"`
+	TeamName    string `json:"teamName"`
+	TeamCity    string `json:"teamCity"`
+	TeamTricode string `json:"teamTricode"`
+	TeamSlug    string `json:"teamSlug"`
+	Wins        int    `json:"wins"`
+	Losses      int    `json:"losses"`
+	Score       int    `json:"score"`
+	Seed        int    `json:"seed"`
+}
+
+// PointsLeader is the leading scorer of a finished game.
+type PointsLeader struct {
+	PersonID    int     `json:"personId"`
+	FirstName   string  `json:"firstName"`
+	LastName    string  `json:"lastName"`
+	TeamID      int     `json:"teamId"`
+	TeamCity    string  `json:"teamCity"`
+	TeamName    string  `json:"teamName"`
+	TeamTricode string  `json:"teamTricode"`
+	Points      float64 `json:"points"`
+}
+
+// Game is a scheduled game. GameDateTimeUTC is the tip-off time, and
+// GameDateTimeEst is the same instant in Eastern Time. If gameDateTimeEst is
+// invalid or does not match gameDateTimeUTC, GameDateTimeEst is
+// GameDateTimeUTC in Eastern Time and a warning is logged. GameDate is
+// formatted as "01/02/2006 15:04:05".
+//
+// The date and time parts are kept as sent: GameDateEst and GameDateUTC hold
+// the date at midnight, e.g. "2023-10-24T00:00:00Z", GameTimeEst and
+// GameTimeUTC hold the time on 1900-01-01, e.g. "1900-01-01T19:30:00Z", and
+// HomeTeamTime and AwayTeamTime hold the tip-off on the local wall clock of
+// each team, all with a "Z" suffix.
+type Game struct {
+	GameDate         string         `json:"gameDate"`
+	GameID           string         `json:"gameId"`
+	GameCode         string         `json:"gameCode"`
+	GameStatus       int            `json:"gameStatus"`
+	GameStatusText   string         `json:"gameStatusText"`
+	GameSequence     int            `json:"gameSequence"`
+	GameDateEst      string         `json:"gameDateEst"`
+	GameTimeEst      string         `json:"gameTimeEst"`
+	GameDateTimeEst  time.Time      `json:"gameDateTimeEst"`
+	GameDateUTC      string         `json:"gameDateUTC"`
+	GameTimeUTC      string         `json:"gameTimeUTC"`
+	GameDateTimeUTC  time.Time      `json:"gameDateTimeUTC"`
+	AwayTeamTime     string         `json:"awayTeamTime"`
+	HomeTeamTime     string         `json:"homeTeamTime"`
+	Day              string         `json:"day"`
+	MonthNum         int            `json:"monthNum"`
+	WeekNumber       int            `json:"weekNumber"`
+	WeekName         string         `json:"weekName"`
+	IfNecessary      bool           `json:"ifNecessary"`
+	SeriesGameNumber string         `json:"seriesGameNumber"`
+	GameLabel        string         `json:"gameLabel"`
+	GameSubLabel     string         `json:"gameSubLabel"`
+	SeriesText       string         `json:"seriesText"`
+	ArenaName        string         `json:"arenaName"`
+	ArenaState       string         `json:"arenaState"`
+	ArenaCity        string         `json:"arenaCity"`
+	PostponedStatus  string         `json:"postponedStatus"`
+	BranchLink       string         `json:"branchLink"`
+	GameSubtype      string         `json:"gameSubtype"`
+	IsNeutral        bool           `json:"isNeutral"`
+	Broadcasters     Broadcasters   `json:"broadcasters"`
+	HomeTeam         Team           `json:"homeTeam"`
+	AwayTeam         Team           `json:"awayTeam"`
+	PointsLeaders    []PointsLeader `json:"pointsLeaders"`
+
+	// timeErr reports the times that could not be decoded.
+	timeErr error
+}
+
+// UnmarshalJSON decodes a game, parsing its UTC and Eastern times.
+// ifNecessary is sent as the string "true" or "false" and is accepted as a
+// boolean as well.
+func (g *Game) UnmarshalJSON(data []byte) error {
+	type plain Game
+	raw := struct {
+		*plain
+		GameDateTimeEst string          `json:"gameDateTimeEst"`
+		GameDateTimeUTC string          `json:"gameDateTimeUTC"`
+		IfNecessary     json.RawMessage `json:"ifNecessary"`
+	}{plain: (*plain)(g)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	ifNecessary := strings.Trim(string(raw.IfNecessary), `"`)
+	switch ifNecessary {
+	case "", "null":
+		g.IfNecessary = false
+	default:
+		v, err := strconv.ParseBool(ifNecessary)
+		if err != nil {
+			return fmt.Errorf("failed to decode ifNecessary of game %s: invalid value %s", g.GameID, raw.IfNecessary)
+		}
+		g.IfNecessary = v
+	}
+
+	// A bad time of one game must not fail the whole schedule: it is left
+	// zero, or taken from the UTC time, and reported by timeErr.
+	g.timeErr = nil
+	var err error
+	if g.GameDateTimeUTC, err = stats.ParseUTCTime(raw.GameDateTimeUTC); err != nil {
+		g.timeErr = fmt.Errorf("invalid gameDateTimeUTC: %w", err)
+	}
+	if g.GameDateTimeEst, err = stats.ParseEasternTime(raw.GameDateTimeEst, g.GameDateTimeUTC); err != nil {
+		g.GameDateTimeEst = stats.InEastern(g.GameDateTimeUTC)
+		g.timeErr = errors.Join(g.timeErr, fmt.Errorf("invalid gameDateTimeEst: %w", err))
+	}
+	return nil
+}
+
+// HasTeam reports whether the team plays in the game.
+func (g *Game) HasTeam(teamID int) bool {
+	return g.HomeTeam.TeamID == teamID || g.AwayTeam.TeamID == teamID
+}
+
+// GameDate holds the games of one day.
+type GameDate struct {
+	GameDate string `json:"gameDate"`
+	Games    []Game `json:"games"`
+}
+
+// Week is a week of the regular season.
+type Week struct {
+	WeekNumber int       `json:"weekNumber"`
+	WeekName   string    `json:"weekName"`
+	StartDate  time.Time `json:"startDate"`
+	EndDate    time.Time `json:"endDate"`
+}
+
+// LeagueSchedule holds the games of a season by day.
+type LeagueSchedule struct {
+	SeasonYear string     `json:"seasonYear"`
+	LeagueID   string     `json:"leagueId"`
+	GameDates  []GameDate `json:"gameDates"`
+	Weeks      []Week     `json:"weeks"`
+}
+
+// Games returns every game of the season in schedule order.
+func (s *LeagueSchedule) Games() []Game {
+	return s.filter(func(*Game) bool { return true })
+}
+
+// Game returns the game with the given ID.
+func (s *LeagueSchedule) Game(gameID string) (Game, bool) {
+	games := s.filter(func(g *Game) bool { return g.GameID == gameID })
+	if len(games) == 0 {
+		return Game{}, false
+	}
+	return games[0], true
+}
+
+// GamesForTeam returns the games of a team.
+func (s *LeagueSchedule) GamesForTeam(teamID int) []Game {
+	return s.filter(func(g *Game) bool { return g.HasTeam(teamID) })
+}
+
+// GamesBetween returns the games that tip off at or after from and before
+// to.
+func (s *LeagueSchedule) GamesBetween(from, to time.Time) []Game {
+	return s.filter(func(g *Game) bool {
+		return !g.GameDateTimeUTC.Before(from) && g.GameDateTimeUTC.Before(to)
+	})
+}
+
+// GamesOnBroadcaster returns the games carried by a broadcaster, matched
+// case-insensitively by its display name or abbreviation, e.g. "ESPN".
+func (s *LeagueSchedule) GamesOnBroadcaster(name string) []Game {
+	return s.filter(func(g *Game) bool {
+		for _, b := range g.Broadcasters.All() {
+			if strings.EqualFold(b.BroadcasterDisplay, name) || strings.EqualFold(b.BroadcasterAbbreviation, name) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// filter returns the games for which keep returns true.
+func (s *LeagueSchedule) filter(keep func(*Game) bool) []Game {
+	var games []Game
+	for i := range s.GameDates {
+		for j := range s.GameDates[i].Games {
+			if g := &s.GameDates[i].Games[j]; keep(g) {
+				games = append(games, *g)
+			}
+		}
+	}
+	return games
+}
+
+// ScheduleLeagueV2Response is the response of the scheduleleaguev2 endpoint.
+type ScheduleLeagueV2Response struct {
+	Meta           Meta           `json:"meta"`
+	LeagueSchedule LeagueSchedule `json:"leagueSchedule"`
+}
+
+// StatsResponse converts the schedule to the SeasonGames and SeasonWeeks
+// result sets. Team columns are prefixed with "homeTeam_" or "awayTeam_",
+// the columns of the game's top scorer with "pointsLeaders_", and the parsed
+// times are formatted as RFC 3339.
+func (r *ScheduleLeagueV2Response) StatsResponse() *StatsResponse {
+	ls := r.LeagueSchedule
+
+	games := ResultSet{Name: "SeasonGames", Headers: []string{
+		"leagueId", "seasonYear", "gameDate", "gameId", "gameCode", "gameStatus", "gameStatusText",
+		"gameSequence", "gameDateEst", "gameTimeEst", "gameDateTimeEst", "gameDateUTC", "gameTimeUTC",
+		"gameDateTimeUTC", "awayTeamTime", "homeTeamTime", "day", "monthNum", "weekNumber", "weekName",
+		"ifNecessary", "seriesGameNumber", "gameLabel", "gameSubLabel", "seriesText", "arenaName",
+		"arenaState", "arenaCity", "postponedStatus", "branchLink", "gameSubtype", "isNeutral",
+	}}
+	for _, side := range []string{"homeTeam_", "awayTeam_"} {
+		for _, column := range []string{"teamId", "teamName", "teamCity", "teamTricode", "teamSlug", "wins", "losses", "score", "seed"} {
+			games.Headers = append(games.Headers, side+column)
+		}
+	}
+	for _, column := range []string{"personId", "firstName", "lastName", "teamId", "teamCity", "teamName", "teamTricode", "points"} {
+		games.Headers = append(games.Headers, "pointsLeaders_"+column)
+	}
+	for _, g := range ls.Games() {
+		values := []interface{}{
+			ls.LeagueID, ls.SeasonYear, g.GameDate, g.GameID, g.GameCode, float64(g.GameStatus), g.GameStatusText,
+			float64(g.GameSequence), g.GameDateEst, g.GameTimeEst, formatTime(g.GameDateTimeEst), g.GameDateUTC, g.GameTimeUTC,
+			formatTime(g.GameDateTimeUTC), g.AwayTeamTime, g.HomeTeamTime, g.Day, float64(g.MonthNum), float64(g.WeekNumber), g.WeekName,
+			g.IfNecessary, g.SeriesGameNumber, g.GameLabel, g.GameSubLabel, g.SeriesText, g.ArenaName,
+			g.ArenaState, g.ArenaCity, g.PostponedStatus, g.BranchLink, g.GameSubtype, g.IsNeutral,
+		}
+		for _, team := range []Team{g.HomeTeam, g.AwayTeam} {
+			values = append(values, float64(team.TeamID), team.TeamName, team.TeamCity, team.TeamTricode, team.TeamSlug,
+				float64(team.Wins), float64(team.Losses), float64(team.Score), float64(team.Seed))
+		}
+		if len(g.PointsLeaders) > 0 {
+			p := g.PointsLeaders[0]
+			values = append(values, float64(p.PersonID), p.FirstName, p.LastName, float64(p.TeamID), p.TeamCity,
+				p.TeamName, p.TeamTricode, p.Points)
+		} else {
+			values = append(values, nil, nil, nil, nil, nil, nil, nil, nil)
+		}
+		games.RowSet = append(games.RowSet, values)
+	}
+
+	weeks := ResultSet{Name: "SeasonWeeks", Headers: []string{
+		"leagueId", "seasonYear", "weekNumber", "weekName", "startDate", "endDate",
+	}}
+	for _, w := range ls.Weeks {
+		weeks.RowSet = append(weeks.RowSet, []interface{}{
+			ls.LeagueID, ls.SeasonYear, float64(w.WeekNumber), w.WeekName, formatTime(w.StartDate), formatTime(w.EndDate),
+		})
+	}
+
+	return &StatsResponse{
+		Resource:   "scheduleleaguev2",
+		ResultSets: []ResultSet{games, weeks},
+	}
+}
+
+// formatTime formats t as RFC 3339, or returns "" for the zero time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 // ScheduleLeagueV2Params holds parameters for the ScheduleLeagueV2 endpoint.
 type ScheduleLeagueV2Params struct {
 	LeagueId string
 	Season string
 }
 
-// GetScheduleLeagueV2 fetches data from the scheduleleaguev2 endpoint.
+// GetScheduleLeagueV2 fetches data from the scheduleleaguev2 endpoint and
+// converts it to result sets. Use GetScheduleLeagueV2Typed for the schedule.
 func (c *Client) GetScheduleLeagueV2(ctx context.Context, params ScheduleLeagueV2Params) (*StatsResponse, error) {
+	resp, err := c.GetScheduleLeagueV2Typed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatsResponse(), nil
+}
+
+// GetScheduleLeagueV2Typed fetches the schedule of a season from the
+// scheduleleaguev2 endpoint.
+func (c *Client) GetScheduleLeagueV2Typed(ctx context.Context, params ScheduleLeagueV2Params) (*ScheduleLeagueV2Response, error) {
 	c.logger.InfoContext(ctx, "Fetching scheduleleaguev2")
 
 	reqParams := map[string]string{
@@ -21,27 +360,23 @@ func (c *Client) GetScheduleLeagueV2(ctx context.Context, params ScheduleLeagueV
 		"Season": params.Season,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "scheduleleaguev2", reqParams)
-	if err != nil {
+	var scheduleResp ScheduleLeagueV2Response
+	if _, err := c.httpClient.Get(ctx, "scheduleleaguev2", reqParams, &scheduleResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch scheduleleaguev2",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch scheduleleaguev2: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from scheduleleaguev2 endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal scheduleleaguev2 response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	for _, g := range scheduleResp.LeagueSchedule.Games() {
+		if g.timeErr != nil {
+			c.logger.WarnContext(ctx, "Invalid game time in scheduleleaguev2, using the UTC time",
+				slog.String("game_id", g.GameID),
+				slog.String("error", g.timeErr.Error()))
+		}
 	}
 
 	c.logger.InfoContext(ctx, "Successfully fetched scheduleleaguev2",
-		slog.Int("result_sets_count", len(statsResp.ResultSets)))
+		slog.Int("game_dates_count", len(scheduleResp.LeagueSchedule.GameDates)))
 
-	return &statsResp, nil
+	return &scheduleResp, nil
 }
@@ -1,40 +1,99 @@
 package schedule
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/utkonoser/nba-api-go/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestGetScheduleLeagueV2(t *testing.T) {
-	mockResponse := `{
-		"resource": "scheduleleaguev2",
-		"parameters": {},
-		"resultSets": [
-{
-				"name": "SeasonGames",
-				"headers": ["leagueId", "seasonYear", "gameDate", "gameId", "gameCode"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+const scheduleLeagueV2Response = `{
+	"meta": {"version": 1, "request": "http://nba.cloud/league/00/2023-24/scheduleleaguev2", "time": "2023-10-01T12:00:00.000Z"},
+	"leagueSchedule": {
+		"seasonYear": "2023-24",
+		"leagueId": "00",
+		"gameDates": [
+			{
+				"gameDate": "10/24/2023 00:00:00",
+				"games": [
+					{
+						"gameDate": "10/24/2023 00:00:00", "gameId": "0022300061", "gameCode": "20231024/LALDEN",
+						"gameStatus": 3, "gameStatusText": "Final", "gameSequence": 1,
+						"gameDateEst": "2023-10-24T00:00:00Z", "gameTimeEst": "1900-01-01T19:30:00Z", "gameDateTimeEst": "2023-10-24T19:30:00Z",
+						"gameDateUTC": "2023-10-24T04:00:00Z", "gameTimeUTC": "1900-01-01T23:30:00Z", "gameDateTimeUTC": "2023-10-24T23:30:00Z",
+						"awayTeamTime": "2023-10-24T16:30:00Z", "homeTeamTime": "2023-10-24T17:30:00Z",
+						"day": "Tue", "monthNum": 10, "weekNumber": 1, "weekName": "Week 1", "ifNecessary": "false",
+						"seriesGameNumber": "", "gameLabel": "", "gameSubLabel": "", "seriesText": "",
+						"arenaName": "Ball Arena", "arenaState": "CO", "arenaCity": "Denver", "postponedStatus": "A",
+						"branchLink": "", "gameSubtype": "", "isNeutral": false,
+						"broadcasters": {
+							"nationalBroadcasters": [{"broadcasterScope": "natl", "broadcasterMedia": "tv", "broadcasterId": 10,
+								"broadcasterDisplay": "TNT", "broadcasterAbbreviation": "TNT", "broadcasterTeamId": -1}],
+							"homeTvBroadcasters": [],
+							"awayTvBroadcasters": []
+						},
+						"homeTeam": {"teamId": 1610612743, "teamName": "Nuggets", "teamCity": "Denver", "teamTricode": "DEN", "teamSlug": "nuggets", "wins": 1, "losses": 0, "score": 119, "seed": 0},
+						"awayTeam": {"teamId": 1610612747, "teamName": "Lakers", "teamCity": "Los Angeles", "teamTricode": "LAL", "teamSlug": "lakers", "wins": 0, "losses": 1, "score": 107, "seed": 0},
+						"pointsLeaders": [{"personId": 203999, "firstName": "Nikola", "lastName": "Jokic", "teamId": 1610612743,
+							"teamCity": "Denver", "teamName": "Nuggets", "teamTricode": "DEN", "points": 29.0}]
+					},
+					{
+						"gameDate": "10/24/2023 00:00:00", "gameId": "0022300062", "gameCode": "20231024/PHXGSW",
+						"gameStatus": 3, "gameStatusText": "Final", "gameSequence": 2,
+						"gameDateTimeEst": "2023-10-24T22:00:00Z", "gameDateTimeUTC": "2023-10-25T02:00:00Z",
+						"day": "Tue", "monthNum": 10, "weekNumber": 1, "weekName": "Week 1",
+						"arenaName": "Chase Center", "arenaState": "CA", "arenaCity": "San Francisco", "postponedStatus": "A",
+						"broadcasters": {
+							"nationalBroadcasters": [{"broadcasterScope": "natl", "broadcasterMedia": "tv", "broadcasterId": 10,
+								"broadcasterDisplay": "TNT", "broadcasterAbbreviation": "TNT", "broadcasterTeamId": -1}]
+						},
+						"homeTeam": {"teamId": 1610612744, "teamName": "Warriors", "teamCity": "Golden State", "teamTricode": "GSW", "teamSlug": "warriors", "wins": 0, "losses": 1, "score": 104},
+						"awayTeam": {"teamId": 1610612756, "teamName": "Suns", "teamCity": "Phoenix", "teamTricode": "PHX", "teamSlug": "suns", "wins": 1, "losses": 0, "score": 108}
+					}
+				]
 			},
-{
-				"name": "SeasonWeeks",
-				"headers": ["leagueId", "seasonYear", "weekNumber", "weekName", "startDate"],
-				"rowSet": [[1, "Test", "Data", 100, 50]]
+			{
+				"gameDate": "01/15/2024 00:00:00",
+				"games": [
+					{
+						"gameDate": "01/15/2024 00:00:00", "gameId": "0022300580", "gameCode": "20240115/LALOKC",
+						"gameStatus": 1, "gameStatusText": "8:00 pm ET", "gameSequence": 7,
+						"gameDateTimeEst": "2024-01-15T20:00:00Z", "gameDateTimeUTC": "2024-01-16T01:00:00Z",
+						"day": "Mon", "monthNum": 1, "weekNumber": 13, "weekName": "Week 13",
+						"arenaName": "Paycom Center", "arenaState": "OK", "arenaCity": "Oklahoma City", "postponedStatus": "A",
+						"broadcasters": {
+							"homeTvBroadcasters": [{"broadcasterScope": "local", "broadcasterMedia": "tv", "broadcasterId": 2021,
+								"broadcasterDisplay": "BSOK", "broadcasterAbbreviation": "BSOK", "broadcasterTeamId": 1610612760}],
+							"awayTvBroadcasters": [{"broadcasterScope": "local", "broadcasterMedia": "tv", "broadcasterId": 1442,
+								"broadcasterDisplay": "Spectrum SportsNet", "broadcasterAbbreviation": "SPECSN", "broadcasterTeamId": 1610612747}]
+						},
+						"homeTeam": {"teamId": 1610612760, "teamName": "Thunder", "teamCity": "Oklahoma City", "teamTricode": "OKC", "teamSlug": "thunder", "wins": 27, "losses": 12},
+						"awayTeam": {"teamId": 1610612747, "teamName": "Lakers", "teamCity": "Los Angeles", "teamTricode": "LAL", "teamSlug": "lakers", "wins": 20, "losses": 21}
+					}
+				]
 			}
+		],
+		"weeks": [
+			{"weekNumber": 1, "weekName": "Week 1", "startDate": "2023-10-23T00:00:00Z", "endDate": "2023-10-29T00:00:00Z"}
 		]
-	}`
+	}
+}`
 
+func TestGetScheduleLeagueV2(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Contains(t, r.URL.Path, "scheduleleaguev2")
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(mockResponse))
+		_, _ = w.Write([]byte(scheduleLeagueV2Response))
 	}))
 	defer server.Close()
 
@@ -47,11 +106,142 @@ func TestGetScheduleLeagueV2(t *testing.T) {
 
 	params := ScheduleLeagueV2Params{}
 
+	resp, err := c.GetScheduleLeagueV2Typed(context.Background(), params)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	schedule := resp.LeagueSchedule
+	assert.Equal(t, "2023-24", schedule.SeasonYear)
+	require.Len(t, schedule.GameDates, 2)
+	g := schedule.GameDates[0].Games[0]
+	tipOff := time.Date(2023, time.October, 24, 23, 30, 0, 0, time.UTC)
+	assert.Equal(t, tipOff, g.GameDateTimeUTC)
+	assert.True(t, g.GameDateTimeEst.Equal(tipOff))
+	assert.Equal(t, "19:30 EDT", g.GameDateTimeEst.Format("15:04 MST"))
+	assert.Equal(t, "Ball Arena", g.ArenaName)
+	assert.Equal(t, "LAL", g.AwayTeam.TeamTricode)
+	assert.Equal(t, "1900-01-01T19:30:00Z", g.GameTimeEst)
+	assert.Equal(t, "2023-10-24T17:30:00Z", g.HomeTeamTime)
+	assert.False(t, g.IfNecessary)
+	require.Len(t, g.PointsLeaders, 1)
+	assert.Equal(t, "Jokic", g.PointsLeaders[0].LastName)
+	assert.Equal(t, float64(29), g.PointsLeaders[0].Points)
+	require.Len(t, schedule.Weeks, 1)
+	assert.Equal(t, time.Date(2023, time.October, 23, 0, 0, 0, 0, time.UTC), schedule.Weeks[0].StartDate)
+
 	response, err := c.GetScheduleLeagueV2(context.Background(), params)
 
 	require.NoError(t, err)
 	require.NotNil(t, response)
 	assert.Equal(t, "scheduleleaguev2", response.Resource)
-	// Note: ResultSets may be empty for some endpoints
-	assert.NotNil(t, response.ResultSets)
+	games, err := response.GetDataSet("SeasonGames")
+	require.NoError(t, err)
+	require.Equal(t, 3, games.RowCount())
+	row, err := games.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-10-24T04:00:00Z", row["gameDateUTC"])
+	assert.Equal(t, "2023-10-24T16:30:00Z", row["awayTeamTime"])
+	assert.Equal(t, false, row["ifNecessary"])
+	assert.Equal(t, float64(203999), row["pointsLeaders_personId"])
+	assert.Equal(t, float64(29), row["pointsLeaders_points"])
+	row, err = games.GetRow(2)
+	require.NoError(t, err)
+	assert.Equal(t, "0022300580", row["gameId"])
+	assert.Equal(t, "2024-01-15T20:00:00-05:00", row["gameDateTimeEst"])
+	assert.Equal(t, "OKC", row["homeTeam_teamTricode"])
+	assert.Equal(t, float64(21), row["awayTeam_losses"])
+	assert.Nil(t, row["pointsLeaders_personId"], "no top scorer before the game")
+
+	weeks, err := response.GetDataSet("SeasonWeeks")
+	require.NoError(t, err)
+	assert.Equal(t, 1, weeks.RowCount())
+}
+
+func TestGetScheduleLeagueV2_InvalidGameTime(t *testing.T) {
+	mockResponse := `{
+		"meta": {"version": 1, "request": "http://nba.cloud/league/00/2023-24/scheduleleaguev2", "time": "2024-01-15T15:00:00.000Z"},
+		"leagueSchedule": {
+			"seasonYear": "2023-24",
+			"leagueId": "00",
+			"gameDates": [{
+				"gameDate": "10/24/2023 00:00:00",
+				"games": [
+					{"gameId": "0022300061", "gameDateTimeEst": "2023-10-24T20:30:00Z", "gameDateTimeUTC": "2023-10-24T23:30:00Z", "ifNecessary": false},
+					{"gameId": "0022300062", "gameDateTimeEst": "TBD", "gameDateTimeUTC": "2023-10-25T02:00:00Z"}
+				]
+			}]
+		}
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(mockResponse))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	httpClient := client.NewHTTPClient(server.URL+"/%s", DefaultHeaders(), logger)
+	c := &Client{
+		httpClient: httpClient,
+		logger:     logger,
+	}
+
+	resp, err := c.GetScheduleLeagueV2Typed(context.Background(), ScheduleLeagueV2Params{})
+
+	require.NoError(t, err, "a mismatched game must not fail the schedule")
+	games := resp.LeagueSchedule.Games()
+	require.Len(t, games, 2)
+	mismatched := games[0]
+	tipOff := time.Date(2023, time.October, 24, 23, 30, 0, 0, time.UTC)
+	assert.True(t, mismatched.GameDateTimeEst.Equal(tipOff), "falls back to the UTC time")
+	assert.Equal(t, "19:30 EDT", mismatched.GameDateTimeEst.Format("15:04 MST"))
+	assert.Equal(t, "22:00 EDT", games[1].GameDateTimeEst.Format("15:04 MST"))
+	assert.Equal(t, 2, strings.Count(logs.String(), "Invalid game time in scheduleleaguev2"))
+	assert.Contains(t, logs.String(), "game_id=0022300061")
+
+	response, err := c.GetScheduleLeagueV2(context.Background(), ScheduleLeagueV2Params{})
+
+	require.NoError(t, err)
+	seasonGames, err := response.GetDataSet("SeasonGames")
+	require.NoError(t, err)
+	row, err := seasonGames.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-10-24T19:30:00-04:00", row["gameDateTimeEst"])
+}
+
+func TestLeagueSchedule_Queries(t *testing.T) {
+	var resp ScheduleLeagueV2Response
+	require.NoError(t, json.Unmarshal([]byte(scheduleLeagueV2Response), &resp))
+	schedule := &resp.LeagueSchedule
+
+	gameIDs := func(games []Game) []string {
+		var ids []string
+		for _, g := range games {
+			ids = append(ids, g.GameID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"0022300061", "0022300062", "0022300580"}, gameIDs(schedule.Games()))
+	assert.Equal(t, []string{"0022300061", "0022300580"}, gameIDs(schedule.GamesForTeam(1610612747)))
+	assert.Empty(t, schedule.GamesForTeam(1610612738))
+
+	from := time.Date(2023, time.October, 24, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.October, 25, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []string{"0022300061"}, gameIDs(schedule.GamesBetween(from, to)))
+	assert.Equal(t, []string{"0022300061", "0022300062"}, gameIDs(schedule.GamesBetween(from, to.Add(24*time.Hour))))
+
+	assert.Equal(t, []string{"0022300061", "0022300062"}, gameIDs(schedule.GamesOnBroadcaster("tnt")))
+	assert.Equal(t, []string{"0022300580"}, gameIDs(schedule.GamesOnBroadcaster("SPECSN")))
+
+	g, ok := schedule.Game("0022300580")
+	require.True(t, ok)
+	assert.True(t, g.HasTeam(1610612760))
+	broadcasters := g.Broadcasters.All()
+	require.Len(t, broadcasters, 2)
+	assert.Equal(t, "BSOK", broadcasters[0].BroadcasterDisplay)
+
+	_, ok = schedule.Game("0000000000")
+	assert.False(t, ok)
 }
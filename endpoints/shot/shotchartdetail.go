@@ -79,25 +79,13 @@ func (c *Client) GetShotChartDetail(ctx context.Context, params ShotChartDetailP
 		"VsDivision": params.VsDivisionNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "shotchartdetail", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "shotchartdetail", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch shotchartdetail",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch shotchartdetail: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from shotchartdetail endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal shotchartdetail response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched shotchartdetail",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
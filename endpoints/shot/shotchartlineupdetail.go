@@ -113,25 +113,13 @@ func (c *Client) GetShotChartLineupDetail(ctx context.Context, params ShotChartL
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "shotchartlineupdetail", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "shotchartlineupdetail", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch shotchartlineupdetail",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch shotchartlineupdetail: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from shotchartlineupdetail endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal shotchartlineupdetail response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched shotchartlineupdetail",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
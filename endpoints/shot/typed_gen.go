@@ -0,0 +1,187 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package shot
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ShotChartDetailResult holds the decoded result sets of the shotchartdetail
+// endpoint.
+type ShotChartDetailResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueAverages  []ShotChartDetailLeagueAveragesRow
+	ShotChartDetail []ShotChartDetailRow
+}
+
+// GetShotChartDetailTyped is like GetShotChartDetail but decodes the result
+// sets into a ShotChartDetailResult.
+func (c *Client) GetShotChartDetailTyped(ctx context.Context, params ShotChartDetailParams) (*ShotChartDetailResult, error) {
+	resp, err := c.GetShotChartDetail(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ShotChartDetailResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"LeagueAverages":    &result.LeagueAverages,
+		"Shot_Chart_Detail": &result.ShotChartDetail,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode shotchartdetail: %w", err)
+	}
+	return result, nil
+}
+
+// ShotChartLeagueWideResult holds the decoded result sets of the
+// shotchartleaguewide endpoint.
+type ShotChartLeagueWideResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	LeagueWide []ShotChartLeagueWideLeagueWideRow
+}
+
+// GetShotChartLeagueWideTyped is like GetShotChartLeagueWide but decodes the
+// result sets into a ShotChartLeagueWideResult.
+func (c *Client) GetShotChartLeagueWideTyped(ctx context.Context, params ShotChartLeagueWideParams) (*ShotChartLeagueWideResult, error) {
+	resp, err := c.GetShotChartLeagueWide(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ShotChartLeagueWideResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"League_Wide": &result.LeagueWide,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode shotchartleaguewide: %w", err)
+	}
+	return result, nil
+}
+
+// ShotChartLineupDetailResult holds the decoded result sets of the
+// shotchartlineupdetail endpoint.
+type ShotChartLineupDetailResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	ShotChartLineupDetail        []ShotChartLineupDetailRow
+	ShotChartLineupLeagueAverage []ShotChartLineupDetailShotChartLineupLeagueAverageRow
+}
+
+// GetShotChartLineupDetailTyped is like GetShotChartLineupDetail but decodes
+// the result sets into a ShotChartLineupDetailResult.
+func (c *Client) GetShotChartLineupDetailTyped(ctx context.Context, params ShotChartLineupDetailParams) (*ShotChartLineupDetailResult, error) {
+	resp, err := c.GetShotChartLineupDetail(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ShotChartLineupDetailResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"ShotChartLineupDetail":        &result.ShotChartLineupDetail,
+		"ShotChartLineupLeagueAverage": &result.ShotChartLineupLeagueAverage,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode shotchartlineupdetail: %w", err)
+	}
+	return result, nil
+}
+
+// ShotChartDetailLeagueAveragesRow is a row of the shotchartdetail
+// LeagueAverages result set.
+type ShotChartDetailLeagueAveragesRow struct {
+	GridType      string  `nba:"GRID_TYPE"`
+	ShotZoneBasic string  `nba:"SHOT_ZONE_BASIC"`
+	ShotZoneArea  string  `nba:"SHOT_ZONE_AREA"`
+	ShotZoneRange string  `nba:"SHOT_ZONE_RANGE"`
+	FGA           float64 `nba:"FGA"`
+	FGM           float64 `nba:"FGM"`
+	FGPct         float64 `nba:"FG_PCT"`
+}
+
+// ShotChartDetailRow is a row of the shotchartdetail Shot_Chart_Detail result
+// set.
+type ShotChartDetailRow struct {
+	GridType          string    `nba:"GRID_TYPE"`
+	GameID            string    `nba:"GAME_ID"`
+	GameEventID       int       `nba:"GAME_EVENT_ID"`
+	PlayerID          int       `nba:"PLAYER_ID"`
+	PlayerName        string    `nba:"PLAYER_NAME"`
+	TeamID            int       `nba:"TEAM_ID"`
+	TeamName          string    `nba:"TEAM_NAME"`
+	Period            int       `nba:"PERIOD"`
+	MinutesRemaining  float64   `nba:"MINUTES_REMAINING"`
+	SecondsRemaining  float64   `nba:"SECONDS_REMAINING"`
+	EventType         string    `nba:"EVENT_TYPE"`
+	ActionType        string    `nba:"ACTION_TYPE"`
+	ShotType          string    `nba:"SHOT_TYPE"`
+	ShotZoneBasic     string    `nba:"SHOT_ZONE_BASIC"`
+	ShotZoneArea      string    `nba:"SHOT_ZONE_AREA"`
+	ShotZoneRange     string    `nba:"SHOT_ZONE_RANGE"`
+	ShotDistance      float64   `nba:"SHOT_DISTANCE"`
+	LocX              float64   `nba:"LOC_X"`
+	LocY              float64   `nba:"LOC_Y"`
+	ShotAttemptedFlag float64   `nba:"SHOT_ATTEMPTED_FLAG"`
+	ShotMadeFlag      float64   `nba:"SHOT_MADE_FLAG"`
+	GameDate          time.Time `nba:"GAME_DATE"`
+	Htm               string    `nba:"HTM"`
+	Vtm               string    `nba:"VTM"`
+}
+
+// ShotChartLeagueWideLeagueWideRow is a row of the shotchartleaguewide
+// League_Wide result set.
+type ShotChartLeagueWideLeagueWideRow struct {
+	GridType      string  `nba:"GRID_TYPE"`
+	ShotZoneBasic string  `nba:"SHOT_ZONE_BASIC"`
+	ShotZoneArea  string  `nba:"SHOT_ZONE_AREA"`
+	ShotZoneRange string  `nba:"SHOT_ZONE_RANGE"`
+	FGA           float64 `nba:"FGA"`
+	FGM           float64 `nba:"FGM"`
+	FGPct         float64 `nba:"FG_PCT"`
+}
+
+// ShotChartLineupDetailRow is a row of the shotchartlineupdetail
+// ShotChartLineupDetail result set.
+type ShotChartLineupDetailRow struct {
+	GridType          string    `nba:"GRID_TYPE"`
+	GameID            string    `nba:"GAME_ID"`
+	GameEventID       int       `nba:"GAME_EVENT_ID"`
+	GroupID           string    `nba:"GROUP_ID"`
+	GroupName         string    `nba:"GROUP_NAME"`
+	PlayerID          int       `nba:"PLAYER_ID"`
+	PlayerName        string    `nba:"PLAYER_NAME"`
+	TeamID            int       `nba:"TEAM_ID"`
+	TeamName          string    `nba:"TEAM_NAME"`
+	Period            int       `nba:"PERIOD"`
+	MinutesRemaining  float64   `nba:"MINUTES_REMAINING"`
+	SecondsRemaining  float64   `nba:"SECONDS_REMAINING"`
+	EventType         string    `nba:"EVENT_TYPE"`
+	ActionType        string    `nba:"ACTION_TYPE"`
+	ShotType          string    `nba:"SHOT_TYPE"`
+	ShotZoneBasic     string    `nba:"SHOT_ZONE_BASIC"`
+	ShotZoneArea      string    `nba:"SHOT_ZONE_AREA"`
+	ShotZoneRange     string    `nba:"SHOT_ZONE_RANGE"`
+	ShotDistance      float64   `nba:"SHOT_DISTANCE"`
+	LocX              float64   `nba:"LOC_X"`
+	LocY              float64   `nba:"LOC_Y"`
+	ShotAttemptedFlag float64   `nba:"SHOT_ATTEMPTED_FLAG"`
+	ShotMadeFlag      float64   `nba:"SHOT_MADE_FLAG"`
+	GameDate          time.Time `nba:"GAME_DATE"`
+	Htm               string    `nba:"HTM"`
+	Vtm               string    `nba:"VTM"`
+}
+
+// ShotChartLineupDetailShotChartLineupLeagueAverageRow is a row of the
+// shotchartlineupdetail ShotChartLineupLeagueAverage result set.
+type ShotChartLineupDetailShotChartLineupLeagueAverageRow struct {
+	GridType      string  `nba:"GRID_TYPE"`
+	ShotZoneBasic string  `nba:"SHOT_ZONE_BASIC"`
+	ShotZoneArea  string  `nba:"SHOT_ZONE_AREA"`
+	ShotZoneRange string  `nba:"SHOT_ZONE_RANGE"`
+	FGA           float64 `nba:"FGA"`
+	FGM           float64 `nba:"FGM"`
+	FGPct         float64 `nba:"FG_PCT"`
+}
@@ -0,0 +1,432 @@
+package stats
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrInvalidTarget is returned when the destination of Decode is not a
+	// pointer to a slice of structs.
+	ErrInvalidTarget = errors.New("decode target must be a pointer to a slice of structs")
+	// ErrUnknownColumn is returned in strict mode for a column without a
+	// matching struct field.
+	ErrUnknownColumn = errors.New("unknown column")
+	// ErrMissingColumn is returned in strict mode for a struct field without a
+	// matching column.
+	ErrMissingColumn = errors.New("missing column")
+	// ErrInvalidValue is returned when a cell cannot be converted to the type
+	// of its field.
+	ErrInvalidValue = errors.New("invalid value")
+)
+
+// timeLayouts are the date formats used by the NBA Stats API, tried in order.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"Jan 02, 2006",
+	"01/02/2006",
+	"20060102",
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textUnmarshalType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Decode decodes the rows of the result set into dst, which must be a pointer
+// to a slice of structs or of pointers to structs. Each row becomes one
+// element.
+//
+// Columns are matched to fields by the nba struct tag, e.g.
+// `nba:"PLAYER_ID"`. Untagged exported fields match the column whose name
+// equals the field name when case and underscores are ignored, so PlayerID
+// matches PLAYER_ID. The tag "-" skips a field and the option ",optional"
+// exempts it from the missing column check of DecodeStrict.
+//
+// JSON numbers are converted to integer, float, string and bool fields; an
+// integer field rejects a number with a fraction. Strings are parsed into
+// numbers, bools ("Y"/"N", "W"/"L" and "1"/"0" included), time.Time using
+// the date formats of the API, and types implementing
+// encoding.TextUnmarshaler. A null cell leaves a pointer field nil and any
+// other field at its zero value.
+func (rs *ResultSet) Decode(dst interface{}) error {
+	return rs.decode(dst, false)
+}
+
+// DecodeStrict is like Decode but fails with ErrUnknownColumn for a column
+// without a matching field and ErrMissingColumn for a field without a
+// matching column, unless the field is tagged optional.
+func (rs *ResultSet) DecodeStrict(dst interface{}) error {
+	return rs.decode(dst, true)
+}
+
+// Decode decodes the rows of the named result set into dst. See
+// ResultSet.Decode.
+func (r *Response) Decode(name string, dst interface{}) error {
+	rs, err := r.GetDataSet(name)
+	if err != nil {
+		return err
+	}
+	return rs.Decode(dst)
+}
+
+// DecodeAll decodes each result set named in targets into its destination.
+// Result sets missing from the response are skipped, leaving their
+// destination unchanged. See ResultSet.Decode.
+func (r *Response) DecodeAll(targets map[string]interface{}) error {
+	for _, rs := range r.ResultSets {
+		dst, ok := targets[rs.Name]
+		if !ok {
+			continue
+		}
+		if err := rs.Decode(dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decode implements Decode and DecodeStrict.
+func (rs *ResultSet) decode(dst interface{}, strict bool) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("%w, got %T", ErrInvalidTarget, dst)
+	}
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	structType := elemType
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("%w, got %T", ErrInvalidTarget, dst)
+	}
+
+	info := cachedStructInfo(structType)
+	columns, err := info.columns(rs.Headers, strict)
+	if err != nil {
+		return fmt.Errorf("failed to decode result set %s into %s: %w", rs.Name, structType, err)
+	}
+
+	out := reflect.MakeSlice(slice.Type(), len(rs.RowSet), len(rs.RowSet))
+	for i, row := range rs.RowSet {
+		elem := out.Index(i)
+		if elemType.Kind() == reflect.Ptr {
+			elem.Set(reflect.New(structType))
+			elem = elem.Elem()
+		}
+		for col, field := range columns {
+			if field == nil || col >= len(row) {
+				continue
+			}
+			if err := setValue(elem.FieldByIndex(field.index), row[col]); err != nil {
+				return fmt.Errorf("failed to decode row %d column %s into %s.%s: %w",
+					i, rs.Headers[col], structType, field.name, err)
+			}
+		}
+	}
+	slice.Set(out)
+	return nil
+}
+
+// structInfo describes the decodable fields of a struct type.
+type structInfo struct {
+	fields []fieldInfo
+	byKey  map[string]*fieldInfo
+}
+
+// fieldInfo describes a decodable struct field.
+type fieldInfo struct {
+	name     string
+	column   string
+	key      string
+	index    []int
+	optional bool
+}
+
+// structInfos caches structInfo by reflect.Type.
+var structInfos sync.Map
+
+// cachedStructInfo returns the structInfo of t, computing it once.
+func cachedStructInfo(t reflect.Type) *structInfo {
+	if info, ok := structInfos.Load(t); ok {
+		return info.(*structInfo)
+	}
+	info := &structInfo{byKey: make(map[string]*fieldInfo)}
+	collectFields(t, nil, info)
+	for i := range info.fields {
+		f := &info.fields[i]
+		if _, exists := info.byKey[f.key]; !exists {
+			info.byKey[f.key] = f
+		}
+	}
+	actual, _ := structInfos.LoadOrStore(t, info)
+	return actual.(*structInfo)
+}
+
+// collectFields adds the decodable fields of t, including those of embedded
+// structs, to info.
+func collectFields(t reflect.Type, index []int, info *structInfo) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, hasTag := sf.Tag.Lookup("nba")
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		if sf.Anonymous && !hasTag && sf.Type.Kind() == reflect.Struct {
+			collectFields(sf.Type, fieldIndex, info)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		column, options, _ := strings.Cut(tag, ",")
+		if column == "" {
+			column = sf.Name
+		}
+		info.fields = append(info.fields, fieldInfo{
+			name:     sf.Name,
+			column:   column,
+			key:      normalizeColumn(column),
+			index:    fieldIndex,
+			optional: options == "optional",
+		})
+	}
+}
+
+// normalizeColumn returns the key used to match columns and field names.
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// columns returns the field of each header, or nil for unmatched headers.
+func (info *structInfo) columns(headers []string, strict bool) ([]*fieldInfo, error) {
+	columns := make([]*fieldInfo, len(headers))
+	matched := make(map[*fieldInfo]bool, len(headers))
+	for i, header := range headers {
+		field := info.byKey[normalizeColumn(header)]
+		if field == nil || matched[field] {
+			if strict && field == nil {
+				return nil, fmt.Errorf("%w %s", ErrUnknownColumn, header)
+			}
+			continue
+		}
+		columns[i] = field
+		matched[field] = true
+	}
+
+	if strict {
+		for i := range info.fields {
+			field := &info.fields[i]
+			if !field.optional && info.byKey[field.key] == field && !matched[field] {
+				return nil, fmt.Errorf("%w %s for field %s", ErrMissingColumn, field.column, field.name)
+			}
+		}
+	}
+	return columns, nil
+}
+
+// setValue stores the JSON value raw in v.
+func setValue(v reflect.Value, raw interface{}) error {
+	if raw == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setValue(v.Elem(), raw)
+	}
+
+	if v.Type() == timeType {
+		return setTime(v, raw)
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalType) {
+		s, ok := raw.(string)
+		if !ok {
+			s = fmt.Sprint(raw)
+		}
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(raw))
+		return nil
+	case reflect.String:
+		return setString(v, raw)
+	case reflect.Bool:
+		return setBool(v, raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return setInt(v, raw)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return setUint(v, raw)
+	case reflect.Float32, reflect.Float64:
+		return setFloat(v, raw)
+	}
+	return fmt.Errorf("%w: unsupported field type %s", ErrInvalidValue, v.Type())
+}
+
+// setString stores raw in a string field.
+func setString(v reflect.Value, raw interface{}) error {
+	switch x := raw.(type) {
+	case string:
+		v.SetString(x)
+	case float64:
+		v.SetString(strconv.FormatFloat(x, 'f', -1, 64))
+	case json.Number:
+		v.SetString(x.String())
+	case bool:
+		v.SetString(strconv.FormatBool(x))
+	default:
+		return mismatch(raw, v)
+	}
+	return nil
+}
+
+// setBool stores raw in a bool field.
+func setBool(v reflect.Value, raw interface{}) error {
+	switch x := raw.(type) {
+	case bool:
+		v.SetBool(x)
+	case float64:
+		if x != 0 && x != 1 {
+			return mismatch(raw, v)
+		}
+		v.SetBool(x == 1)
+	case string:
+		switch strings.ToUpper(x) {
+		case "Y", "YES", "W", "TRUE", "1":
+			v.SetBool(true)
+		case "N", "NO", "L", "FALSE", "0", "":
+			v.SetBool(false)
+		default:
+			return mismatch(raw, v)
+		}
+	default:
+		return mismatch(raw, v)
+	}
+	return nil
+}
+
+// setInt stores raw in a signed integer field. Floats are checked against
+// the int64 range before the conversion, whose result is undefined outside
+// of it.
+func setInt(v reflect.Value, raw interface{}) error {
+	var n int64
+	f, err := toFloat(raw)
+	if err == nil && f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+		n = int64(f)
+	} else {
+		var parseErr error
+		n, parseErr = parseIntString(raw)
+		if parseErr != nil {
+			if err == nil && f == math.Trunc(f) {
+				return overflow(raw, v)
+			}
+			return mismatch(raw, v)
+		}
+	}
+	if v.OverflowInt(n) {
+		return overflow(raw, v)
+	}
+	v.SetInt(n)
+	return nil
+}
+
+// setUint stores raw in an unsigned integer field.
+func setUint(v reflect.Value, raw interface{}) error {
+	f, err := toFloat(raw)
+	if err != nil || f != math.Trunc(f) || f < 0 {
+		return mismatch(raw, v)
+	}
+	if f >= math.MaxUint64 {
+		return overflow(raw, v)
+	}
+	n := uint64(f)
+	if v.OverflowUint(n) {
+		return overflow(raw, v)
+	}
+	v.SetUint(n)
+	return nil
+}
+
+// setFloat stores raw in a float field.
+func setFloat(v reflect.Value, raw interface{}) error {
+	f, err := toFloat(raw)
+	if err != nil {
+		return mismatch(raw, v)
+	}
+	v.SetFloat(f)
+	return nil
+}
+
+// setTime parses raw into a time.Time field.
+func setTime(v reflect.Value, raw interface{}) error {
+	s, ok := raw.(string)
+	if !ok {
+		return mismatch(raw, v)
+	}
+	if s == "" {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			v.Set(reflect.ValueOf(t))
+			return nil
+		}
+	}
+	return mismatch(raw, v)
+}
+
+// toFloat converts a JSON number or numeric string to float64.
+func toFloat(raw interface{}) (float64, error) {
+	switch x := raw.(type) {
+	case float64:
+		return x, nil
+	case json.Number:
+		return x.Float64()
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(x), 64)
+	}
+	return 0, fmt.Errorf("%w: %T is not a number", ErrInvalidValue, raw)
+}
+
+// parseIntString parses integers too large to be represented exactly as
+// float64.
+func parseIntString(raw interface{}) (int64, error) {
+	switch x := raw.(type) {
+	case json.Number:
+		return x.Int64()
+	case string:
+		return strconv.ParseInt(strings.TrimSpace(x), 10, 64)
+	}
+	return 0, fmt.Errorf("%w: %T is not an integer", ErrInvalidValue, raw)
+}
+
+// overflow returns the error for a number out of the range of field v.
+func overflow(raw interface{}, v reflect.Value) error {
+	return fmt.Errorf("%w: %v overflows %s", ErrInvalidValue, raw, v.Type())
+}
+
+// mismatch returns the error for a value that does not fit field v.
+func mismatch(raw interface{}, v reflect.Value) error {
+	return fmt.Errorf("%w: cannot convert %T %v to %s", ErrInvalidValue, raw, raw, v.Type())
+}
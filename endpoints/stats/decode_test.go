@@ -0,0 +1,225 @@
+package stats
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type upperString string
+
+func (s *upperString) UnmarshalText(text []byte) error {
+	*s = upperString(strings.ToUpper(string(text)))
+	return nil
+}
+
+type teamInfo struct {
+	TeamID           int64 `nba:"TEAM_ID"`
+	TeamAbbreviation string
+}
+
+type gameLogRow struct {
+	teamInfo
+	PlayerID  int       `nba:"Player_ID"`
+	GameID    string    `nba:"Game_ID"`
+	GameDate  time.Time `nba:"GAME_DATE"`
+	Win       bool      `nba:"WL"`
+	Pts       float64   `nba:"PTS"`
+	PlusMinus *int      `nba:"PLUS_MINUS"`
+	Matchup   upperString
+	VideoURL  string `nba:"VIDEO_URL,optional"`
+	Ignored   string `nba:"-"`
+}
+
+func newGameLog(t *testing.T) *ResultSet {
+	t.Helper()
+	var rs ResultSet
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "PlayerGameLog",
+		"headers": ["Player_ID", "Game_ID", "GAME_DATE", "TEAM_ID", "TEAM_ABBREVIATION", "MATCHUP", "WL", "PTS", "PLUS_MINUS"],
+		"rowSet": [
+			[2544, "0022300061", "OCT 24, 2023", 1610612747, "LAL", "lal @ den", "L", 21, -12],
+			[2544, "0022300078", "2023-10-26T00:00:00", 1610612747, "LAL", "lal vs. phx", "W", 21.0, null]
+		]
+	}`), &rs))
+	return &rs
+}
+
+func TestResultSet_Decode(t *testing.T) {
+	var rows []gameLogRow
+	require.NoError(t, newGameLog(t).Decode(&rows))
+	require.Len(t, rows, 2)
+
+	first := rows[0]
+	assert.Equal(t, 2544, first.PlayerID)
+	assert.Equal(t, "0022300061", first.GameID)
+	assert.Equal(t, time.Date(2023, time.October, 24, 0, 0, 0, 0, time.UTC), first.GameDate)
+	assert.Equal(t, int64(1610612747), first.TeamID)
+	assert.Equal(t, "LAL", first.TeamAbbreviation)
+	assert.Equal(t, upperString("LAL @ DEN"), first.Matchup)
+	assert.False(t, first.Win)
+	assert.Equal(t, 21.0, first.Pts)
+	require.NotNil(t, first.PlusMinus)
+	assert.Equal(t, -12, *first.PlusMinus)
+
+	second := rows[1]
+	assert.True(t, second.Win)
+	assert.Equal(t, time.Date(2023, time.October, 26, 0, 0, 0, 0, time.UTC), second.GameDate)
+	assert.Nil(t, second.PlusMinus)
+}
+
+func TestResultSet_Decode_Pointers(t *testing.T) {
+	var rows []*gameLogRow
+	require.NoError(t, newGameLog(t).Decode(&rows))
+	require.Len(t, rows, 2)
+	assert.Equal(t, "0022300078", rows[1].GameID)
+}
+
+func TestResultSet_DecodeStrict(t *testing.T) {
+	var rows []gameLogRow
+	require.NoError(t, newGameLog(t).DecodeStrict(&rows), "VIDEO_URL is optional")
+
+	rs := newGameLog(t)
+	rs.Headers = append(rs.Headers, "FANTASY_PTS")
+	assert.ErrorIs(t, rs.DecodeStrict(&rows), ErrUnknownColumn)
+	assert.NoError(t, rs.Decode(&rows))
+
+	rs = newGameLog(t)
+	rs.Headers = rs.Headers[:len(rs.Headers)-1]
+	assert.ErrorIs(t, rs.DecodeStrict(&rows), ErrMissingColumn)
+}
+
+func TestResultSet_Decode_Errors(t *testing.T) {
+	rs := newGameLog(t)
+
+	var notSlice gameLogRow
+	assert.ErrorIs(t, rs.Decode(&notSlice), ErrInvalidTarget)
+	var notStruct []int
+	assert.ErrorIs(t, rs.Decode(&notStruct), ErrInvalidTarget)
+	assert.ErrorIs(t, rs.Decode(nil), ErrInvalidTarget)
+
+	rs.RowSet[1][7] = 21.5
+	var rows []struct {
+		Pts int `nba:"PTS"`
+	}
+	err := rs.Decode(&rows)
+	assert.ErrorIs(t, err, ErrInvalidValue)
+	assert.Contains(t, err.Error(), "row 1 column PTS")
+}
+
+func TestResultSet_Decode_IntegerRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     interface{}
+		want    int64
+		wantErr string
+	}{
+		{name: "float", raw: 21.0, want: 21},
+		{name: "negative float", raw: -3.0, want: -3},
+		{name: "exact string", raw: "9223372036854775807", want: math.MaxInt64},
+		{name: "fraction", raw: 21.5, wantErr: "cannot convert"},
+		{name: "above int64", raw: 1e19, wantErr: "overflows"},
+		{name: "max int64 as float", raw: float64(math.MaxInt64), wantErr: "overflows"},
+		{name: "below int64", raw: -1e19, wantErr: "overflows"},
+		{name: "infinity", raw: math.Inf(1), wantErr: "overflows"},
+		{name: "NaN", raw: math.NaN(), wantErr: "cannot convert"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := ResultSet{Headers: []string{"N"}, RowSet: [][]interface{}{{tt.raw}}}
+			var rows []struct {
+				N int64 `nba:"N"`
+			}
+			err := rs.Decode(&rows)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidValue)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rows[0].N)
+		})
+	}
+}
+
+func TestResultSet_Decode_UnsignedRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     interface{}
+		want    uint8
+		wantErr string
+	}{
+		{name: "float", raw: 255.0, want: 255},
+		{name: "above uint8", raw: 256.0, wantErr: "overflows"},
+		{name: "above uint64", raw: 1e20, wantErr: "overflows"},
+		{name: "negative", raw: -1.0, wantErr: "cannot convert"},
+		{name: "fraction", raw: 1.5, wantErr: "cannot convert"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := ResultSet{Headers: []string{"N"}, RowSet: [][]interface{}{{tt.raw}}}
+			var rows []struct {
+				N uint8 `nba:"N"`
+			}
+			err := rs.Decode(&rows)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, ErrInvalidValue)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rows[0].N)
+		})
+	}
+}
+
+func TestResponse_Decode(t *testing.T) {
+	var resp Response
+	require.NoError(t, json.Unmarshal([]byte(payload), &resp))
+
+	var players []struct {
+		PersonID         int `nba:"PERSON_ID"`
+		DisplayFirstLast string
+	}
+	require.NoError(t, resp.Decode("CommonAllPlayers", &players))
+	require.Len(t, players, 2)
+	assert.Equal(t, 201939, players[1].PersonID)
+	assert.Equal(t, "Stephen Curry", players[1].DisplayFirstLast)
+
+	assert.Error(t, resp.Decode("Missing", &players))
+
+	var missing []struct{}
+	players = nil
+	require.NoError(t, resp.DecodeAll(map[string]interface{}{
+		"CommonAllPlayers": &players,
+		"Missing":          &missing,
+	}))
+	assert.Len(t, players, 2)
+	assert.Nil(t, missing)
+}
+
+func BenchmarkResultSet_Decode(b *testing.B) {
+	var rs ResultSet
+	_ = json.Unmarshal([]byte(`{
+		"headers": ["Player_ID", "Game_ID", "GAME_DATE", "TEAM_ID", "TEAM_ABBREVIATION", "MATCHUP", "WL", "PTS", "PLUS_MINUS"],
+		"rowSet": [[2544, "0022300061", "OCT 24, 2023", 1610612747, "LAL", "LAL @ DEN", "L", 21, -12]]
+	}`), &rs)
+	for len(rs.RowSet) < 82 {
+		rs.RowSet = append(rs.RowSet, rs.RowSet[0])
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var rows []gameLogRow
+		if err := rs.Decode(&rows); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package stats
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const payload = `{
+	"resource": "commonallplayers",
+	"parameters": {"Season": "2023-24"},
+	"resultSets": [{
+		"name": "CommonAllPlayers",
+		"headers": ["PERSON_ID", "DISPLAY_FIRST_LAST"],
+		"rowSet": [[2544, "LeBron James"], [201939, "Stephen Curry"]]
+	}]
+}`
+
+func TestResponse(t *testing.T) {
+	var resp Response
+	require.NoError(t, json.Unmarshal([]byte(payload), &resp))
+
+	rs, err := resp.GetDataSet("CommonAllPlayers")
+	require.NoError(t, err)
+	assert.Equal(t, 2, rs.RowCount())
+
+	rows := rs.ToMap()
+	require.Len(t, rows, 2)
+	assert.Equal(t, "Stephen Curry", rows[1]["DISPLAY_FIRST_LAST"])
+
+	row, err := rs.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, float64(2544), row["PERSON_ID"])
+
+	_, err = rs.GetRow(2)
+	assert.Error(t, err)
+
+	data, err := rs.ToJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"PERSON_ID": 2544, "DISPLAY_FIRST_LAST": "LeBron James"}, {"PERSON_ID": 201939, "DISPLAY_FIRST_LAST": "Stephen Curry"}]`, data)
+
+	_, err = resp.GetDataSet("Missing")
+	assert.Error(t, err)
+}
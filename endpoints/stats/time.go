@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+// eastern is the zone of the Eastern times of the V3 endpoints. The embedded
+// time/tzdata keeps it available without a system tz database.
+var eastern = mustLoadLocation("America/New_York")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load location %s: %v", name, err))
+	}
+	return loc
+}
+
+// ParseUTCTime parses a UTC time of the V3 endpoints such as the gameTimeUTC
+// field, e.g. "2023-10-25T00:30:00Z". An empty string is the zero time.
+func ParseUTCTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", value)
+	}
+	return t, nil
+}
+
+// ParseEasternTime parses an Eastern time of the V3 endpoints such as the
+// gameEt and gameDateTimeEst fields. They hold the Eastern wall clock but are
+// sent with a "Z" suffix, e.g. "2023-10-24T20:30:00Z" for 8:30 pm ET.
+//
+// The wall clock is read in America/New_York and the result is in that zone.
+// utc is the same instant in UTC when the response has one; if it is not
+// zero and does not match the wall clock, an error is returned. Times with a
+// real offset, e.g. "2023-10-24T20:30:00-04:00", are returned as they are.
+func ParseEasternTime(value string, utc time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if !strings.HasSuffix(value, "Z") {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t, nil
+		}
+	}
+
+	wall, err := time.Parse("2006-01-02T15:04:05", strings.TrimSuffix(value, "Z"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", value)
+	}
+	if !utc.IsZero() {
+		// The UTC time settles the wall clocks that occur twice when
+		// daylight saving time ends.
+		t := utc.In(eastern)
+		if !sameWallClock(t, wall) {
+			return time.Time{}, fmt.Errorf("eastern time %q does not match %s", value, utc.UTC().Format(time.RFC3339))
+		}
+		return t, nil
+	}
+
+	t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), eastern)
+	if !sameWallClock(t, wall) {
+		return time.Time{}, fmt.Errorf("eastern time %q does not exist", value)
+	}
+	return t, nil
+}
+
+// InEastern returns t in America/New_York, or the zero time if t is zero.
+// It is the fallback for Eastern times that fail to parse.
+func InEastern(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	return t.In(eastern)
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	y, m, d := t.Date()
+	wy, wm, wd := wall.Date()
+	return y == wy && m == wm && d == wd &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() &&
+		t.Second() == wall.Second() && t.Nanosecond() == wall.Nanosecond()
+}
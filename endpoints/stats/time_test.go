@@ -0,0 +1,72 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUTCTime(t *testing.T) {
+	got, err := ParseUTCTime("2023-10-25T00:30:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, time.October, 25, 0, 30, 0, 0, time.UTC), got)
+
+	got, err = ParseUTCTime("")
+	require.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	_, err = ParseUTCTime("10/25/2023")
+	assert.Error(t, err)
+}
+
+func TestParseEasternTime(t *testing.T) {
+	utc := time.Date(2023, time.October, 25, 0, 30, 0, 0, time.UTC)
+
+	got, err := ParseEasternTime("2023-10-24T20:30:00Z", utc)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(utc))
+	assert.Equal(t, "2023-10-24 20:30 EDT", got.Format("2006-01-02 15:04 MST"))
+
+	winter := time.Date(2024, time.January, 15, 20, 0, 0, 0, time.UTC)
+	got, err = ParseEasternTime("2024-01-15T15:00:00Z", winter)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(winter))
+	assert.Equal(t, "EST", got.Format("MST"))
+
+	got, err = ParseEasternTime("2023-10-24T20:30:00Z", time.Time{})
+	require.NoError(t, err)
+	assert.True(t, got.Equal(utc), "converted in America/New_York without a UTC time")
+	assert.Equal(t, "EDT", got.Format("MST"))
+
+	got, err = ParseEasternTime("2024-01-15T15:00:00Z", time.Time{})
+	require.NoError(t, err)
+	assert.True(t, got.Equal(winter))
+
+	_, err = ParseEasternTime("2024-03-10T02:30:00Z", time.Time{})
+	assert.Error(t, err, "skipped by daylight saving time")
+
+	_, err = ParseEasternTime("2023-10-24T20:30:00Z", utc.Add(time.Hour))
+	assert.Error(t, err, "wall clock does not match the UTC time")
+
+	_, err = ParseEasternTime("2023-10-24T20:30:00Z", utc.Add(-4*time.Hour))
+	assert.Error(t, err, "the wall clock labelled as UTC")
+
+	got, err = ParseEasternTime("2023-10-24T20:30:00-04:00", time.Time{})
+	require.NoError(t, err)
+	assert.True(t, got.Equal(utc))
+
+	_, err = ParseEasternTime("Oct 24", utc)
+	assert.Error(t, err)
+}
+
+func TestInEastern(t *testing.T) {
+	utc := time.Date(2023, time.October, 24, 23, 30, 0, 0, time.UTC)
+
+	got := InEastern(utc)
+	assert.True(t, got.Equal(utc))
+	assert.Equal(t, "19:30 EDT", got.Format("15:04 MST"))
+
+	assert.True(t, InEastern(time.Time{}).IsZero())
+}
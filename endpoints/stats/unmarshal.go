@@ -0,0 +1,152 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// UnmarshalJSON decodes a response in any of the shapes used by the API:
+// the usual "resultSets" array, a single "resultSet" object as returned by
+// leagueleaders, and a "resultSets" object holding one result set. All of
+// them end up in ResultSets.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Resource   string          `json:"resource"`
+		Parameters interface{}     `json:"parameters"`
+		ResultSets json.RawMessage `json:"resultSets"`
+		ResultSet  json.RawMessage `json:"resultSet"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	sets, err := decodeResultSets(raw.ResultSets)
+	if err != nil {
+		return fmt.Errorf("failed to decode resultSets: %w", err)
+	}
+	single, err := decodeResultSets(raw.ResultSet)
+	if err != nil {
+		return fmt.Errorf("failed to decode resultSet: %w", err)
+	}
+
+	r.Resource = raw.Resource
+	r.Parameters = raw.Parameters
+	r.ResultSets = append(sets, single...)
+	return nil
+}
+
+// decodeResultSets decodes an array of result sets or a single result set
+// object.
+func decodeResultSets(data json.RawMessage) ([]ResultSet, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	if data[0] == '{' {
+		var rs ResultSet
+		if err := json.Unmarshal(data, &rs); err != nil {
+			return nil, err
+		}
+		return []ResultSet{rs}, nil
+	}
+
+	var sets []ResultSet
+	if err := json.Unmarshal(data, &sets); err != nil {
+		return nil, err
+	}
+	return sets, nil
+}
+
+// headerGroup is an element of grouped result set headers. Endpoints such
+// as leaguedashplayershotlocations label spans of columns, e.g. each zone's
+// FGM, FGA and FG_PCT, with a group before the plain column names:
+//
+//	"headers": [
+//	  {"name": "SHOT_CATEGORY", "columnsToSkip": 6, "columnSpan": 3,
+//	   "columnNames": ["Restricted Area", "In The Paint (Non-RA)", ...]},
+//	  {"name": "columns", "columnSpan": 1,
+//	   "columnNames": ["PLAYER_ID", ..., "FGM", "FGA", "FG_PCT", "FGM", ...]}
+//	]
+type headerGroup struct {
+	Name          string   `json:"name"`
+	ColumnsToSkip int      `json:"columnsToSkip"`
+	ColumnSpan    int      `json:"columnSpan"`
+	ColumnNames   []string `json:"columnNames"`
+}
+
+// UnmarshalJSON decodes a result set with plain or grouped headers. Grouped
+// headers are flattened by prefixing each column with its group label, so
+// the FGM column of "Restricted Area" becomes RESTRICTED_AREA_FGM.
+func (rs *ResultSet) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Name    string          `json:"name"`
+		Headers json.RawMessage `json:"headers"`
+		RowSet  [][]interface{} `json:"rowSet"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	headers, err := decodeHeaders(raw.Headers)
+	if err != nil {
+		return fmt.Errorf("failed to decode headers of %s: %w", raw.Name, err)
+	}
+
+	rs.Name = raw.Name
+	rs.Headers = headers
+	rs.RowSet = raw.RowSet
+	return nil
+}
+
+// decodeHeaders decodes plain headers or flattens grouped ones.
+func decodeHeaders(data json.RawMessage) ([]string, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	var headers []string
+	if err := json.Unmarshal(data, &headers); err == nil {
+		return headers, nil
+	}
+
+	var groups []headerGroup
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	columns := groups[len(groups)-1].ColumnNames
+	headers = append([]string(nil), columns...)
+	for _, group := range groups[:len(groups)-1] {
+		span := group.ColumnSpan
+		if span < 1 {
+			span = 1
+		}
+		for i, label := range group.ColumnNames {
+			prefix := columnLabel(label)
+			for j := 0; j < span; j++ {
+				col := group.ColumnsToSkip + i*span + j
+				if col < len(headers) {
+					headers[col] = prefix + "_" + headers[col]
+				}
+			}
+		}
+	}
+	return headers, nil
+}
+
+// columnLabel turns a group label such as "In The Paint (Non-RA)" into
+// IN_THE_PAINT_NON_RA.
+func columnLabel(label string) string {
+	words := strings.FieldsFunc(label, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.ToUpper(strings.Join(words, "_"))
+}
@@ -0,0 +1,93 @@
+package stats
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponse_UnmarshalJSON_SingleResultSet(t *testing.T) {
+	payload := `{
+		"resource": "leagueleaders",
+		"parameters": {"LeagueID": "00", "PerMode": "PerGame", "StatCategory": "PTS", "Season": "2023-24", "SeasonType": "Regular Season", "Scope": "S", "ActiveFlag": null},
+		"resultSet": {
+			"name": "LeagueLeaders",
+			"headers": ["PLAYER_ID", "RANK", "PLAYER", "TEAM_ID", "TEAM", "GP", "MIN", "FGM", "FGA", "FG_PCT", "FG3M", "FG3A", "FG3_PCT", "FTM", "FTA", "FT_PCT", "OREB", "DREB", "REB", "AST", "STL", "BLK", "TOV", "PTS", "EFF"],
+			"rowSet": [
+				[1629029, 1, "Luka Doncic", 1610612742, "DAL", 70, 37.5, 11.5, 23.6, 0.487, 4.1, 10.6, 0.382, 6.1, 7.8, 0.786, 0.8, 8.4, 9.2, 9.8, 1.4, 0.5, 4.0, 33.9, 35.6],
+				[203507, 2, "Giannis Antetokounmpo", 1610612749, "MIL", 73, 35.2, 11.5, 18.8, 0.611, 0.5, 1.7, 0.274, 7.0, 10.6, 0.657, 2.7, 8.8, 11.5, 6.5, 1.2, 1.1, 3.4, 30.4, 35.4]
+			]
+		}
+	}`
+
+	var resp Response
+	require.NoError(t, json.Unmarshal([]byte(payload), &resp))
+
+	assert.Equal(t, "leagueleaders", resp.Resource)
+	require.Len(t, resp.ResultSets, 1)
+	rs, err := resp.GetDataSet("LeagueLeaders")
+	require.NoError(t, err)
+	assert.Equal(t, 2, rs.RowCount())
+
+	var leaders []struct {
+		PlayerID int    `nba:"PLAYER_ID"`
+		Player   string `nba:"PLAYER"`
+		Pts      float64
+	}
+	require.NoError(t, rs.Decode(&leaders))
+	assert.Equal(t, "Luka Doncic", leaders[0].Player)
+	assert.Equal(t, 33.9, leaders[0].Pts)
+}
+
+func TestResponse_UnmarshalJSON_ResultSetsObject(t *testing.T) {
+	payload := `{
+		"resource": "leaguedashplayershotlocations",
+		"parameters": {"Season": "2023-24", "DistanceRange": "By Zone"},
+		"resultSets": {
+			"name": "ShotLocations",
+			"headers": [
+				{"name": "SHOT_CATEGORY", "columnsToSkip": 5, "columnSpan": 3,
+				 "columnNames": ["Restricted Area", "In The Paint (Non-RA)"]},
+				{"name": "columns", "columnSpan": 1,
+				 "columnNames": ["PLAYER_ID", "PLAYER_NAME", "TEAM_ID", "TEAM_ABBREVIATION", "AGE", "FGM", "FGA", "FG_PCT", "FGM", "FGA", "FG_PCT"]}
+			],
+			"rowSet": [
+				[2544, "LeBron James", 1610612747, "LAL", 39.0, 5.2, 6.7, 0.776, 1.3, 2.9, 0.448]
+			]
+		}
+	}`
+
+	var resp Response
+	require.NoError(t, json.Unmarshal([]byte(payload), &resp))
+
+	require.Len(t, resp.ResultSets, 1)
+	rs := resp.ResultSets[0]
+	assert.Equal(t, "ShotLocations", rs.Name)
+	assert.Equal(t, []string{
+		"PLAYER_ID", "PLAYER_NAME", "TEAM_ID", "TEAM_ABBREVIATION", "AGE",
+		"RESTRICTED_AREA_FGM", "RESTRICTED_AREA_FGA", "RESTRICTED_AREA_FG_PCT",
+		"IN_THE_PAINT_NON_RA_FGM", "IN_THE_PAINT_NON_RA_FGA", "IN_THE_PAINT_NON_RA_FG_PCT",
+	}, rs.Headers)
+
+	row, err := rs.GetRow(0)
+	require.NoError(t, err)
+	assert.Equal(t, 0.776, row["RESTRICTED_AREA_FG_PCT"])
+	assert.Equal(t, 0.448, row["IN_THE_PAINT_NON_RA_FG_PCT"])
+}
+
+func TestResponse_UnmarshalJSON_ResultSetsArray(t *testing.T) {
+	var resp Response
+	require.NoError(t, json.Unmarshal([]byte(payload), &resp))
+
+	require.Len(t, resp.ResultSets, 1)
+	assert.Equal(t, []string{"PERSON_ID", "DISPLAY_FIRST_LAST"}, resp.ResultSets[0].Headers)
+	assert.Equal(t, map[string]interface{}{"Season": "2023-24"}, resp.Parameters)
+
+	var empty Response
+	require.NoError(t, json.Unmarshal([]byte(`{"resource": "boxscoresummaryv3", "parameters": {}}`), &empty))
+	assert.Empty(t, empty.ResultSets)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"resultSets": "invalid"}`), &empty))
+}
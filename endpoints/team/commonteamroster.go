@@ -23,25 +23,13 @@ func (c *Client) GetCommonTeamRoster(ctx context.Context, params CommonTeamRoste
 		"LeagueID": params.LeagueIdNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "commonteamroster", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "commonteamroster", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch commonteamroster",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch commonteamroster: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from commonteamroster endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal commonteamroster response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched commonteamroster",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
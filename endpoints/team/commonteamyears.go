@@ -19,25 +19,13 @@ func (c *Client) GetCommonTeamYears(ctx context.Context, params CommonTeamYearsP
 		"LeagueID": params.LeagueId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "commonteamyears", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "commonteamyears", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch commonteamyears",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch commonteamyears: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from commonteamyears endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal commonteamyears response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched commonteamyears",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -133,25 +133,13 @@ func (c *Client) GetTeamDashboardByGeneralSplits(ctx context.Context, params Tea
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamdashboardbygeneralsplits", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamdashboardbygeneralsplits", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamdashboardbygeneralsplits",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamdashboardbygeneralsplits: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamdashboardbygeneralsplits endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamdashboardbygeneralsplits response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamdashboardbygeneralsplits",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -133,25 +133,13 @@ func (c *Client) GetTeamDashboardByShootingSplits(ctx context.Context, params Te
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamdashboardbyshootingsplits", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamdashboardbyshootingsplits", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamdashboardbyshootingsplits",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamdashboardbyshootingsplits: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamdashboardbyshootingsplits endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamdashboardbyshootingsplits response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamdashboardbyshootingsplits",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -67,25 +67,13 @@ func (c *Client) GetTeamDashLineups(ctx context.Context, params TeamDashLineupsP
 		"VsDivision": params.VsDivisionNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamdashlineups", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamdashlineups", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamdashlineups",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamdashlineups: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamdashlineups endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamdashlineups response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamdashlineups",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
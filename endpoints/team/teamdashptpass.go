@@ -93,25 +93,13 @@ func (c *Client) GetTeamDashPtPass(ctx context.Context, params TeamDashPtPassPar
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamdashptpass", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamdashptpass", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamdashptpass",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamdashptpass: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamdashptpass endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamdashptpass response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamdashptpass",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -103,25 +103,13 @@ func (c *Client) GetTeamDashPtReb(ctx context.Context, params TeamDashPtRebParam
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamdashptreb", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamdashptreb", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamdashptreb",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamdashptreb: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamdashptreb endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamdashptreb response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamdashptreb",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
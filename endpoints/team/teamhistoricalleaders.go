@@ -23,25 +23,13 @@ func (c *Client) GetTeamHistoricalLeaders(ctx context.Context, params TeamHistor
 		"SeasonID": params.SeasonId,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamhistoricalleaders", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamhistoricalleaders", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamhistoricalleaders",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamhistoricalleaders: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamhistoricalleaders endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamhistoricalleaders response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamhistoricalleaders",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -25,25 +25,13 @@ func (c *Client) GetTeamInfoCommon(ctx context.Context, params TeamInfoCommonPar
 		"SeasonType": params.SeasonTypeNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teaminfocommon", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teaminfocommon", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teaminfocommon",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teaminfocommon: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teaminfocommon endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teaminfocommon response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teaminfocommon",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
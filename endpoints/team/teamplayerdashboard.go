@@ -133,25 +133,13 @@ func (c *Client) GetTeamPlayerDashboard(ctx context.Context, params TeamPlayerDa
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamplayerdashboard", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamplayerdashboard", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamplayerdashboard",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamplayerdashboard: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamplayerdashboard endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamplayerdashboard response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamplayerdashboard",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
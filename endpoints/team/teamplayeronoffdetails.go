@@ -125,25 +125,13 @@ func (c *Client) GetTeamPlayerOnOffDetails(ctx context.Context, params TeamPlaye
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamplayeronoffdetails", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamplayeronoffdetails", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamplayeronoffdetails",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamplayeronoffdetails: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamplayeronoffdetails endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamplayeronoffdetails response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamplayeronoffdetails",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
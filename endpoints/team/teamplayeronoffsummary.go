@@ -125,25 +125,13 @@ func (c *Client) GetTeamPlayerOnOffSummary(ctx context.Context, params TeamPlaye
 		reqParams["VsDivision"] = params.VsDivisionNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamplayeronoffsummary", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamplayeronoffsummary", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamplayeronoffsummary",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamplayeronoffsummary: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamplayeronoffsummary endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamplayeronoffsummary response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamplayeronoffsummary",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -39,25 +39,13 @@ func (c *Client) GetTeamYearByYearStats(ctx context.Context, params TeamYearByYe
 		"SeasonType": seasonType,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "teamyearbyyearstats", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "teamyearbyyearstats", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch teamyearbyyearstats",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch teamyearbyyearstats: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from teamyearbyyearstats endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal teamyearbyyearstats response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched teamyearbyyearstats",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
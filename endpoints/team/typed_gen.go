@@ -0,0 +1,1938 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package team
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CommonTeamRosterResult holds the decoded result sets of the
+// commonteamroster endpoint.
+type CommonTeamRosterResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Coaches          []CommonTeamRosterCoachesRow
+	CommonTeamRoster []CommonTeamRosterRow
+}
+
+// GetCommonTeamRosterTyped is like GetCommonTeamRoster but decodes the result
+// sets into a CommonTeamRosterResult.
+func (c *Client) GetCommonTeamRosterTyped(ctx context.Context, params CommonTeamRosterParams) (*CommonTeamRosterResult, error) {
+	resp, err := c.GetCommonTeamRoster(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CommonTeamRosterResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Coaches":          &result.Coaches,
+		"CommonTeamRoster": &result.CommonTeamRoster,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode commonteamroster: %w", err)
+	}
+	return result, nil
+}
+
+// CommonTeamYearsResult holds the decoded result sets of the commonteamyears
+// endpoint.
+type CommonTeamYearsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	TeamYears []CommonTeamYearsTeamYearsRow
+}
+
+// GetCommonTeamYearsTyped is like GetCommonTeamYears but decodes the result
+// sets into a CommonTeamYearsResult.
+func (c *Client) GetCommonTeamYearsTyped(ctx context.Context, params CommonTeamYearsParams) (*CommonTeamYearsResult, error) {
+	resp, err := c.GetCommonTeamYears(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CommonTeamYearsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"TeamYears": &result.TeamYears,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode commonteamyears: %w", err)
+	}
+	return result, nil
+}
+
+// TeamDashLineupsResult holds the decoded result sets of the teamdashlineups
+// endpoint.
+type TeamDashLineupsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	Lineups []TeamDashLineupsLineupsRow
+	Overall []TeamDashLineupsOverallRow
+}
+
+// GetTeamDashLineupsTyped is like GetTeamDashLineups but decodes the result
+// sets into a TeamDashLineupsResult.
+func (c *Client) GetTeamDashLineupsTyped(ctx context.Context, params TeamDashLineupsParams) (*TeamDashLineupsResult, error) {
+	resp, err := c.GetTeamDashLineups(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamDashLineupsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"Lineups": &result.Lineups,
+		"Overall": &result.Overall,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamdashlineups: %w", err)
+	}
+	return result, nil
+}
+
+// TeamDashPtPassResult holds the decoded result sets of the teamdashptpass
+// endpoint.
+type TeamDashPtPassResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PassesMade     []TeamDashPtPassPassesMadeRow
+	PassesReceived []TeamDashPtPassPassesReceivedRow
+}
+
+// GetTeamDashPtPassTyped is like GetTeamDashPtPass but decodes the result
+// sets into a TeamDashPtPassResult.
+func (c *Client) GetTeamDashPtPassTyped(ctx context.Context, params TeamDashPtPassParams) (*TeamDashPtPassResult, error) {
+	resp, err := c.GetTeamDashPtPass(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamDashPtPassResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PassesMade":     &result.PassesMade,
+		"PassesReceived": &result.PassesReceived,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamdashptpass: %w", err)
+	}
+	return result, nil
+}
+
+// TeamDashPtRebResult holds the decoded result sets of the teamdashptreb
+// endpoint.
+type TeamDashPtRebResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	NumContestedRebounding []TeamDashPtRebNumContestedReboundingRow
+	OverallRebounding      []TeamDashPtRebOverallReboundingRow
+	RebDistanceRebounding  []TeamDashPtRebRebDistanceReboundingRow
+	ShotDistanceRebounding []TeamDashPtRebShotDistanceReboundingRow
+	ShotTypeRebounding     []TeamDashPtRebShotTypeReboundingRow
+}
+
+// GetTeamDashPtRebTyped is like GetTeamDashPtReb but decodes the result sets
+// into a TeamDashPtRebResult.
+func (c *Client) GetTeamDashPtRebTyped(ctx context.Context, params TeamDashPtRebParams) (*TeamDashPtRebResult, error) {
+	resp, err := c.GetTeamDashPtReb(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamDashPtRebResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"NumContestedRebounding": &result.NumContestedRebounding,
+		"OverallRebounding":      &result.OverallRebounding,
+		"RebDistanceRebounding":  &result.RebDistanceRebounding,
+		"ShotDistanceRebounding": &result.ShotDistanceRebounding,
+		"ShotTypeRebounding":     &result.ShotTypeRebounding,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamdashptreb: %w", err)
+	}
+	return result, nil
+}
+
+// TeamDashboardByGeneralSplitsResult holds the decoded result sets of the
+// teamdashboardbygeneralsplits endpoint.
+type TeamDashboardByGeneralSplitsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	DaysRestTeamDashboard       []TeamDashboardByGeneralSplitsDaysRestTeamDashboardRow
+	LocationTeamDashboard       []TeamDashboardByGeneralSplitsLocationTeamDashboardRow
+	MonthTeamDashboard          []TeamDashboardByGeneralSplitsMonthTeamDashboardRow
+	OverallTeamDashboard        []TeamDashboardByGeneralSplitsOverallTeamDashboardRow
+	PrePostAllStarTeamDashboard []TeamDashboardByGeneralSplitsPrePostAllStarTeamDashboardRow
+	WinsLossesTeamDashboard     []TeamDashboardByGeneralSplitsWinsLossesTeamDashboardRow
+}
+
+// GetTeamDashboardByGeneralSplitsTyped is like
+// GetTeamDashboardByGeneralSplits but decodes the result sets into a
+// TeamDashboardByGeneralSplitsResult.
+func (c *Client) GetTeamDashboardByGeneralSplitsTyped(ctx context.Context, params TeamDashboardByGeneralSplitsParams) (*TeamDashboardByGeneralSplitsResult, error) {
+	resp, err := c.GetTeamDashboardByGeneralSplits(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamDashboardByGeneralSplitsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"DaysRestTeamDashboard":       &result.DaysRestTeamDashboard,
+		"LocationTeamDashboard":       &result.LocationTeamDashboard,
+		"MonthTeamDashboard":          &result.MonthTeamDashboard,
+		"OverallTeamDashboard":        &result.OverallTeamDashboard,
+		"PrePostAllStarTeamDashboard": &result.PrePostAllStarTeamDashboard,
+		"WinsLossesTeamDashboard":     &result.WinsLossesTeamDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamdashboardbygeneralsplits: %w", err)
+	}
+	return result, nil
+}
+
+// TeamDashboardByShootingSplitsResult holds the decoded result sets of the
+// teamdashboardbyshootingsplits endpoint.
+type TeamDashboardByShootingSplitsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	AssistedBy               []TeamDashboardByShootingSplitsAssistedByRow
+	AssitedShotTeamDashboard []TeamDashboardByShootingSplitsTeamDashboardRow
+	OverallTeamDashboard     []TeamDashboardByShootingSplitsTeamDashboardRow
+	Shot5FTTeamDashboard     []TeamDashboardByShootingSplitsTeamDashboardRow
+	Shot8FTTeamDashboard     []TeamDashboardByShootingSplitsTeamDashboardRow
+	ShotAreaTeamDashboard    []TeamDashboardByShootingSplitsTeamDashboardRow
+	ShotTypeTeamDashboard    []TeamDashboardByShootingSplitsTeamDashboardRow
+}
+
+// GetTeamDashboardByShootingSplitsTyped is like
+// GetTeamDashboardByShootingSplits but decodes the result sets into a
+// TeamDashboardByShootingSplitsResult.
+func (c *Client) GetTeamDashboardByShootingSplitsTyped(ctx context.Context, params TeamDashboardByShootingSplitsParams) (*TeamDashboardByShootingSplitsResult, error) {
+	resp, err := c.GetTeamDashboardByShootingSplits(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamDashboardByShootingSplitsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"AssistedBy":               &result.AssistedBy,
+		"AssitedShotTeamDashboard": &result.AssitedShotTeamDashboard,
+		"OverallTeamDashboard":     &result.OverallTeamDashboard,
+		"Shot5FTTeamDashboard":     &result.Shot5FTTeamDashboard,
+		"Shot8FTTeamDashboard":     &result.Shot8FTTeamDashboard,
+		"ShotAreaTeamDashboard":    &result.ShotAreaTeamDashboard,
+		"ShotTypeTeamDashboard":    &result.ShotTypeTeamDashboard,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamdashboardbyshootingsplits: %w", err)
+	}
+	return result, nil
+}
+
+// TeamDetailsResult holds the decoded result sets of the teamdetails
+// endpoint.
+type TeamDetailsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	TeamAwardsChampionships []TeamDetailsTeamAwardsRow
+	TeamAwardsConf          []TeamDetailsTeamAwardsRow
+	TeamAwardsDiv           []TeamDetailsTeamAwardsRow
+	TeamBackground          []TeamDetailsTeamBackgroundRow
+	TeamHistory             []TeamDetailsTeamHistoryRow
+	TeamHof                 []TeamDetailsTeamRow
+	TeamRetired             []TeamDetailsTeamRow
+	TeamSocialSites         []TeamDetailsTeamSocialSitesRow
+}
+
+// GetTeamDetailsTyped is like GetTeamDetails but decodes the result sets into
+// a TeamDetailsResult.
+func (c *Client) GetTeamDetailsTyped(ctx context.Context, params TeamDetailsParams) (*TeamDetailsResult, error) {
+	resp, err := c.GetTeamDetails(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamDetailsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"TeamAwardsChampionships": &result.TeamAwardsChampionships,
+		"TeamAwardsConf":          &result.TeamAwardsConf,
+		"TeamAwardsDiv":           &result.TeamAwardsDiv,
+		"TeamBackground":          &result.TeamBackground,
+		"TeamHistory":             &result.TeamHistory,
+		"TeamHof":                 &result.TeamHof,
+		"TeamRetired":             &result.TeamRetired,
+		"TeamSocialSites":         &result.TeamSocialSites,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamdetails: %w", err)
+	}
+	return result, nil
+}
+
+// TeamEstimatedMetricsResult holds the decoded result sets of the
+// teamestimatedmetrics endpoint.
+type TeamEstimatedMetricsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	TeamEstimatedMetrics []TeamEstimatedMetricsRow
+}
+
+// GetTeamEstimatedMetricsTyped is like GetTeamEstimatedMetrics but decodes
+// the result sets into a TeamEstimatedMetricsResult.
+func (c *Client) GetTeamEstimatedMetricsTyped(ctx context.Context, params TeamEstimatedMetricsParams) (*TeamEstimatedMetricsResult, error) {
+	resp, err := c.GetTeamEstimatedMetrics(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamEstimatedMetricsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"TeamEstimatedMetrics": &result.TeamEstimatedMetrics,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamestimatedmetrics: %w", err)
+	}
+	return result, nil
+}
+
+// TeamHistoricalLeadersResult holds the decoded result sets of the
+// teamhistoricalleaders endpoint.
+type TeamHistoricalLeadersResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	CareerLeadersByTeam []TeamHistoricalLeadersCareerLeadersByTeamRow
+}
+
+// GetTeamHistoricalLeadersTyped is like GetTeamHistoricalLeaders but decodes
+// the result sets into a TeamHistoricalLeadersResult.
+func (c *Client) GetTeamHistoricalLeadersTyped(ctx context.Context, params TeamHistoricalLeadersParams) (*TeamHistoricalLeadersResult, error) {
+	resp, err := c.GetTeamHistoricalLeaders(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamHistoricalLeadersResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"CareerLeadersByTeam": &result.CareerLeadersByTeam,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamhistoricalleaders: %w", err)
+	}
+	return result, nil
+}
+
+// TeamInfoCommonResult holds the decoded result sets of the teaminfocommon
+// endpoint.
+type TeamInfoCommonResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	AvailableSeasons []TeamInfoCommonAvailableSeasonsRow
+	TeamInfoCommon   []TeamInfoCommonRow
+	TeamSeasonRanks  []TeamInfoCommonTeamSeasonRanksRow
+}
+
+// GetTeamInfoCommonTyped is like GetTeamInfoCommon but decodes the result
+// sets into a TeamInfoCommonResult.
+func (c *Client) GetTeamInfoCommonTyped(ctx context.Context, params TeamInfoCommonParams) (*TeamInfoCommonResult, error) {
+	resp, err := c.GetTeamInfoCommon(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamInfoCommonResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"AvailableSeasons": &result.AvailableSeasons,
+		"TeamInfoCommon":   &result.TeamInfoCommon,
+		"TeamSeasonRanks":  &result.TeamSeasonRanks,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teaminfocommon: %w", err)
+	}
+	return result, nil
+}
+
+// TeamPlayerDashboardResult holds the decoded result sets of the
+// teamplayerdashboard endpoint.
+type TeamPlayerDashboardResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	PlayersSeasonTotals []TeamPlayerDashboardPlayersSeasonTotalsRow
+	TeamOverall         []TeamPlayerDashboardTeamOverallRow
+}
+
+// GetTeamPlayerDashboardTyped is like GetTeamPlayerDashboard but decodes the
+// result sets into a TeamPlayerDashboardResult.
+func (c *Client) GetTeamPlayerDashboardTyped(ctx context.Context, params TeamPlayerDashboardParams) (*TeamPlayerDashboardResult, error) {
+	resp, err := c.GetTeamPlayerDashboard(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamPlayerDashboardResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"PlayersSeasonTotals": &result.PlayersSeasonTotals,
+		"TeamOverall":         &result.TeamOverall,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamplayerdashboard: %w", err)
+	}
+	return result, nil
+}
+
+// TeamPlayerOnOffDetailsResult holds the decoded result sets of the
+// teamplayeronoffdetails endpoint.
+type TeamPlayerOnOffDetailsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallTeamPlayerOnOffDetails         []TeamPlayerOnOffDetailsOverallTeamPlayerOnOffDetailsRow
+	PlayersOffCourtTeamPlayerOnOffDetails []TeamPlayerOnOffDetailsPlayersRow
+	PlayersOnCourtTeamPlayerOnOffDetails  []TeamPlayerOnOffDetailsPlayersRow
+}
+
+// GetTeamPlayerOnOffDetailsTyped is like GetTeamPlayerOnOffDetails but
+// decodes the result sets into a TeamPlayerOnOffDetailsResult.
+func (c *Client) GetTeamPlayerOnOffDetailsTyped(ctx context.Context, params TeamPlayerOnOffDetailsParams) (*TeamPlayerOnOffDetailsResult, error) {
+	resp, err := c.GetTeamPlayerOnOffDetails(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamPlayerOnOffDetailsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallTeamPlayerOnOffDetails":         &result.OverallTeamPlayerOnOffDetails,
+		"PlayersOffCourtTeamPlayerOnOffDetails": &result.PlayersOffCourtTeamPlayerOnOffDetails,
+		"PlayersOnCourtTeamPlayerOnOffDetails":  &result.PlayersOnCourtTeamPlayerOnOffDetails,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamplayeronoffdetails: %w", err)
+	}
+	return result, nil
+}
+
+// TeamPlayerOnOffSummaryResult holds the decoded result sets of the
+// teamplayeronoffsummary endpoint.
+type TeamPlayerOnOffSummaryResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OverallTeamPlayerOnOffSummary         []TeamPlayerOnOffSummaryOverallTeamPlayerOnOffSummaryRow
+	PlayersOffCourtTeamPlayerOnOffSummary []TeamPlayerOnOffSummaryPlayersRow
+	PlayersOnCourtTeamPlayerOnOffSummary  []TeamPlayerOnOffSummaryPlayersRow
+}
+
+// GetTeamPlayerOnOffSummaryTyped is like GetTeamPlayerOnOffSummary but
+// decodes the result sets into a TeamPlayerOnOffSummaryResult.
+func (c *Client) GetTeamPlayerOnOffSummaryTyped(ctx context.Context, params TeamPlayerOnOffSummaryParams) (*TeamPlayerOnOffSummaryResult, error) {
+	resp, err := c.GetTeamPlayerOnOffSummary(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamPlayerOnOffSummaryResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OverallTeamPlayerOnOffSummary":         &result.OverallTeamPlayerOnOffSummary,
+		"PlayersOffCourtTeamPlayerOnOffSummary": &result.PlayersOffCourtTeamPlayerOnOffSummary,
+		"PlayersOnCourtTeamPlayerOnOffSummary":  &result.PlayersOnCourtTeamPlayerOnOffSummary,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamplayeronoffsummary: %w", err)
+	}
+	return result, nil
+}
+
+// TeamVsPlayerResult holds the decoded result sets of the teamvsplayer
+// endpoint.
+type TeamVsPlayerResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	OnOffCourt           []TeamVsPlayerOnOffCourtRow
+	Overall              []TeamVsPlayerOverallRow
+	ShotAreaOffCourt     []TeamVsPlayerShotRow
+	ShotAreaOnCourt      []TeamVsPlayerShotRow
+	ShotAreaOverall      []TeamVsPlayerShotOverallRow
+	ShotDistanceOffCourt []TeamVsPlayerShotRow
+	ShotDistanceOnCourt  []TeamVsPlayerShotRow
+	ShotDistanceOverall  []TeamVsPlayerShotOverallRow
+	VsPlayerOverall      []TeamVsPlayerVsPlayerOverallRow
+}
+
+// GetTeamVsPlayerTyped is like GetTeamVsPlayer but decodes the result sets
+// into a TeamVsPlayerResult.
+func (c *Client) GetTeamVsPlayerTyped(ctx context.Context, params TeamVsPlayerParams) (*TeamVsPlayerResult, error) {
+	resp, err := c.GetTeamVsPlayer(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamVsPlayerResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"OnOffCourt":           &result.OnOffCourt,
+		"Overall":              &result.Overall,
+		"ShotAreaOffCourt":     &result.ShotAreaOffCourt,
+		"ShotAreaOnCourt":      &result.ShotAreaOnCourt,
+		"ShotAreaOverall":      &result.ShotAreaOverall,
+		"ShotDistanceOffCourt": &result.ShotDistanceOffCourt,
+		"ShotDistanceOnCourt":  &result.ShotDistanceOnCourt,
+		"ShotDistanceOverall":  &result.ShotDistanceOverall,
+		"vsPlayerOverall":      &result.VsPlayerOverall,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamvsplayer: %w", err)
+	}
+	return result, nil
+}
+
+// TeamYearByYearStatsResult holds the decoded result sets of the
+// teamyearbyyearstats endpoint.
+type TeamYearByYearStatsResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	TeamStats []TeamYearByYearStatsTeamStatsRow
+}
+
+// GetTeamYearByYearStatsTyped is like GetTeamYearByYearStats but decodes the
+// result sets into a TeamYearByYearStatsResult.
+func (c *Client) GetTeamYearByYearStatsTyped(ctx context.Context, params TeamYearByYearStatsParams) (*TeamYearByYearStatsResult, error) {
+	resp, err := c.GetTeamYearByYearStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TeamYearByYearStatsResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"TeamStats": &result.TeamStats,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode teamyearbyyearstats: %w", err)
+	}
+	return result, nil
+}
+
+// CommonTeamRosterCoachesRow is a row of the commonteamroster Coaches result
+// set.
+type CommonTeamRosterCoachesRow struct {
+	TeamID       int     `nba:"TEAM_ID"`
+	Season       string  `nba:"SEASON"`
+	CoachID      string  `nba:"COACH_ID"`
+	FirstName    string  `nba:"FIRST_NAME"`
+	LastName     string  `nba:"LAST_NAME"`
+	CoachName    string  `nba:"COACH_NAME"`
+	IsAssistant  float64 `nba:"IS_ASSISTANT"`
+	CoachType    string  `nba:"COACH_TYPE"`
+	SortSequence float64 `nba:"SORT_SEQUENCE"`
+}
+
+// CommonTeamRosterRow is a row of the commonteamroster CommonTeamRoster
+// result set.
+type CommonTeamRosterRow struct {
+	TeamID      int       `nba:"TeamID"`
+	Season      string    `nba:"SEASON"`
+	LeagueID    string    `nba:"LeagueID"`
+	Player      string    `nba:"PLAYER"`
+	Nickname    string    `nba:"NICKNAME"`
+	PlayerSlug  string    `nba:"PLAYER_SLUG"`
+	Num         string    `nba:"NUM"`
+	Position    string    `nba:"POSITION"`
+	Height      string    `nba:"HEIGHT"`
+	Weight      string    `nba:"WEIGHT"`
+	BirthDate   time.Time `nba:"BIRTH_DATE"`
+	Age         float64   `nba:"AGE"`
+	Exp         string    `nba:"EXP"`
+	School      string    `nba:"SCHOOL"`
+	PlayerID    int       `nba:"PLAYER_ID"`
+	HowAcquired string    `nba:"HOW_ACQUIRED"`
+}
+
+// CommonTeamYearsTeamYearsRow is a row of the commonteamyears TeamYears
+// result set.
+type CommonTeamYearsTeamYearsRow struct {
+	LeagueID     string `nba:"LEAGUE_ID"`
+	TeamID       int    `nba:"TEAM_ID"`
+	MinYear      string `nba:"MIN_YEAR"`
+	MaxYear      string `nba:"MAX_YEAR"`
+	Abbreviation string `nba:"ABBREVIATION"`
+}
+
+// TeamDashLineupsLineupsRow is a row of the teamdashlineups Lineups result
+// set.
+type TeamDashLineupsLineupsRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	GroupID       string  `nba:"GROUP_ID"`
+	GroupName     string  `nba:"GROUP_NAME"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	GPRank        int     `nba:"GP_RANK"`
+	WRank         int     `nba:"W_RANK"`
+	LRank         int     `nba:"L_RANK"`
+	WPctRank      int     `nba:"W_PCT_RANK"`
+	MinRank       int     `nba:"MIN_RANK"`
+	FGMRank       int     `nba:"FGM_RANK"`
+	FGARank       int     `nba:"FGA_RANK"`
+	FGPctRank     int     `nba:"FG_PCT_RANK"`
+	FG3MRank      int     `nba:"FG3M_RANK"`
+	FG3ARank      int     `nba:"FG3A_RANK"`
+	FG3PctRank    int     `nba:"FG3_PCT_RANK"`
+	FTMRank       int     `nba:"FTM_RANK"`
+	FTARank       int     `nba:"FTA_RANK"`
+	FTPctRank     int     `nba:"FT_PCT_RANK"`
+	OREBRank      int     `nba:"OREB_RANK"`
+	DREBRank      int     `nba:"DREB_RANK"`
+	REBRank       int     `nba:"REB_RANK"`
+	ASTRank       int     `nba:"AST_RANK"`
+	TOVRank       int     `nba:"TOV_RANK"`
+	STLRank       int     `nba:"STL_RANK"`
+	BLKRank       int     `nba:"BLK_RANK"`
+	BLKARank      int     `nba:"BLKA_RANK"`
+	PFRank        int     `nba:"PF_RANK"`
+	PFDRank       int     `nba:"PFD_RANK"`
+	PTSRank       int     `nba:"PTS_RANK"`
+	PlusMinusRank int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashLineupsOverallRow is a row of the teamdashlineups Overall result
+// set.
+type TeamDashLineupsOverallRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	GroupValue       string  `nba:"GROUP_VALUE"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	GP               int     `nba:"GP"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	WPct             float64 `nba:"W_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	GPRank           int     `nba:"GP_RANK"`
+	WRank            int     `nba:"W_RANK"`
+	LRank            int     `nba:"L_RANK"`
+	WPctRank         int     `nba:"W_PCT_RANK"`
+	MinRank          int     `nba:"MIN_RANK"`
+	FGMRank          int     `nba:"FGM_RANK"`
+	FGARank          int     `nba:"FGA_RANK"`
+	FGPctRank        int     `nba:"FG_PCT_RANK"`
+	FG3MRank         int     `nba:"FG3M_RANK"`
+	FG3ARank         int     `nba:"FG3A_RANK"`
+	FG3PctRank       int     `nba:"FG3_PCT_RANK"`
+	FTMRank          int     `nba:"FTM_RANK"`
+	FTARank          int     `nba:"FTA_RANK"`
+	FTPctRank        int     `nba:"FT_PCT_RANK"`
+	OREBRank         int     `nba:"OREB_RANK"`
+	DREBRank         int     `nba:"DREB_RANK"`
+	REBRank          int     `nba:"REB_RANK"`
+	ASTRank          int     `nba:"AST_RANK"`
+	TOVRank          int     `nba:"TOV_RANK"`
+	STLRank          int     `nba:"STL_RANK"`
+	BLKRank          int     `nba:"BLK_RANK"`
+	BLKARank         int     `nba:"BLKA_RANK"`
+	PFRank           int     `nba:"PF_RANK"`
+	PFDRank          int     `nba:"PFD_RANK"`
+	PTSRank          int     `nba:"PTS_RANK"`
+	PlusMinusRank    int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashPtPassPassesMadeRow is a row of the teamdashptpass PassesMade
+// result set.
+type TeamDashPtPassPassesMadeRow struct {
+	TeamID               int     `nba:"TEAM_ID"`
+	TeamName             string  `nba:"TEAM_NAME"`
+	PassType             string  `nba:"PASS_TYPE"`
+	G                    float64 `nba:"G"`
+	PassFrom             string  `nba:"PASS_FROM"`
+	PassTeammatePlayerID int     `nba:"PASS_TEAMMATE_PLAYER_ID"`
+	Frequency            float64 `nba:"FREQUENCY"`
+	Pass                 float64 `nba:"PASS"`
+	AST                  float64 `nba:"AST"`
+	FGM                  float64 `nba:"FGM"`
+	FGA                  float64 `nba:"FGA"`
+	FGPct                float64 `nba:"FG_PCT"`
+	Fg2m                 float64 `nba:"FG2M"`
+	Fg2a                 float64 `nba:"FG2A"`
+	Fg2Pct               float64 `nba:"FG2_PCT"`
+	FG3M                 float64 `nba:"FG3M"`
+	FG3A                 float64 `nba:"FG3A"`
+	FG3Pct               float64 `nba:"FG3_PCT"`
+}
+
+// TeamDashPtPassPassesReceivedRow is a row of the teamdashptpass
+// PassesReceived result set.
+type TeamDashPtPassPassesReceivedRow struct {
+	TeamID               int     `nba:"TEAM_ID"`
+	TeamName             string  `nba:"TEAM_NAME"`
+	PassType             string  `nba:"PASS_TYPE"`
+	G                    float64 `nba:"G"`
+	PassTo               string  `nba:"PASS_TO"`
+	PassTeammatePlayerID int     `nba:"PASS_TEAMMATE_PLAYER_ID"`
+	Frequency            float64 `nba:"FREQUENCY"`
+	Pass                 float64 `nba:"PASS"`
+	AST                  float64 `nba:"AST"`
+	FGM                  float64 `nba:"FGM"`
+	FGA                  float64 `nba:"FGA"`
+	FGPct                float64 `nba:"FG_PCT"`
+	Fg2m                 float64 `nba:"FG2M"`
+	Fg2a                 float64 `nba:"FG2A"`
+	Fg2Pct               float64 `nba:"FG2_PCT"`
+	FG3M                 float64 `nba:"FG3M"`
+	FG3A                 float64 `nba:"FG3A"`
+	FG3Pct               float64 `nba:"FG3_PCT"`
+}
+
+// TeamDashPtRebNumContestedReboundingRow is a row of the teamdashptreb
+// NumContestedRebounding result set.
+type TeamDashPtRebNumContestedReboundingRow struct {
+	TeamID                int     `nba:"TEAM_ID"`
+	TeamName              string  `nba:"TEAM_NAME"`
+	SortOrder             float64 `nba:"SORT_ORDER"`
+	G                     float64 `nba:"G"`
+	REBNumContestingRange string  `nba:"REB_NUM_CONTESTING_RANGE"`
+	REBFrequency          float64 `nba:"REB_FREQUENCY"`
+	OREB                  float64 `nba:"OREB"`
+	DREB                  float64 `nba:"DREB"`
+	REB                   float64 `nba:"REB"`
+	COREB                 float64 `nba:"C_OREB"`
+	CDREB                 float64 `nba:"C_DREB"`
+	CREB                  float64 `nba:"C_REB"`
+	CREBPct               float64 `nba:"C_REB_PCT"`
+	UcOREB                float64 `nba:"UC_OREB"`
+	UcDREB                float64 `nba:"UC_DREB"`
+	UcREB                 float64 `nba:"UC_REB"`
+	UcREBPct              float64 `nba:"UC_REB_PCT"`
+}
+
+// TeamDashPtRebOverallReboundingRow is a row of the teamdashptreb
+// OverallRebounding result set.
+type TeamDashPtRebOverallReboundingRow struct {
+	TeamID       int     `nba:"TEAM_ID"`
+	TeamName     string  `nba:"TEAM_NAME"`
+	G            float64 `nba:"G"`
+	Overall      string  `nba:"OVERALL"`
+	REBFrequency float64 `nba:"REB_FREQUENCY"`
+	OREB         float64 `nba:"OREB"`
+	DREB         float64 `nba:"DREB"`
+	REB          float64 `nba:"REB"`
+	COREB        float64 `nba:"C_OREB"`
+	CDREB        float64 `nba:"C_DREB"`
+	CREB         float64 `nba:"C_REB"`
+	CREBPct      float64 `nba:"C_REB_PCT"`
+	UcOREB       float64 `nba:"UC_OREB"`
+	UcDREB       float64 `nba:"UC_DREB"`
+	UcREB        float64 `nba:"UC_REB"`
+	UcREBPct     float64 `nba:"UC_REB_PCT"`
+}
+
+// TeamDashPtRebRebDistanceReboundingRow is a row of the teamdashptreb
+// RebDistanceRebounding result set.
+type TeamDashPtRebRebDistanceReboundingRow struct {
+	TeamID       int     `nba:"TEAM_ID"`
+	TeamName     string  `nba:"TEAM_NAME"`
+	SortOrder    float64 `nba:"SORT_ORDER"`
+	G            float64 `nba:"G"`
+	REBDistRange string  `nba:"REB_DIST_RANGE"`
+	REBFrequency float64 `nba:"REB_FREQUENCY"`
+	OREB         float64 `nba:"OREB"`
+	DREB         float64 `nba:"DREB"`
+	REB          float64 `nba:"REB"`
+	COREB        float64 `nba:"C_OREB"`
+	CDREB        float64 `nba:"C_DREB"`
+	CREB         float64 `nba:"C_REB"`
+	CREBPct      float64 `nba:"C_REB_PCT"`
+	UcOREB       float64 `nba:"UC_OREB"`
+	UcDREB       float64 `nba:"UC_DREB"`
+	UcREB        float64 `nba:"UC_REB"`
+	UcREBPct     float64 `nba:"UC_REB_PCT"`
+}
+
+// TeamDashPtRebShotDistanceReboundingRow is a row of the teamdashptreb
+// ShotDistanceRebounding result set.
+type TeamDashPtRebShotDistanceReboundingRow struct {
+	TeamID        int     `nba:"TEAM_ID"`
+	TeamName      string  `nba:"TEAM_NAME"`
+	SortOrder     float64 `nba:"SORT_ORDER"`
+	G             float64 `nba:"G"`
+	ShotDistRange string  `nba:"SHOT_DIST_RANGE"`
+	REBFrequency  float64 `nba:"REB_FREQUENCY"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	COREB         float64 `nba:"C_OREB"`
+	CDREB         float64 `nba:"C_DREB"`
+	CREB          float64 `nba:"C_REB"`
+	CREBPct       float64 `nba:"C_REB_PCT"`
+	UcOREB        float64 `nba:"UC_OREB"`
+	UcDREB        float64 `nba:"UC_DREB"`
+	UcREB         float64 `nba:"UC_REB"`
+	UcREBPct      float64 `nba:"UC_REB_PCT"`
+}
+
+// TeamDashPtRebShotTypeReboundingRow is a row of the teamdashptreb
+// ShotTypeRebounding result set.
+type TeamDashPtRebShotTypeReboundingRow struct {
+	TeamID        int     `nba:"TEAM_ID"`
+	TeamName      string  `nba:"TEAM_NAME"`
+	SortOrder     float64 `nba:"SORT_ORDER"`
+	G             float64 `nba:"G"`
+	ShotTypeRange string  `nba:"SHOT_TYPE_RANGE"`
+	REBFrequency  float64 `nba:"REB_FREQUENCY"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	COREB         float64 `nba:"C_OREB"`
+	CDREB         float64 `nba:"C_DREB"`
+	CREB          float64 `nba:"C_REB"`
+	CREBPct       float64 `nba:"C_REB_PCT"`
+	UcOREB        float64 `nba:"UC_OREB"`
+	UcDREB        float64 `nba:"UC_DREB"`
+	UcREB         float64 `nba:"UC_REB"`
+	UcREBPct      float64 `nba:"UC_REB_PCT"`
+}
+
+// TeamDashboardByGeneralSplitsDaysRestTeamDashboardRow is a row of the
+// teamdashboardbygeneralsplits DaysRestTeamDashboard result set.
+type TeamDashboardByGeneralSplitsDaysRestTeamDashboardRow struct {
+	GroupSet          string  `nba:"GROUP_SET"`
+	GroupValue        string  `nba:"GROUP_VALUE"`
+	TeamDaysRestRange string  `nba:"TEAM_DAYS_REST_RANGE"`
+	GP                int     `nba:"GP"`
+	W                 int     `nba:"W"`
+	L                 int     `nba:"L"`
+	WPct              float64 `nba:"W_PCT"`
+	Min               float64 `nba:"MIN"`
+	FGM               float64 `nba:"FGM"`
+	FGA               float64 `nba:"FGA"`
+	FGPct             float64 `nba:"FG_PCT"`
+	FG3M              float64 `nba:"FG3M"`
+	FG3A              float64 `nba:"FG3A"`
+	FG3Pct            float64 `nba:"FG3_PCT"`
+	FTM               float64 `nba:"FTM"`
+	FTA               float64 `nba:"FTA"`
+	FTPct             float64 `nba:"FT_PCT"`
+	OREB              float64 `nba:"OREB"`
+	DREB              float64 `nba:"DREB"`
+	REB               float64 `nba:"REB"`
+	AST               float64 `nba:"AST"`
+	TOV               float64 `nba:"TOV"`
+	STL               float64 `nba:"STL"`
+	BLK               float64 `nba:"BLK"`
+	BLKA              float64 `nba:"BLKA"`
+	PF                float64 `nba:"PF"`
+	PFD               float64 `nba:"PFD"`
+	PTS               float64 `nba:"PTS"`
+	PlusMinus         float64 `nba:"PLUS_MINUS"`
+	GPRank            int     `nba:"GP_RANK"`
+	WRank             int     `nba:"W_RANK"`
+	LRank             int     `nba:"L_RANK"`
+	WPctRank          int     `nba:"W_PCT_RANK"`
+	MinRank           int     `nba:"MIN_RANK"`
+	FGMRank           int     `nba:"FGM_RANK"`
+	FGARank           int     `nba:"FGA_RANK"`
+	FGPctRank         int     `nba:"FG_PCT_RANK"`
+	FG3MRank          int     `nba:"FG3M_RANK"`
+	FG3ARank          int     `nba:"FG3A_RANK"`
+	FG3PctRank        int     `nba:"FG3_PCT_RANK"`
+	FTMRank           int     `nba:"FTM_RANK"`
+	FTARank           int     `nba:"FTA_RANK"`
+	FTPctRank         int     `nba:"FT_PCT_RANK"`
+	OREBRank          int     `nba:"OREB_RANK"`
+	DREBRank          int     `nba:"DREB_RANK"`
+	REBRank           int     `nba:"REB_RANK"`
+	ASTRank           int     `nba:"AST_RANK"`
+	TOVRank           int     `nba:"TOV_RANK"`
+	STLRank           int     `nba:"STL_RANK"`
+	BLKRank           int     `nba:"BLK_RANK"`
+	BLKARank          int     `nba:"BLKA_RANK"`
+	PFRank            int     `nba:"PF_RANK"`
+	PFDRank           int     `nba:"PFD_RANK"`
+	PTSRank           int     `nba:"PTS_RANK"`
+	PlusMinusRank     int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashboardByGeneralSplitsLocationTeamDashboardRow is a row of the
+// teamdashboardbygeneralsplits LocationTeamDashboard result set.
+type TeamDashboardByGeneralSplitsLocationTeamDashboardRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	GroupValue       string  `nba:"GROUP_VALUE"`
+	TeamGameLocation string  `nba:"TEAM_GAME_LOCATION"`
+	GP               int     `nba:"GP"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	WPct             float64 `nba:"W_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	GPRank           int     `nba:"GP_RANK"`
+	WRank            int     `nba:"W_RANK"`
+	LRank            int     `nba:"L_RANK"`
+	WPctRank         int     `nba:"W_PCT_RANK"`
+	MinRank          int     `nba:"MIN_RANK"`
+	FGMRank          int     `nba:"FGM_RANK"`
+	FGARank          int     `nba:"FGA_RANK"`
+	FGPctRank        int     `nba:"FG_PCT_RANK"`
+	FG3MRank         int     `nba:"FG3M_RANK"`
+	FG3ARank         int     `nba:"FG3A_RANK"`
+	FG3PctRank       int     `nba:"FG3_PCT_RANK"`
+	FTMRank          int     `nba:"FTM_RANK"`
+	FTARank          int     `nba:"FTA_RANK"`
+	FTPctRank        int     `nba:"FT_PCT_RANK"`
+	OREBRank         int     `nba:"OREB_RANK"`
+	DREBRank         int     `nba:"DREB_RANK"`
+	REBRank          int     `nba:"REB_RANK"`
+	ASTRank          int     `nba:"AST_RANK"`
+	TOVRank          int     `nba:"TOV_RANK"`
+	STLRank          int     `nba:"STL_RANK"`
+	BLKRank          int     `nba:"BLK_RANK"`
+	BLKARank         int     `nba:"BLKA_RANK"`
+	PFRank           int     `nba:"PF_RANK"`
+	PFDRank          int     `nba:"PFD_RANK"`
+	PTSRank          int     `nba:"PTS_RANK"`
+	PlusMinusRank    int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashboardByGeneralSplitsMonthTeamDashboardRow is a row of the
+// teamdashboardbygeneralsplits MonthTeamDashboard result set.
+type TeamDashboardByGeneralSplitsMonthTeamDashboardRow struct {
+	GroupSet        string  `nba:"GROUP_SET"`
+	GroupValue      string  `nba:"GROUP_VALUE"`
+	SeasonMonthName string  `nba:"SEASON_MONTH_NAME"`
+	GP              int     `nba:"GP"`
+	W               int     `nba:"W"`
+	L               int     `nba:"L"`
+	WPct            float64 `nba:"W_PCT"`
+	Min             float64 `nba:"MIN"`
+	FGM             float64 `nba:"FGM"`
+	FGA             float64 `nba:"FGA"`
+	FGPct           float64 `nba:"FG_PCT"`
+	FG3M            float64 `nba:"FG3M"`
+	FG3A            float64 `nba:"FG3A"`
+	FG3Pct          float64 `nba:"FG3_PCT"`
+	FTM             float64 `nba:"FTM"`
+	FTA             float64 `nba:"FTA"`
+	FTPct           float64 `nba:"FT_PCT"`
+	OREB            float64 `nba:"OREB"`
+	DREB            float64 `nba:"DREB"`
+	REB             float64 `nba:"REB"`
+	AST             float64 `nba:"AST"`
+	TOV             float64 `nba:"TOV"`
+	STL             float64 `nba:"STL"`
+	BLK             float64 `nba:"BLK"`
+	BLKA            float64 `nba:"BLKA"`
+	PF              float64 `nba:"PF"`
+	PFD             float64 `nba:"PFD"`
+	PTS             float64 `nba:"PTS"`
+	PlusMinus       float64 `nba:"PLUS_MINUS"`
+	GPRank          int     `nba:"GP_RANK"`
+	WRank           int     `nba:"W_RANK"`
+	LRank           int     `nba:"L_RANK"`
+	WPctRank        int     `nba:"W_PCT_RANK"`
+	MinRank         int     `nba:"MIN_RANK"`
+	FGMRank         int     `nba:"FGM_RANK"`
+	FGARank         int     `nba:"FGA_RANK"`
+	FGPctRank       int     `nba:"FG_PCT_RANK"`
+	FG3MRank        int     `nba:"FG3M_RANK"`
+	FG3ARank        int     `nba:"FG3A_RANK"`
+	FG3PctRank      int     `nba:"FG3_PCT_RANK"`
+	FTMRank         int     `nba:"FTM_RANK"`
+	FTARank         int     `nba:"FTA_RANK"`
+	FTPctRank       int     `nba:"FT_PCT_RANK"`
+	OREBRank        int     `nba:"OREB_RANK"`
+	DREBRank        int     `nba:"DREB_RANK"`
+	REBRank         int     `nba:"REB_RANK"`
+	ASTRank         int     `nba:"AST_RANK"`
+	TOVRank         int     `nba:"TOV_RANK"`
+	STLRank         int     `nba:"STL_RANK"`
+	BLKRank         int     `nba:"BLK_RANK"`
+	BLKARank        int     `nba:"BLKA_RANK"`
+	PFRank          int     `nba:"PF_RANK"`
+	PFDRank         int     `nba:"PFD_RANK"`
+	PTSRank         int     `nba:"PTS_RANK"`
+	PlusMinusRank   int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashboardByGeneralSplitsOverallTeamDashboardRow is a row of the
+// teamdashboardbygeneralsplits OverallTeamDashboard result set.
+type TeamDashboardByGeneralSplitsOverallTeamDashboardRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	GroupValue    string  `nba:"GROUP_VALUE"`
+	SeasonYear    string  `nba:"SEASON_YEAR"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	GPRank        int     `nba:"GP_RANK"`
+	WRank         int     `nba:"W_RANK"`
+	LRank         int     `nba:"L_RANK"`
+	WPctRank      int     `nba:"W_PCT_RANK"`
+	MinRank       int     `nba:"MIN_RANK"`
+	FGMRank       int     `nba:"FGM_RANK"`
+	FGARank       int     `nba:"FGA_RANK"`
+	FGPctRank     int     `nba:"FG_PCT_RANK"`
+	FG3MRank      int     `nba:"FG3M_RANK"`
+	FG3ARank      int     `nba:"FG3A_RANK"`
+	FG3PctRank    int     `nba:"FG3_PCT_RANK"`
+	FTMRank       int     `nba:"FTM_RANK"`
+	FTARank       int     `nba:"FTA_RANK"`
+	FTPctRank     int     `nba:"FT_PCT_RANK"`
+	OREBRank      int     `nba:"OREB_RANK"`
+	DREBRank      int     `nba:"DREB_RANK"`
+	REBRank       int     `nba:"REB_RANK"`
+	ASTRank       int     `nba:"AST_RANK"`
+	TOVRank       int     `nba:"TOV_RANK"`
+	STLRank       int     `nba:"STL_RANK"`
+	BLKRank       int     `nba:"BLK_RANK"`
+	BLKARank      int     `nba:"BLKA_RANK"`
+	PFRank        int     `nba:"PF_RANK"`
+	PFDRank       int     `nba:"PFD_RANK"`
+	PTSRank       int     `nba:"PTS_RANK"`
+	PlusMinusRank int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashboardByGeneralSplitsPrePostAllStarTeamDashboardRow is a row of the
+// teamdashboardbygeneralsplits PrePostAllStarTeamDashboard result set.
+type TeamDashboardByGeneralSplitsPrePostAllStarTeamDashboardRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	GroupValue    string  `nba:"GROUP_VALUE"`
+	SeasonSegment string  `nba:"SEASON_SEGMENT"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	GPRank        int     `nba:"GP_RANK"`
+	WRank         int     `nba:"W_RANK"`
+	LRank         int     `nba:"L_RANK"`
+	WPctRank      int     `nba:"W_PCT_RANK"`
+	MinRank       int     `nba:"MIN_RANK"`
+	FGMRank       int     `nba:"FGM_RANK"`
+	FGARank       int     `nba:"FGA_RANK"`
+	FGPctRank     int     `nba:"FG_PCT_RANK"`
+	FG3MRank      int     `nba:"FG3M_RANK"`
+	FG3ARank      int     `nba:"FG3A_RANK"`
+	FG3PctRank    int     `nba:"FG3_PCT_RANK"`
+	FTMRank       int     `nba:"FTM_RANK"`
+	FTARank       int     `nba:"FTA_RANK"`
+	FTPctRank     int     `nba:"FT_PCT_RANK"`
+	OREBRank      int     `nba:"OREB_RANK"`
+	DREBRank      int     `nba:"DREB_RANK"`
+	REBRank       int     `nba:"REB_RANK"`
+	ASTRank       int     `nba:"AST_RANK"`
+	TOVRank       int     `nba:"TOV_RANK"`
+	STLRank       int     `nba:"STL_RANK"`
+	BLKRank       int     `nba:"BLK_RANK"`
+	BLKARank      int     `nba:"BLKA_RANK"`
+	PFRank        int     `nba:"PF_RANK"`
+	PFDRank       int     `nba:"PFD_RANK"`
+	PTSRank       int     `nba:"PTS_RANK"`
+	PlusMinusRank int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashboardByGeneralSplitsWinsLossesTeamDashboardRow is a row of the
+// teamdashboardbygeneralsplits WinsLossesTeamDashboard result set.
+type TeamDashboardByGeneralSplitsWinsLossesTeamDashboardRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	GroupValue    string  `nba:"GROUP_VALUE"`
+	GameResult    string  `nba:"GAME_RESULT"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	GPRank        int     `nba:"GP_RANK"`
+	WRank         int     `nba:"W_RANK"`
+	LRank         int     `nba:"L_RANK"`
+	WPctRank      int     `nba:"W_PCT_RANK"`
+	MinRank       int     `nba:"MIN_RANK"`
+	FGMRank       int     `nba:"FGM_RANK"`
+	FGARank       int     `nba:"FGA_RANK"`
+	FGPctRank     int     `nba:"FG_PCT_RANK"`
+	FG3MRank      int     `nba:"FG3M_RANK"`
+	FG3ARank      int     `nba:"FG3A_RANK"`
+	FG3PctRank    int     `nba:"FG3_PCT_RANK"`
+	FTMRank       int     `nba:"FTM_RANK"`
+	FTARank       int     `nba:"FTA_RANK"`
+	FTPctRank     int     `nba:"FT_PCT_RANK"`
+	OREBRank      int     `nba:"OREB_RANK"`
+	DREBRank      int     `nba:"DREB_RANK"`
+	REBRank       int     `nba:"REB_RANK"`
+	ASTRank       int     `nba:"AST_RANK"`
+	TOVRank       int     `nba:"TOV_RANK"`
+	STLRank       int     `nba:"STL_RANK"`
+	BLKRank       int     `nba:"BLK_RANK"`
+	BLKARank      int     `nba:"BLKA_RANK"`
+	PFRank        int     `nba:"PF_RANK"`
+	PFDRank       int     `nba:"PFD_RANK"`
+	PTSRank       int     `nba:"PTS_RANK"`
+	PlusMinusRank int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamDashboardByShootingSplitsAssistedByRow is a row of the
+// teamdashboardbyshootingsplits AssistedBy result set.
+type TeamDashboardByShootingSplitsAssistedByRow struct {
+	GroupSet       string  `nba:"GROUP_SET"`
+	PlayerID       int     `nba:"PLAYER_ID"`
+	PlayerName     string  `nba:"PLAYER_NAME"`
+	FGM            float64 `nba:"FGM"`
+	FGA            float64 `nba:"FGA"`
+	FGPct          float64 `nba:"FG_PCT"`
+	FG3M           float64 `nba:"FG3M"`
+	FG3A           float64 `nba:"FG3A"`
+	FG3Pct         float64 `nba:"FG3_PCT"`
+	EFGPct         float64 `nba:"EFG_PCT"`
+	BLKA           float64 `nba:"BLKA"`
+	PctAST2pm      float64 `nba:"PCT_AST_2PM"`
+	PctUast2pm     float64 `nba:"PCT_UAST_2PM"`
+	PctAST3pm      float64 `nba:"PCT_AST_3PM"`
+	PctUast3pm     float64 `nba:"PCT_UAST_3PM"`
+	PctASTFGM      float64 `nba:"PCT_AST_FGM"`
+	PctUastFGM     float64 `nba:"PCT_UAST_FGM"`
+	FGMRank        int     `nba:"FGM_RANK"`
+	FGARank        int     `nba:"FGA_RANK"`
+	FGPctRank      int     `nba:"FG_PCT_RANK"`
+	FG3MRank       int     `nba:"FG3M_RANK"`
+	FG3ARank       int     `nba:"FG3A_RANK"`
+	FG3PctRank     int     `nba:"FG3_PCT_RANK"`
+	EFGPctRank     int     `nba:"EFG_PCT_RANK"`
+	BLKARank       int     `nba:"BLKA_RANK"`
+	PctAST2pmRank  int     `nba:"PCT_AST_2PM_RANK"`
+	PctUast2pmRank int     `nba:"PCT_UAST_2PM_RANK"`
+	PctAST3pmRank  int     `nba:"PCT_AST_3PM_RANK"`
+	PctUast3pmRank int     `nba:"PCT_UAST_3PM_RANK"`
+	PctASTFGMRank  int     `nba:"PCT_AST_FGM_RANK"`
+	PctUastFGMRank int     `nba:"PCT_UAST_FGM_RANK"`
+	Cfid           int     `nba:"CFID"`
+	Cfparams       string  `nba:"CFPARAMS"`
+}
+
+// TeamDashboardByShootingSplitsTeamDashboardRow is a row of the
+// teamdashboardbyshootingsplits AssitedShotTeamDashboard,
+// teamdashboardbyshootingsplits OverallTeamDashboard,
+// teamdashboardbyshootingsplits Shot5FTTeamDashboard,
+// teamdashboardbyshootingsplits Shot8FTTeamDashboard,
+// teamdashboardbyshootingsplits ShotAreaTeamDashboard and
+// teamdashboardbyshootingsplits ShotTypeTeamDashboard result sets.
+type TeamDashboardByShootingSplitsTeamDashboardRow struct {
+	GroupSet       string  `nba:"GROUP_SET"`
+	GroupValue     string  `nba:"GROUP_VALUE"`
+	FGM            float64 `nba:"FGM"`
+	FGA            float64 `nba:"FGA"`
+	FGPct          float64 `nba:"FG_PCT"`
+	FG3M           float64 `nba:"FG3M"`
+	FG3A           float64 `nba:"FG3A"`
+	FG3Pct         float64 `nba:"FG3_PCT"`
+	EFGPct         float64 `nba:"EFG_PCT"`
+	BLKA           float64 `nba:"BLKA"`
+	PctAST2pm      float64 `nba:"PCT_AST_2PM"`
+	PctUast2pm     float64 `nba:"PCT_UAST_2PM"`
+	PctAST3pm      float64 `nba:"PCT_AST_3PM"`
+	PctUast3pm     float64 `nba:"PCT_UAST_3PM"`
+	PctASTFGM      float64 `nba:"PCT_AST_FGM"`
+	PctUastFGM     float64 `nba:"PCT_UAST_FGM"`
+	FGMRank        int     `nba:"FGM_RANK"`
+	FGARank        int     `nba:"FGA_RANK"`
+	FGPctRank      int     `nba:"FG_PCT_RANK"`
+	FG3MRank       int     `nba:"FG3M_RANK"`
+	FG3ARank       int     `nba:"FG3A_RANK"`
+	FG3PctRank     int     `nba:"FG3_PCT_RANK"`
+	EFGPctRank     int     `nba:"EFG_PCT_RANK"`
+	BLKARank       int     `nba:"BLKA_RANK"`
+	PctAST2pmRank  int     `nba:"PCT_AST_2PM_RANK"`
+	PctUast2pmRank int     `nba:"PCT_UAST_2PM_RANK"`
+	PctAST3pmRank  int     `nba:"PCT_AST_3PM_RANK"`
+	PctUast3pmRank int     `nba:"PCT_UAST_3PM_RANK"`
+	PctASTFGMRank  int     `nba:"PCT_AST_FGM_RANK"`
+	PctUastFGMRank int     `nba:"PCT_UAST_FGM_RANK"`
+	Cfid           int     `nba:"CFID"`
+	Cfparams       string  `nba:"CFPARAMS"`
+}
+
+// TeamDetailsTeamAwardsRow is a row of the teamdetails
+// TeamAwardsChampionships, teamdetails TeamAwardsConf and teamdetails
+// TeamAwardsDiv result sets.
+type TeamDetailsTeamAwardsRow struct {
+	Yearawarded  int    `nba:"YEARAWARDED"`
+	Oppositeteam string `nba:"OPPOSITETEAM"`
+}
+
+// TeamDetailsTeamBackgroundRow is a row of the teamdetails TeamBackground
+// result set.
+type TeamDetailsTeamBackgroundRow struct {
+	TeamID             int    `nba:"TEAM_ID"`
+	Abbreviation       string `nba:"ABBREVIATION"`
+	Nickname           string `nba:"NICKNAME"`
+	Yearfounded        int    `nba:"YEARFOUNDED"`
+	City               string `nba:"CITY"`
+	Arena              string `nba:"ARENA"`
+	Arenacapacity      int    `nba:"ARENACAPACITY"`
+	Owner              string `nba:"OWNER"`
+	Generalmanager     string `nba:"GENERALMANAGER"`
+	Headcoach          string `nba:"HEADCOACH"`
+	Dleagueaffiliation string `nba:"DLEAGUEAFFILIATION"`
+}
+
+// TeamDetailsTeamHistoryRow is a row of the teamdetails TeamHistory result
+// set.
+type TeamDetailsTeamHistoryRow struct {
+	TeamID         int    `nba:"TEAM_ID"`
+	City           string `nba:"CITY"`
+	Nickname       string `nba:"NICKNAME"`
+	Yearfounded    int    `nba:"YEARFOUNDED"`
+	Yearactivetill int    `nba:"YEARACTIVETILL"`
+}
+
+// TeamDetailsTeamRow is a row of the teamdetails TeamHof and teamdetails
+// TeamRetired result sets.
+type TeamDetailsTeamRow struct {
+	Playerid        int    `nba:"PLAYERID"`
+	Player          string `nba:"PLAYER"`
+	Position        string `nba:"POSITION"`
+	Jersey          string `nba:"JERSEY"`
+	Seasonswithteam string `nba:"SEASONSWITHTEAM"`
+	Year            int    `nba:"YEAR"`
+}
+
+// TeamDetailsTeamSocialSitesRow is a row of the teamdetails TeamSocialSites
+// result set.
+type TeamDetailsTeamSocialSitesRow struct {
+	Accounttype string `nba:"ACCOUNTTYPE"`
+	WebsiteLink string `nba:"WEBSITE_LINK"`
+}
+
+// TeamEstimatedMetricsRow is a row of the teamestimatedmetrics
+// TeamEstimatedMetrics result set.
+type TeamEstimatedMetricsRow struct {
+	TeamName       string  `nba:"TEAM_NAME"`
+	TeamID         int     `nba:"TEAM_ID"`
+	GP             int     `nba:"GP"`
+	W              int     `nba:"W"`
+	L              int     `nba:"L"`
+	WPct           float64 `nba:"W_PCT"`
+	Min            float64 `nba:"MIN"`
+	EOffRating     float64 `nba:"E_OFF_RATING"`
+	EDefRating     float64 `nba:"E_DEF_RATING"`
+	ENetRating     float64 `nba:"E_NET_RATING"`
+	EPace          float64 `nba:"E_PACE"`
+	EASTRatio      float64 `nba:"E_AST_RATIO"`
+	EOREBPct       float64 `nba:"E_OREB_PCT"`
+	EDREBPct       float64 `nba:"E_DREB_PCT"`
+	EREBPct        float64 `nba:"E_REB_PCT"`
+	ETmTOVPct      float64 `nba:"E_TM_TOV_PCT"`
+	GPRank         int     `nba:"GP_RANK"`
+	WRank          int     `nba:"W_RANK"`
+	LRank          int     `nba:"L_RANK"`
+	WPctRank       int     `nba:"W_PCT_RANK"`
+	MinRank        int     `nba:"MIN_RANK"`
+	EOffRatingRank int     `nba:"E_OFF_RATING_RANK"`
+	EDefRatingRank int     `nba:"E_DEF_RATING_RANK"`
+	ENetRatingRank int     `nba:"E_NET_RATING_RANK"`
+	EASTRatioRank  int     `nba:"E_AST_RATIO_RANK"`
+	EOREBPctRank   int     `nba:"E_OREB_PCT_RANK"`
+	EDREBPctRank   int     `nba:"E_DREB_PCT_RANK"`
+	EREBPctRank    int     `nba:"E_REB_PCT_RANK"`
+	ETmTOVPctRank  int     `nba:"E_TM_TOV_PCT_RANK"`
+	EPaceRank      int     `nba:"E_PACE_RANK"`
+}
+
+// TeamHistoricalLeadersCareerLeadersByTeamRow is a row of the
+// teamhistoricalleaders CareerLeadersByTeam result set.
+type TeamHistoricalLeadersCareerLeadersByTeamRow struct {
+	TeamID      int     `nba:"TEAM_ID"`
+	PTS         float64 `nba:"PTS"`
+	PTSPersonID int     `nba:"PTS_PERSON_ID"`
+	PTSPlayer   string  `nba:"PTS_PLAYER"`
+	AST         float64 `nba:"AST"`
+	ASTPersonID int     `nba:"AST_PERSON_ID"`
+	ASTPlayer   string  `nba:"AST_PLAYER"`
+	REB         float64 `nba:"REB"`
+	REBPersonID int     `nba:"REB_PERSON_ID"`
+	REBPlayer   string  `nba:"REB_PLAYER"`
+	BLK         float64 `nba:"BLK"`
+	BLKPersonID int     `nba:"BLK_PERSON_ID"`
+	BLKPlayer   string  `nba:"BLK_PLAYER"`
+	STL         float64 `nba:"STL"`
+	STLPersonID int     `nba:"STL_PERSON_ID"`
+	STLPlayer   string  `nba:"STL_PLAYER"`
+	SeasonYear  string  `nba:"SEASON_YEAR"`
+}
+
+// TeamInfoCommonAvailableSeasonsRow is a row of the teaminfocommon
+// AvailableSeasons result set.
+type TeamInfoCommonAvailableSeasonsRow struct {
+	SeasonID string `nba:"SEASON_ID"`
+}
+
+// TeamInfoCommonRow is a row of the teaminfocommon TeamInfoCommon result set.
+type TeamInfoCommonRow struct {
+	TeamID           int     `nba:"TEAM_ID"`
+	SeasonYear       string  `nba:"SEASON_YEAR"`
+	TeamCity         string  `nba:"TEAM_CITY"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamConference   string  `nba:"TEAM_CONFERENCE"`
+	TeamDivision     string  `nba:"TEAM_DIVISION"`
+	TeamCode         string  `nba:"TEAM_CODE"`
+	TeamSlug         string  `nba:"TEAM_SLUG"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	Pct              float64 `nba:"PCT"`
+	ConfRank         int     `nba:"CONF_RANK"`
+	DivRank          int     `nba:"DIV_RANK"`
+	MinYear          string  `nba:"MIN_YEAR"`
+	MaxYear          string  `nba:"MAX_YEAR"`
+}
+
+// TeamInfoCommonTeamSeasonRanksRow is a row of the teaminfocommon
+// TeamSeasonRanks result set.
+type TeamInfoCommonTeamSeasonRanksRow struct {
+	LeagueID   string  `nba:"LEAGUE_ID"`
+	SeasonID   string  `nba:"SEASON_ID"`
+	TeamID     int     `nba:"TEAM_ID"`
+	PTSRank    int     `nba:"PTS_RANK"`
+	PTSPg      float64 `nba:"PTS_PG"`
+	REBRank    int     `nba:"REB_RANK"`
+	REBPg      float64 `nba:"REB_PG"`
+	ASTRank    int     `nba:"AST_RANK"`
+	ASTPg      float64 `nba:"AST_PG"`
+	OppPTSRank int     `nba:"OPP_PTS_RANK"`
+	OppPTSPg   float64 `nba:"OPP_PTS_PG"`
+}
+
+// TeamPlayerDashboardPlayersSeasonTotalsRow is a row of the
+// teamplayerdashboard PlayersSeasonTotals result set.
+type TeamPlayerDashboardPlayersSeasonTotalsRow struct {
+	GroupSet           string  `nba:"GROUP_SET"`
+	PlayerID           int     `nba:"PLAYER_ID"`
+	PlayerName         string  `nba:"PLAYER_NAME"`
+	Nickname           string  `nba:"NICKNAME"`
+	GP                 int     `nba:"GP"`
+	W                  int     `nba:"W"`
+	L                  int     `nba:"L"`
+	WPct               float64 `nba:"W_PCT"`
+	Min                float64 `nba:"MIN"`
+	FGM                float64 `nba:"FGM"`
+	FGA                float64 `nba:"FGA"`
+	FGPct              float64 `nba:"FG_PCT"`
+	FG3M               float64 `nba:"FG3M"`
+	FG3A               float64 `nba:"FG3A"`
+	FG3Pct             float64 `nba:"FG3_PCT"`
+	FTM                float64 `nba:"FTM"`
+	FTA                float64 `nba:"FTA"`
+	FTPct              float64 `nba:"FT_PCT"`
+	OREB               float64 `nba:"OREB"`
+	DREB               float64 `nba:"DREB"`
+	REB                float64 `nba:"REB"`
+	AST                float64 `nba:"AST"`
+	TOV                float64 `nba:"TOV"`
+	STL                float64 `nba:"STL"`
+	BLK                float64 `nba:"BLK"`
+	BLKA               float64 `nba:"BLKA"`
+	PF                 float64 `nba:"PF"`
+	PFD                float64 `nba:"PFD"`
+	PTS                float64 `nba:"PTS"`
+	PlusMinus          float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS      float64 `nba:"NBA_FANTASY_PTS"`
+	DD2                float64 `nba:"DD2"`
+	TD3                float64 `nba:"TD3"`
+	WnbaFantasyPTS     float64 `nba:"WNBA_FANTASY_PTS"`
+	GPRank             int     `nba:"GP_RANK"`
+	WRank              int     `nba:"W_RANK"`
+	LRank              int     `nba:"L_RANK"`
+	WPctRank           int     `nba:"W_PCT_RANK"`
+	MinRank            int     `nba:"MIN_RANK"`
+	FGMRank            int     `nba:"FGM_RANK"`
+	FGARank            int     `nba:"FGA_RANK"`
+	FGPctRank          int     `nba:"FG_PCT_RANK"`
+	FG3MRank           int     `nba:"FG3M_RANK"`
+	FG3ARank           int     `nba:"FG3A_RANK"`
+	FG3PctRank         int     `nba:"FG3_PCT_RANK"`
+	FTMRank            int     `nba:"FTM_RANK"`
+	FTARank            int     `nba:"FTA_RANK"`
+	FTPctRank          int     `nba:"FT_PCT_RANK"`
+	OREBRank           int     `nba:"OREB_RANK"`
+	DREBRank           int     `nba:"DREB_RANK"`
+	REBRank            int     `nba:"REB_RANK"`
+	ASTRank            int     `nba:"AST_RANK"`
+	TOVRank            int     `nba:"TOV_RANK"`
+	STLRank            int     `nba:"STL_RANK"`
+	BLKRank            int     `nba:"BLK_RANK"`
+	BLKARank           int     `nba:"BLKA_RANK"`
+	PFRank             int     `nba:"PF_RANK"`
+	PFDRank            int     `nba:"PFD_RANK"`
+	PTSRank            int     `nba:"PTS_RANK"`
+	PlusMinusRank      int     `nba:"PLUS_MINUS_RANK"`
+	NBAFantasyPTSRank  int     `nba:"NBA_FANTASY_PTS_RANK"`
+	DD2Rank            int     `nba:"DD2_RANK"`
+	TD3Rank            int     `nba:"TD3_RANK"`
+	WnbaFantasyPTSRank int     `nba:"WNBA_FANTASY_PTS_RANK"`
+}
+
+// TeamPlayerDashboardTeamOverallRow is a row of the teamplayerdashboard
+// TeamOverall result set.
+type TeamPlayerDashboardTeamOverallRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	TeamID        int     `nba:"TEAM_ID"`
+	TeamName      string  `nba:"TEAM_NAME"`
+	GroupValue    string  `nba:"GROUP_VALUE"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	GPRank        int     `nba:"GP_RANK"`
+	WRank         int     `nba:"W_RANK"`
+	LRank         int     `nba:"L_RANK"`
+	WPctRank      int     `nba:"W_PCT_RANK"`
+	MinRank       int     `nba:"MIN_RANK"`
+	FGMRank       int     `nba:"FGM_RANK"`
+	FGARank       int     `nba:"FGA_RANK"`
+	FGPctRank     int     `nba:"FG_PCT_RANK"`
+	FG3MRank      int     `nba:"FG3M_RANK"`
+	FG3ARank      int     `nba:"FG3A_RANK"`
+	FG3PctRank    int     `nba:"FG3_PCT_RANK"`
+	FTMRank       int     `nba:"FTM_RANK"`
+	FTARank       int     `nba:"FTA_RANK"`
+	FTPctRank     int     `nba:"FT_PCT_RANK"`
+	OREBRank      int     `nba:"OREB_RANK"`
+	DREBRank      int     `nba:"DREB_RANK"`
+	REBRank       int     `nba:"REB_RANK"`
+	ASTRank       int     `nba:"AST_RANK"`
+	TOVRank       int     `nba:"TOV_RANK"`
+	STLRank       int     `nba:"STL_RANK"`
+	BLKRank       int     `nba:"BLK_RANK"`
+	BLKARank      int     `nba:"BLKA_RANK"`
+	PFRank        int     `nba:"PF_RANK"`
+	PFDRank       int     `nba:"PFD_RANK"`
+	PTSRank       int     `nba:"PTS_RANK"`
+	PlusMinusRank int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamPlayerOnOffDetailsOverallTeamPlayerOnOffDetailsRow is a row of the
+// teamplayeronoffdetails OverallTeamPlayerOnOffDetails result set.
+type TeamPlayerOnOffDetailsOverallTeamPlayerOnOffDetailsRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	GroupValue       string  `nba:"GROUP_VALUE"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	GP               int     `nba:"GP"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	WPct             float64 `nba:"W_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	GPRank           int     `nba:"GP_RANK"`
+	WRank            int     `nba:"W_RANK"`
+	LRank            int     `nba:"L_RANK"`
+	WPctRank         int     `nba:"W_PCT_RANK"`
+	MinRank          int     `nba:"MIN_RANK"`
+	FGMRank          int     `nba:"FGM_RANK"`
+	FGARank          int     `nba:"FGA_RANK"`
+	FGPctRank        int     `nba:"FG_PCT_RANK"`
+	FG3MRank         int     `nba:"FG3M_RANK"`
+	FG3ARank         int     `nba:"FG3A_RANK"`
+	FG3PctRank       int     `nba:"FG3_PCT_RANK"`
+	FTMRank          int     `nba:"FTM_RANK"`
+	FTARank          int     `nba:"FTA_RANK"`
+	FTPctRank        int     `nba:"FT_PCT_RANK"`
+	OREBRank         int     `nba:"OREB_RANK"`
+	DREBRank         int     `nba:"DREB_RANK"`
+	REBRank          int     `nba:"REB_RANK"`
+	ASTRank          int     `nba:"AST_RANK"`
+	TOVRank          int     `nba:"TOV_RANK"`
+	STLRank          int     `nba:"STL_RANK"`
+	BLKRank          int     `nba:"BLK_RANK"`
+	BLKARank         int     `nba:"BLKA_RANK"`
+	PFRank           int     `nba:"PF_RANK"`
+	PFDRank          int     `nba:"PFD_RANK"`
+	PTSRank          int     `nba:"PTS_RANK"`
+	PlusMinusRank    int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamPlayerOnOffDetailsPlayersRow is a row of the teamplayeronoffdetails
+// PlayersOffCourtTeamPlayerOnOffDetails and teamplayeronoffdetails
+// PlayersOnCourtTeamPlayerOnOffDetails result sets.
+type TeamPlayerOnOffDetailsPlayersRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	VsPlayerID       int     `nba:"VS_PLAYER_ID"`
+	VsPlayerName     string  `nba:"VS_PLAYER_NAME"`
+	CourtStatus      string  `nba:"COURT_STATUS"`
+	GP               int     `nba:"GP"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	WPct             float64 `nba:"W_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	GPRank           int     `nba:"GP_RANK"`
+	WRank            int     `nba:"W_RANK"`
+	LRank            int     `nba:"L_RANK"`
+	WPctRank         int     `nba:"W_PCT_RANK"`
+	MinRank          int     `nba:"MIN_RANK"`
+	FGMRank          int     `nba:"FGM_RANK"`
+	FGARank          int     `nba:"FGA_RANK"`
+	FGPctRank        int     `nba:"FG_PCT_RANK"`
+	FG3MRank         int     `nba:"FG3M_RANK"`
+	FG3ARank         int     `nba:"FG3A_RANK"`
+	FG3PctRank       int     `nba:"FG3_PCT_RANK"`
+	FTMRank          int     `nba:"FTM_RANK"`
+	FTARank          int     `nba:"FTA_RANK"`
+	FTPctRank        int     `nba:"FT_PCT_RANK"`
+	OREBRank         int     `nba:"OREB_RANK"`
+	DREBRank         int     `nba:"DREB_RANK"`
+	REBRank          int     `nba:"REB_RANK"`
+	ASTRank          int     `nba:"AST_RANK"`
+	TOVRank          int     `nba:"TOV_RANK"`
+	STLRank          int     `nba:"STL_RANK"`
+	BLKRank          int     `nba:"BLK_RANK"`
+	BLKARank         int     `nba:"BLKA_RANK"`
+	PFRank           int     `nba:"PF_RANK"`
+	PFDRank          int     `nba:"PFD_RANK"`
+	PTSRank          int     `nba:"PTS_RANK"`
+	PlusMinusRank    int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamPlayerOnOffSummaryOverallTeamPlayerOnOffSummaryRow is a row of the
+// teamplayeronoffsummary OverallTeamPlayerOnOffSummary result set.
+type TeamPlayerOnOffSummaryOverallTeamPlayerOnOffSummaryRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	GroupValue       string  `nba:"GROUP_VALUE"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	GP               int     `nba:"GP"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	WPct             float64 `nba:"W_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	GPRank           int     `nba:"GP_RANK"`
+	WRank            int     `nba:"W_RANK"`
+	LRank            int     `nba:"L_RANK"`
+	WPctRank         int     `nba:"W_PCT_RANK"`
+	MinRank          int     `nba:"MIN_RANK"`
+	FGMRank          int     `nba:"FGM_RANK"`
+	FGARank          int     `nba:"FGA_RANK"`
+	FGPctRank        int     `nba:"FG_PCT_RANK"`
+	FG3MRank         int     `nba:"FG3M_RANK"`
+	FG3ARank         int     `nba:"FG3A_RANK"`
+	FG3PctRank       int     `nba:"FG3_PCT_RANK"`
+	FTMRank          int     `nba:"FTM_RANK"`
+	FTARank          int     `nba:"FTA_RANK"`
+	FTPctRank        int     `nba:"FT_PCT_RANK"`
+	OREBRank         int     `nba:"OREB_RANK"`
+	DREBRank         int     `nba:"DREB_RANK"`
+	REBRank          int     `nba:"REB_RANK"`
+	ASTRank          int     `nba:"AST_RANK"`
+	TOVRank          int     `nba:"TOV_RANK"`
+	STLRank          int     `nba:"STL_RANK"`
+	BLKRank          int     `nba:"BLK_RANK"`
+	BLKARank         int     `nba:"BLKA_RANK"`
+	PFRank           int     `nba:"PF_RANK"`
+	PFDRank          int     `nba:"PFD_RANK"`
+	PTSRank          int     `nba:"PTS_RANK"`
+	PlusMinusRank    int     `nba:"PLUS_MINUS_RANK"`
+}
+
+// TeamPlayerOnOffSummaryPlayersRow is a row of the teamplayeronoffsummary
+// PlayersOffCourtTeamPlayerOnOffSummary and teamplayeronoffsummary
+// PlayersOnCourtTeamPlayerOnOffSummary result sets.
+type TeamPlayerOnOffSummaryPlayersRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	VsPlayerID       int     `nba:"VS_PLAYER_ID"`
+	VsPlayerName     string  `nba:"VS_PLAYER_NAME"`
+	CourtStatus      string  `nba:"COURT_STATUS"`
+	GP               int     `nba:"GP"`
+	Min              float64 `nba:"MIN"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	OffRating        float64 `nba:"OFF_RATING"`
+	DefRating        float64 `nba:"DEF_RATING"`
+	NetRating        float64 `nba:"NET_RATING"`
+}
+
+// TeamVsPlayerOnOffCourtRow is a row of the teamvsplayer OnOffCourt result
+// set.
+type TeamVsPlayerOnOffCourtRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	VsPlayerID       int     `nba:"VS_PLAYER_ID"`
+	VsPlayerName     string  `nba:"VS_PLAYER_NAME"`
+	CourtStatus      string  `nba:"COURT_STATUS"`
+	GP               int     `nba:"GP"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	WPct             float64 `nba:"W_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS    float64 `nba:"NBA_FANTASY_PTS"`
+}
+
+// TeamVsPlayerOverallRow is a row of the teamvsplayer Overall result set.
+type TeamVsPlayerOverallRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	GroupValue       string  `nba:"GROUP_VALUE"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	GP               int     `nba:"GP"`
+	W                int     `nba:"W"`
+	L                int     `nba:"L"`
+	WPct             float64 `nba:"W_PCT"`
+	Min              float64 `nba:"MIN"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3M             float64 `nba:"FG3M"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FTM              float64 `nba:"FTM"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OREB             float64 `nba:"OREB"`
+	DREB             float64 `nba:"DREB"`
+	REB              float64 `nba:"REB"`
+	AST              float64 `nba:"AST"`
+	TOV              float64 `nba:"TOV"`
+	STL              float64 `nba:"STL"`
+	BLK              float64 `nba:"BLK"`
+	BLKA             float64 `nba:"BLKA"`
+	PF               float64 `nba:"PF"`
+	PFD              float64 `nba:"PFD"`
+	PTS              float64 `nba:"PTS"`
+	PlusMinus        float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS    float64 `nba:"NBA_FANTASY_PTS"`
+}
+
+// TeamVsPlayerShotOverallRow is a row of the teamvsplayer ShotAreaOverall and
+// teamvsplayer ShotDistanceOverall result sets.
+type TeamVsPlayerShotOverallRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	GroupValue       string  `nba:"GROUP_VALUE"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+}
+
+// TeamVsPlayerShotRow is a row of the teamvsplayer ShotAreaOffCourt,
+// teamvsplayer ShotAreaOnCourt, teamvsplayer ShotDistanceOffCourt and
+// teamvsplayer ShotDistanceOnCourt result sets.
+type TeamVsPlayerShotRow struct {
+	GroupSet         string  `nba:"GROUP_SET"`
+	TeamID           int     `nba:"TEAM_ID"`
+	TeamAbbreviation string  `nba:"TEAM_ABBREVIATION"`
+	TeamName         string  `nba:"TEAM_NAME"`
+	VsPlayerID       int     `nba:"VS_PLAYER_ID"`
+	VsPlayerName     string  `nba:"VS_PLAYER_NAME"`
+	CourtStatus      string  `nba:"COURT_STATUS"`
+	GroupValue       string  `nba:"GROUP_VALUE"`
+	FGM              float64 `nba:"FGM"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+}
+
+// TeamVsPlayerVsPlayerOverallRow is a row of the teamvsplayer vsPlayerOverall
+// result set.
+type TeamVsPlayerVsPlayerOverallRow struct {
+	GroupSet      string  `nba:"GROUP_SET"`
+	GroupValue    string  `nba:"GROUP_VALUE"`
+	PlayerID      int     `nba:"PLAYER_ID"`
+	PlayerName    string  `nba:"PLAYER_NAME"`
+	GP            int     `nba:"GP"`
+	W             int     `nba:"W"`
+	L             int     `nba:"L"`
+	WPct          float64 `nba:"W_PCT"`
+	Min           float64 `nba:"MIN"`
+	FGM           float64 `nba:"FGM"`
+	FGA           float64 `nba:"FGA"`
+	FGPct         float64 `nba:"FG_PCT"`
+	FG3M          float64 `nba:"FG3M"`
+	FG3A          float64 `nba:"FG3A"`
+	FG3Pct        float64 `nba:"FG3_PCT"`
+	FTM           float64 `nba:"FTM"`
+	FTA           float64 `nba:"FTA"`
+	FTPct         float64 `nba:"FT_PCT"`
+	OREB          float64 `nba:"OREB"`
+	DREB          float64 `nba:"DREB"`
+	REB           float64 `nba:"REB"`
+	AST           float64 `nba:"AST"`
+	TOV           float64 `nba:"TOV"`
+	STL           float64 `nba:"STL"`
+	BLK           float64 `nba:"BLK"`
+	BLKA          float64 `nba:"BLKA"`
+	PF            float64 `nba:"PF"`
+	PFD           float64 `nba:"PFD"`
+	PTS           float64 `nba:"PTS"`
+	PlusMinus     float64 `nba:"PLUS_MINUS"`
+	NBAFantasyPTS float64 `nba:"NBA_FANTASY_PTS"`
+}
+
+// TeamYearByYearStatsTeamStatsRow is a row of the teamyearbyyearstats
+// TeamStats result set.
+type TeamYearByYearStatsTeamStatsRow struct {
+	TeamID              int     `nba:"TEAM_ID"`
+	TeamCity            string  `nba:"TEAM_CITY"`
+	TeamName            string  `nba:"TEAM_NAME"`
+	Year                string  `nba:"YEAR"`
+	GP                  int     `nba:"GP"`
+	Wins                float64 `nba:"WINS"`
+	Losses              float64 `nba:"LOSSES"`
+	WinPct              float64 `nba:"WIN_PCT"`
+	ConfRank            int     `nba:"CONF_RANK"`
+	DivRank             int     `nba:"DIV_RANK"`
+	PoWins              float64 `nba:"PO_WINS"`
+	PoLosses            float64 `nba:"PO_LOSSES"`
+	ConfCount           float64 `nba:"CONF_COUNT"`
+	DivCount            float64 `nba:"DIV_COUNT"`
+	NBAFinalsAppearance string  `nba:"NBA_FINALS_APPEARANCE"`
+	FGM                 float64 `nba:"FGM"`
+	FGA                 float64 `nba:"FGA"`
+	FGPct               float64 `nba:"FG_PCT"`
+	FG3M                float64 `nba:"FG3M"`
+	FG3A                float64 `nba:"FG3A"`
+	FG3Pct              float64 `nba:"FG3_PCT"`
+	FTM                 float64 `nba:"FTM"`
+	FTA                 float64 `nba:"FTA"`
+	FTPct               float64 `nba:"FT_PCT"`
+	OREB                float64 `nba:"OREB"`
+	DREB                float64 `nba:"DREB"`
+	REB                 float64 `nba:"REB"`
+	AST                 float64 `nba:"AST"`
+	PF                  float64 `nba:"PF"`
+	STL                 float64 `nba:"STL"`
+	TOV                 float64 `nba:"TOV"`
+	BLK                 float64 `nba:"BLK"`
+	PTS                 float64 `nba:"PTS"`
+	PTSRank             int     `nba:"PTS_RANK"`
+}
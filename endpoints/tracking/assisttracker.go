@@ -73,25 +73,13 @@ func (c *Client) GetAssistTracker(ctx context.Context, params AssistTrackerParam
 		"Weight": params.WeightNullable,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "assisttracker", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "assisttracker", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch assisttracker",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch assisttracker: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from assisttracker endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal assisttracker response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched assisttracker",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
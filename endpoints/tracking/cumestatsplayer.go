@@ -41,25 +41,13 @@ func (c *Client) GetCumeStatsPlayer(ctx context.Context, params CumeStatsPlayerP
 		"SeasonType": seasonType,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "cumestatsplayer", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "cumestatsplayer", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch cumestatsplayer",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch cumestatsplayer: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from cumestatsplayer endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal cumestatsplayer response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched cumestatsplayer",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
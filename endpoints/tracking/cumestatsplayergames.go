@@ -61,25 +61,13 @@ func (c *Client) GetCumeStatsPlayerGames(ctx context.Context, params CumeStatsPl
 		reqParams["VsTeamID"] = params.VsTeamIdNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "cumestatsplayergames", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "cumestatsplayergames", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch cumestatsplayergames",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch cumestatsplayergames: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from cumestatsplayergames endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal cumestatsplayergames response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched cumestatsplayergames",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -41,25 +41,13 @@ func (c *Client) GetCumeStatsTeam(ctx context.Context, params CumeStatsTeamParam
 		"SeasonType": seasonType,
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "cumestatsteam", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "cumestatsteam", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch cumestatsteam",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch cumestatsteam: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from cumestatsteam endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal cumestatsteam response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched cumestatsteam",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -65,25 +65,13 @@ func (c *Client) GetCumeStatsTeamGames(ctx context.Context, params CumeStatsTeam
 		reqParams["VsTeamID"] = params.VsTeamIdNullable
 	}
 
-	resp, err := c.httpClient.SendRequest(ctx, "cumestatsteamgames", reqParams)
-	if err != nil {
+	var statsResp StatsResponse
+	if _, err := c.httpClient.Get(ctx, "cumestatsteamgames", reqParams, &statsResp); err != nil {
 		c.logger.ErrorContext(ctx, "Failed to fetch cumestatsteamgames",
 			slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to fetch cumestatsteamgames: %w", err)
 	}
 
-	if !resp.IsValidJSON() {
-		c.logger.ErrorContext(ctx, "Invalid JSON response from cumestatsteamgames endpoint")
-		return nil, fmt.Errorf("invalid JSON response")
-	}
-
-	var statsResp StatsResponse
-	if err := resp.GetJSON(&statsResp); err != nil {
-		c.logger.ErrorContext(ctx, "Failed to unmarshal cumestatsteamgames response",
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
-
 	c.logger.InfoContext(ctx, "Successfully fetched cumestatsteamgames",
 		slog.Int("result_sets_count", len(statsResp.ResultSets)))
 
@@ -0,0 +1,342 @@
+// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.
+
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AssistTrackerResult holds the decoded result sets of the assisttracker
+// endpoint.
+type AssistTrackerResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	AssistTracker []AssistTrackerRow
+}
+
+// GetAssistTrackerTyped is like GetAssistTracker but decodes the result sets
+// into a AssistTrackerResult.
+func (c *Client) GetAssistTrackerTyped(ctx context.Context, params AssistTrackerParams) (*AssistTrackerResult, error) {
+	resp, err := c.GetAssistTracker(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AssistTrackerResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"AssistTracker": &result.AssistTracker,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode assisttracker: %w", err)
+	}
+	return result, nil
+}
+
+// CumeStatsPlayerResult holds the decoded result sets of the cumestatsplayer
+// endpoint.
+type CumeStatsPlayerResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	GameByGameStats  []CumeStatsPlayerGameByGameStatsRow
+	TotalPlayerStats []CumeStatsPlayerTotalPlayerStatsRow
+}
+
+// GetCumeStatsPlayerTyped is like GetCumeStatsPlayer but decodes the result
+// sets into a CumeStatsPlayerResult.
+func (c *Client) GetCumeStatsPlayerTyped(ctx context.Context, params CumeStatsPlayerParams) (*CumeStatsPlayerResult, error) {
+	resp, err := c.GetCumeStatsPlayer(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CumeStatsPlayerResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"GameByGameStats":  &result.GameByGameStats,
+		"TotalPlayerStats": &result.TotalPlayerStats,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode cumestatsplayer: %w", err)
+	}
+	return result, nil
+}
+
+// CumeStatsPlayerGamesResult holds the decoded result sets of the
+// cumestatsplayergames endpoint.
+type CumeStatsPlayerGamesResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	CumeStatsPlayerGames []CumeStatsPlayerGamesRow
+}
+
+// GetCumeStatsPlayerGamesTyped is like GetCumeStatsPlayerGames but decodes
+// the result sets into a CumeStatsPlayerGamesResult.
+func (c *Client) GetCumeStatsPlayerGamesTyped(ctx context.Context, params CumeStatsPlayerGamesParams) (*CumeStatsPlayerGamesResult, error) {
+	resp, err := c.GetCumeStatsPlayerGames(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CumeStatsPlayerGamesResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"CumeStatsPlayerGames": &result.CumeStatsPlayerGames,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode cumestatsplayergames: %w", err)
+	}
+	return result, nil
+}
+
+// CumeStatsTeamResult holds the decoded result sets of the cumestatsteam
+// endpoint.
+type CumeStatsTeamResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	GameByGameStats []CumeStatsTeamGameByGameStatsRow
+	TotalTeamStats  []CumeStatsTeamTotalTeamStatsRow
+}
+
+// GetCumeStatsTeamTyped is like GetCumeStatsTeam but decodes the result sets
+// into a CumeStatsTeamResult.
+func (c *Client) GetCumeStatsTeamTyped(ctx context.Context, params CumeStatsTeamParams) (*CumeStatsTeamResult, error) {
+	resp, err := c.GetCumeStatsTeam(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CumeStatsTeamResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"GameByGameStats": &result.GameByGameStats,
+		"TotalTeamStats":  &result.TotalTeamStats,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode cumestatsteam: %w", err)
+	}
+	return result, nil
+}
+
+// CumeStatsTeamGamesResult holds the decoded result sets of the
+// cumestatsteamgames endpoint.
+type CumeStatsTeamGamesResult struct {
+	// Raw is the response the result sets were decoded from.
+	Raw *StatsResponse
+
+	CumeStatsTeamGames []CumeStatsTeamGamesRow
+}
+
+// GetCumeStatsTeamGamesTyped is like GetCumeStatsTeamGames but decodes the
+// result sets into a CumeStatsTeamGamesResult.
+func (c *Client) GetCumeStatsTeamGamesTyped(ctx context.Context, params CumeStatsTeamGamesParams) (*CumeStatsTeamGamesResult, error) {
+	resp, err := c.GetCumeStatsTeamGames(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CumeStatsTeamGamesResult{Raw: resp}
+	if err := resp.DecodeAll(map[string]interface{}{
+		"CumeStatsTeamGames": &result.CumeStatsTeamGames,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode cumestatsteamgames: %w", err)
+	}
+	return result, nil
+}
+
+// AssistTrackerRow is a row of the assisttracker AssistTracker result set.
+type AssistTrackerRow struct {
+	Assists float64 `nba:"ASSISTS"`
+}
+
+// CumeStatsPlayerGameByGameStatsRow is a row of the cumestatsplayer
+// GameByGameStats result set.
+type CumeStatsPlayerGameByGameStatsRow struct {
+	DateEST       time.Time `nba:"DATE_EST"`
+	VisitorTeam   string    `nba:"VISITOR_TEAM"`
+	HomeTeam      string    `nba:"HOME_TEAM"`
+	GP            int       `nba:"GP"`
+	GS            int       `nba:"GS"`
+	ActualMinutes float64   `nba:"ACTUAL_MINUTES"`
+	ActualSeconds float64   `nba:"ACTUAL_SECONDS"`
+	FG            float64   `nba:"FG"`
+	FGA           float64   `nba:"FGA"`
+	FGPct         float64   `nba:"FG_PCT"`
+	FG3           float64   `nba:"FG3"`
+	FG3A          float64   `nba:"FG3A"`
+	FG3Pct        float64   `nba:"FG3_PCT"`
+	FT            float64   `nba:"FT"`
+	FTA           float64   `nba:"FTA"`
+	FTPct         float64   `nba:"FT_PCT"`
+	OffREB        float64   `nba:"OFF_REB"`
+	DefREB        float64   `nba:"DEF_REB"`
+	TotREB        float64   `nba:"TOT_REB"`
+	AST           float64   `nba:"AST"`
+	PF            float64   `nba:"PF"`
+	Dq            float64   `nba:"DQ"`
+	STL           float64   `nba:"STL"`
+	Turnovers     float64   `nba:"TURNOVERS"`
+	BLK           float64   `nba:"BLK"`
+	PTS           float64   `nba:"PTS"`
+	AvgSec        float64   `nba:"AVG_SEC"`
+}
+
+// CumeStatsPlayerGamesRow is a row of the cumestatsplayergames
+// CumeStatsPlayerGames result set.
+type CumeStatsPlayerGamesRow struct {
+	Matchup string `nba:"MATCHUP"`
+	GameID  string `nba:"GAME_ID"`
+}
+
+// CumeStatsPlayerTotalPlayerStatsRow is a row of the cumestatsplayer
+// TotalPlayerStats result set.
+type CumeStatsPlayerTotalPlayerStatsRow struct {
+	DisplayFiLast    string  `nba:"DISPLAY_FI_LAST"`
+	PersonID         int     `nba:"PERSON_ID"`
+	JerseyNum        string  `nba:"JERSEY_NUM"`
+	GP               int     `nba:"GP"`
+	GS               int     `nba:"GS"`
+	ActualMinutes    float64 `nba:"ACTUAL_MINUTES"`
+	ActualSeconds    float64 `nba:"ACTUAL_SECONDS"`
+	FG               float64 `nba:"FG"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3              float64 `nba:"FG3"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FT               float64 `nba:"FT"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OffREB           float64 `nba:"OFF_REB"`
+	DefREB           float64 `nba:"DEF_REB"`
+	TotREB           float64 `nba:"TOT_REB"`
+	AST              float64 `nba:"AST"`
+	PF               float64 `nba:"PF"`
+	Dq               float64 `nba:"DQ"`
+	STL              float64 `nba:"STL"`
+	Turnovers        float64 `nba:"TURNOVERS"`
+	BLK              float64 `nba:"BLK"`
+	PTS              float64 `nba:"PTS"`
+	MaxActualMinutes float64 `nba:"MAX_ACTUAL_MINUTES"`
+	MaxActualSeconds float64 `nba:"MAX_ACTUAL_SECONDS"`
+	MaxREB           float64 `nba:"MAX_REB"`
+	MaxAST           float64 `nba:"MAX_AST"`
+	MaxSTL           float64 `nba:"MAX_STL"`
+	MaxTurnovers     float64 `nba:"MAX_TURNOVERS"`
+	MaxBlkp          float64 `nba:"MAX_BLKP"`
+	MaxPTS           float64 `nba:"MAX_PTS"`
+	AvgActualMinutes float64 `nba:"AVG_ACTUAL_MINUTES"`
+	AvgActualSeconds float64 `nba:"AVG_ACTUAL_SECONDS"`
+	AvgREB           float64 `nba:"AVG_REB"`
+	AvgAST           float64 `nba:"AVG_AST"`
+	AvgSTL           float64 `nba:"AVG_STL"`
+	AvgTurnovers     float64 `nba:"AVG_TURNOVERS"`
+	AvgBlkp          float64 `nba:"AVG_BLKP"`
+	AvgPTS           float64 `nba:"AVG_PTS"`
+	PerMinREB        float64 `nba:"PER_MIN_REB"`
+	PerMinAST        float64 `nba:"PER_MIN_AST"`
+	PerMinSTL        float64 `nba:"PER_MIN_STL"`
+	PerMinTurnovers  float64 `nba:"PER_MIN_TURNOVERS"`
+	PerMinBLK        float64 `nba:"PER_MIN_BLK"`
+	PerMinPTS        float64 `nba:"PER_MIN_PTS"`
+}
+
+// CumeStatsTeamGameByGameStatsRow is a row of the cumestatsteam
+// GameByGameStats result set.
+type CumeStatsTeamGameByGameStatsRow struct {
+	JerseyNum        string  `nba:"JERSEY_NUM"`
+	Player           string  `nba:"PLAYER"`
+	PersonID         int     `nba:"PERSON_ID"`
+	TeamID           int     `nba:"TEAM_ID"`
+	GP               int     `nba:"GP"`
+	GS               int     `nba:"GS"`
+	ActualMinutes    float64 `nba:"ACTUAL_MINUTES"`
+	ActualSeconds    float64 `nba:"ACTUAL_SECONDS"`
+	FG               float64 `nba:"FG"`
+	FGA              float64 `nba:"FGA"`
+	FGPct            float64 `nba:"FG_PCT"`
+	FG3              float64 `nba:"FG3"`
+	FG3A             float64 `nba:"FG3A"`
+	FG3Pct           float64 `nba:"FG3_PCT"`
+	FT               float64 `nba:"FT"`
+	FTA              float64 `nba:"FTA"`
+	FTPct            float64 `nba:"FT_PCT"`
+	OffREB           float64 `nba:"OFF_REB"`
+	DefREB           float64 `nba:"DEF_REB"`
+	TotREB           float64 `nba:"TOT_REB"`
+	AST              float64 `nba:"AST"`
+	PF               float64 `nba:"PF"`
+	Dq               float64 `nba:"DQ"`
+	STL              float64 `nba:"STL"`
+	Turnovers        float64 `nba:"TURNOVERS"`
+	BLK              float64 `nba:"BLK"`
+	PTS              float64 `nba:"PTS"`
+	MaxActualMinutes float64 `nba:"MAX_ACTUAL_MINUTES"`
+	MaxActualSeconds float64 `nba:"MAX_ACTUAL_SECONDS"`
+	MaxREB           float64 `nba:"MAX_REB"`
+	MaxAST           float64 `nba:"MAX_AST"`
+	MaxSTL           float64 `nba:"MAX_STL"`
+	MaxTurnovers     float64 `nba:"MAX_TURNOVERS"`
+	MaxBLK           float64 `nba:"MAX_BLK"`
+	MaxPTS           float64 `nba:"MAX_PTS"`
+	AvgActualMinutes float64 `nba:"AVG_ACTUAL_MINUTES"`
+	AvgActualSeconds float64 `nba:"AVG_ACTUAL_SECONDS"`
+	AvgREB           float64 `nba:"AVG_REB"`
+	AvgAST           float64 `nba:"AVG_AST"`
+	AvgSTL           float64 `nba:"AVG_STL"`
+	AvgTurnovers     float64 `nba:"AVG_TURNOVERS"`
+	AvgBLK           float64 `nba:"AVG_BLK"`
+	AvgPTS           float64 `nba:"AVG_PTS"`
+	PerMinREB        float64 `nba:"PER_MIN_REB"`
+	PerMinAST        float64 `nba:"PER_MIN_AST"`
+	PerMinSTL        float64 `nba:"PER_MIN_STL"`
+	PerMinTurnovers  float64 `nba:"PER_MIN_TURNOVERS"`
+	PerMinBLK        float64 `nba:"PER_MIN_BLK"`
+	PerMinPTS        float64 `nba:"PER_MIN_PTS"`
+}
+
+// CumeStatsTeamGamesRow is a row of the cumestatsteamgames CumeStatsTeamGames
+// result set.
+type CumeStatsTeamGamesRow struct {
+	Matchup string `nba:"MATCHUP"`
+	GameID  string `nba:"GAME_ID"`
+}
+
+// CumeStatsTeamTotalTeamStatsRow is a row of the cumestatsteam TotalTeamStats
+// result set.
+type CumeStatsTeamTotalTeamStatsRow struct {
+	City           string  `nba:"CITY"`
+	Nickname       string  `nba:"NICKNAME"`
+	TeamID         int     `nba:"TEAM_ID"`
+	W              int     `nba:"W"`
+	L              int     `nba:"L"`
+	WHome          float64 `nba:"W_HOME"`
+	LHome          float64 `nba:"L_HOME"`
+	WRoad          float64 `nba:"W_ROAD"`
+	LRoad          float64 `nba:"L_ROAD"`
+	TeamTurnovers  float64 `nba:"TEAM_TURNOVERS"`
+	TeamRebounds   float64 `nba:"TEAM_REBOUNDS"`
+	GP             int     `nba:"GP"`
+	GS             int     `nba:"GS"`
+	ActualMinutes  float64 `nba:"ACTUAL_MINUTES"`
+	ActualSeconds  float64 `nba:"ACTUAL_SECONDS"`
+	FG             float64 `nba:"FG"`
+	FGA            float64 `nba:"FGA"`
+	FGPct          float64 `nba:"FG_PCT"`
+	FG3            float64 `nba:"FG3"`
+	FG3A           float64 `nba:"FG3A"`
+	FG3Pct         float64 `nba:"FG3_PCT"`
+	FT             float64 `nba:"FT"`
+	FTA            float64 `nba:"FTA"`
+	FTPct          float64 `nba:"FT_PCT"`
+	OffREB         float64 `nba:"OFF_REB"`
+	DefREB         float64 `nba:"DEF_REB"`
+	TotREB         float64 `nba:"TOT_REB"`
+	AST            float64 `nba:"AST"`
+	PF             float64 `nba:"PF"`
+	STL            float64 `nba:"STL"`
+	TotalTurnovers float64 `nba:"TOTAL_TURNOVERS"`
+	BLK            float64 `nba:"BLK"`
+	PTS            float64 `nba:"PTS"`
+	AvgREB         float64 `nba:"AVG_REB"`
+	AvgPTS         float64 `nba:"AVG_PTS"`
+	Dq             float64 `nba:"DQ"`
+}
@@ -3,6 +3,7 @@ module github.com/utkonoser/nba-api-go
 go 1.25.1
 
 require (
+	github.com/andybalholm/brotli v1.2.0
 	github.com/stretchr/testify v1.10.0
 	golang.org/x/text v0.21.0
 )
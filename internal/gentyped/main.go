@@ -0,0 +1,651 @@
+// Command gentyped generates the typed result structs and GetXxxTyped methods
+// of the stats endpoint packages.
+//
+// It finds every GetXxx method that fetches a stats endpoint, reads the
+// result sets and columns of that endpoint from its nbatest fixture, and
+// writes typed_gen.go into each endpoint package. Each package has a
+// go:generate directive, so a package is regenerated with
+//
+//	go generate ./endpoints/player
+//
+// and all of them with go generate ./endpoints/... or, from the module root,
+// go run ./internal/gentyped.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/utkonoser/nba-api-go/endpoints/stats"
+)
+
+const (
+	endpointsDir = "endpoints"
+	fixturesDir  = "nbatest/fixtures/stats"
+	outputFile   = "typed_gen.go"
+)
+
+// nested lists endpoints that return nested JSON rather than result sets.
+var nested = map[string]bool{
+	"boxscoreadvancedv3":    true,
+	"boxscoredefensivev2":   true,
+	"boxscorefourfactorsv3": true,
+	"boxscorematchupsv3":    true,
+	"boxscoremiscv3":        true,
+	"boxscoreplayertrackv3": true,
+	"boxscorescoringv3":     true,
+	"boxscoresummaryv3":     true,
+	"boxscoretraditionalv3": true,
+	"boxscoreusagev3":       true,
+	"iststandings":          true,
+	"playbyplayv3":          true,
+	"scheduleleaguev2":      true,
+	"scoreboardv3":          true,
+}
+
+// rowNames renames generated row types so that endpoints returning the same
+// columns share one type.
+var rowNames = map[string]string{
+	"PlayerCareerStatsSeasonTotalsRow":                   "PlayerSeasonTotalsRow",
+	"PlayerProfileV2SeasonTotalsRow":                     "PlayerSeasonTotalsRow",
+	"PlayerCareerStatsCareerTotalsRow":                   "PlayerCareerTotalsRow",
+	"PlayerProfileV2CareerTotalsRow":                     "PlayerCareerTotalsRow",
+	"PlayerCareerStatsSeasonRankingsRow":                 "PlayerSeasonRankingsRow",
+	"PlayerProfileV2SeasonRankingsRow":                   "PlayerSeasonRankingsRow",
+	"PlayerCareerStatsCareerTotalsCollegeSeasonRow":      "PlayerCollegeCareerTotalsRow",
+	"PlayerProfileV2CareerTotalsCollegeSeasonRow":        "PlayerCollegeCareerTotalsRow",
+	"PlayerCareerStatsSeasonTotalsCollegeSeasonRow":      "PlayerCollegeSeasonTotalsRow",
+	"PlayerProfileV2SeasonTotalsCollegeSeasonRow":        "PlayerCollegeSeasonTotalsRow",
+	"PlayerDashboardByClutchPlayerDashboardRow":          "PlayerDashboardRow",
+	"PlayerDashboardByGameSplitsPlayerDashboardRow":      "PlayerDashboardRow",
+	"PlayerDashboardByGeneralSplitsRow":                  "PlayerDashboardRow",
+	"PlayerDashboardByLastNGamesPlayerDashboardRow":      "PlayerDashboardRow",
+	"PlayerDashboardByTeamPerformancePlayerDashboardRow": "PlayerDashboardRow",
+}
+
+// initialisms are column words spelled in upper case in field names.
+var initialisms = map[string]bool{
+	"ID": true, "URL": true, "NBA": true, "WL": true, "GP": true, "GS": true,
+	"FG": true, "FG3": true, "FT": true, "FGM": true, "FGA": true, "FG3M": true,
+	"FG3A": true, "FTM": true, "FTA": true, "OREB": true, "DREB": true,
+	"REB": true, "AST": true, "STL": true, "BLK": true, "BLKA": true,
+	"TOV": true, "PF": true, "PFD": true, "PTS": true, "DD2": true, "TD3": true,
+	"TS": true, "EFG": true, "PIE": true, "EST": true,
+}
+
+// intColumns are numeric columns decoded as int rather than float64, in
+// addition to IDs, years and ranks.
+var intColumns = map[string]bool{
+	"GP": true, "GS": true, "W": true, "L": true, "NUMSEASONS": true,
+	"SEASONEXP": true, "SEASONSWITHTEAM": true, "ROUNDNUMBER": true,
+	"ROUNDPICK": true, "OVERALLPICK": true, "GAMENUM": true, "PERIOD": true,
+	"HUSTLESTATUS": true, "ROSTERSTATUS": true, "ARENACAPACITY": true,
+	"VIDEOAVAILABLE": true,
+}
+
+// dateColumns are string columns decoded as time.Time.
+var dateColumns = map[string]bool{
+	"GAMEDATE": true, "GAMEDATEEST": true, "BIRTHDATE": true, "DATEEST": true,
+	"STARTDATE": true, "ENDDATE": true,
+}
+
+// method is a GetXxx method fetching a stats endpoint.
+type method struct {
+	pkg      string
+	name     string
+	params   string
+	endpoint string
+	sets     []resultSet
+}
+
+// resultSet is a result set of a fixture.
+type resultSet struct {
+	Name    string
+	Headers []string
+	RowSet  [][]interface{}
+	row     *rowType
+}
+
+// rowType is a generated row struct.
+type rowType struct {
+	name   string
+	fields []field
+	usedBy []string
+}
+
+// field is a field of a row struct.
+type field struct {
+	name   string
+	typ    string
+	column string
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gentyped: ")
+
+	only, err := enterModuleRoot()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	methods, err := findMethods()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	byPkg := make(map[string][]*method)
+	for _, m := range methods {
+		if nested[m.endpoint] || (only != "" && m.pkg != only) {
+			continue
+		}
+		sets, err := loadFixture(m.endpoint)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(sets) == 0 {
+			continue
+		}
+		m.sets = sets
+		byPkg[m.pkg] = append(byPkg[m.pkg], m)
+	}
+
+	for pkg, methods := range byPkg {
+		src, err := generate(pkg, methods)
+		if err != nil {
+			log.Fatalf("%s: %v", pkg, err)
+		}
+		if err := os.WriteFile(filepath.Join(endpointsDir, pkg, outputFile), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// findMethods parses the endpoint packages for GetXxx methods.
+// enterModuleRoot changes to the module root, where the paths of gentyped
+// are relative to. When run by go generate in an endpoint package, it
+// returns the name of that package, the only one to generate.
+func enterModuleRoot() (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	root := wd
+	for {
+		if _, err := os.Stat(filepath.Join(root, "go.mod")); err == nil {
+			break
+		}
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", fmt.Errorf("no go.mod above %s", wd)
+		}
+		root = parent
+	}
+	if err := os.Chdir(root); err != nil {
+		return "", fmt.Errorf("failed to change to module root: %w", err)
+	}
+
+	if os.Getenv("GOPACKAGE") == "" {
+		return "", nil
+	}
+	rel, err := filepath.Rel(root, wd)
+	if err != nil {
+		return "", err
+	}
+	if filepath.Dir(rel) != endpointsDir {
+		return "", fmt.Errorf("go generate must run in an endpoint package, not %s", rel)
+	}
+	return filepath.Base(rel), nil
+}
+
+func findMethods() ([]*method, error) {
+	dirs, err := os.ReadDir(endpointsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []*method
+	fset := token.NewFileSet()
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(endpointsDir, dir.Name(), "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range files {
+			if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == outputFile {
+				continue
+			}
+			file, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return nil, err
+			}
+			for _, decl := range file.Decls {
+				if m := parseMethod(dir.Name(), decl); m != nil {
+					methods = append(methods, m)
+				}
+			}
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+	return methods, nil
+}
+
+// parseMethod returns the method declared by decl if it is a GetXxx method
+// taking a params struct and calling c.httpClient.Get with a literal
+// endpoint name.
+func parseMethod(pkg string, decl ast.Decl) *method {
+	fn, ok := decl.(*ast.FuncDecl)
+	if !ok || fn.Recv == nil || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Get") {
+		return nil
+	}
+	params := fn.Type.Params.List
+	if len(params) != 2 {
+		return nil
+	}
+	paramsType, ok := params[1].Type.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	m := &method{pkg: pkg, name: fn.Name.Name, params: paramsType.Name}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Get" {
+			return true
+		}
+		recv, ok := sel.X.(*ast.SelectorExpr)
+		if !ok || recv.Sel.Name != "httpClient" {
+			return true
+		}
+		if lit, ok := call.Args[1].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			m.endpoint, _ = strconv.Unquote(lit.Value)
+		}
+		return false
+	})
+	if m.endpoint == "" {
+		return nil
+	}
+	return m
+}
+
+// loadFixture returns the result sets of the fixture of endpoint.
+func loadFixture(endpoint string) ([]resultSet, error) {
+	data, err := os.ReadFile(filepath.Join(fixturesDir, endpoint+".json"))
+	if err != nil {
+		return nil, err
+	}
+	var resp stats.Response
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture of %s: %w", endpoint, err)
+	}
+
+	sets := make([]resultSet, len(resp.ResultSets))
+	for i, rs := range resp.ResultSets {
+		sets[i] = resultSet{Name: rs.Name, Headers: rs.Headers, RowSet: rs.RowSet}
+	}
+	return sets, nil
+}
+
+// generate returns the source of typed_gen.go for pkg.
+func generate(pkg string, methods []*method) ([]byte, error) {
+	rows := make(map[string]*rowType)
+	for _, m := range methods {
+		if err := assignRows(m, rows); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gentyped from the nbatest fixtures. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import (\n\t\"context\"\n\t\"fmt\"\n")
+	if usesTime(rows) {
+		fmt.Fprintf(&buf, "\t\"time\"\n")
+	}
+	fmt.Fprintf(&buf, ")\n")
+
+	for _, m := range methods {
+		writeMethod(&buf, m)
+	}
+
+	names := make([]string, 0, len(rows))
+	for name := range rows {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeRow(&buf, rows[name])
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+// assignRows assigns a row type to every result set of m. Result sets with
+// the same columns share a type.
+func assignRows(m *method, rows map[string]*rowType) error {
+	base := strings.TrimPrefix(m.name, "Get")
+
+	groups := make(map[string][]int)
+	var order []string
+	for i, rs := range m.sets {
+		key := strings.Join(rs.Headers, ",")
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	taken := make(map[string]bool)
+	for _, key := range order {
+		var names []string
+		for _, i := range groups[key] {
+			names = append(names, typeName(m.sets[i].Name))
+		}
+		name := rowName(base, names, false)
+		if renamed, ok := rowNames[name]; ok {
+			name = renamed
+		}
+		if taken[name] {
+			// Another group of this endpoint already has the name, e.g. the
+			// on/off court shot sets next to the overall ones.
+			name = rowName(base, names, true)
+		}
+		taken[name] = true
+
+		row, ok := rows[name]
+		if !ok {
+			row = &rowType{name: name, fields: fields(m.sets[groups[key][0]])}
+			rows[name] = row
+		} else if !sameFields(row.fields, fields(m.sets[groups[key][0]])) {
+			return fmt.Errorf("%s is used for result sets with different columns", name)
+		}
+		for _, i := range groups[key] {
+			m.sets[i].row = row
+			row.usedBy = append(row.usedBy, m.endpoint+" "+m.sets[i].Name)
+		}
+	}
+	return nil
+}
+
+// rowName returns the row type name of the result sets names of the
+// endpoint base. If both is set, the name is built from the common prefix
+// and suffix of names rather than just one of them.
+func rowName(base string, names []string, both bool) string {
+	common := names[0]
+	if len(names) > 1 {
+		prefix, suffix := commonWords(names)
+		switch {
+		case both || prefix == "":
+			common = prefix + suffix
+		default:
+			common = prefix
+		}
+	}
+	if common == base || strings.HasPrefix(common, base) {
+		common = strings.TrimPrefix(common, base)
+	}
+	return base + common + "Row"
+}
+
+// commonWords returns the longest common word prefix and suffix of names.
+func commonWords(names []string) (string, string) {
+	split := make([][]string, len(names))
+	for i, name := range names {
+		split[i] = camelWords(name)
+	}
+
+	prefix := split[0]
+	suffix := split[0]
+	for _, words := range split[1:] {
+		n := 0
+		for n < len(prefix) && n < len(words) && prefix[n] == words[n] {
+			n++
+		}
+		prefix = prefix[:n]
+
+		n = 0
+		for n < len(suffix) && n < len(words) && suffix[len(suffix)-1-n] == words[len(words)-1-n] {
+			n++
+		}
+		suffix = suffix[len(suffix)-n:]
+	}
+	return strings.Join(prefix, ""), strings.Join(suffix, "")
+}
+
+// fields returns the fields of the row type of rs.
+func fields(rs resultSet) []field {
+	var fields []field
+	seen := make(map[string]int)
+	for i, header := range rs.Headers {
+		name := fieldName(header)
+		if seen[name]++; seen[name] > 1 {
+			name += strconv.Itoa(seen[name])
+		}
+		fields = append(fields, field{name: name, typ: fieldType(header, column(rs, i)), column: header})
+	}
+	return fields
+}
+
+// column returns the values of column i of rs.
+func column(rs resultSet, i int) []interface{} {
+	var values []interface{}
+	for _, row := range rs.RowSet {
+		if i < len(row) {
+			values = append(values, row[i])
+		}
+	}
+	return values
+}
+
+// fieldType returns the Go type of a column from its name and values.
+func fieldType(header string, values []interface{}) string {
+	key := normalize(header)
+
+	isString, isInt := false, true
+	for _, v := range values {
+		switch x := v.(type) {
+		case string:
+			isString = true
+		case bool:
+			return "bool"
+		case float64:
+			if x != float64(int64(x)) {
+				isInt = false
+			}
+		}
+	}
+
+	switch {
+	case isString && dateColumns[key]:
+		return "time.Time"
+	case isString:
+		return "string"
+	case isInt && (strings.HasSuffix(key, "ID") || strings.Contains(key, "YEAR") ||
+		strings.HasSuffix(key, "RANK") || intColumns[key]):
+		return "int"
+	}
+	return "float64"
+}
+
+// sameFields reports whether a and b declare the same fields. Columns are
+// compared the way ResultSet.Decode matches them, ignoring case and
+// underscores.
+func sameFields(a, b []field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].name != b[i].name || a[i].typ != b[i].typ ||
+			normalize(a[i].column) != normalize(b[i].column) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize returns the key ResultSet.Decode matches a column by.
+func normalize(column string) string {
+	return strings.ToUpper(strings.ReplaceAll(column, "_", ""))
+}
+
+// usesTime reports whether any row has a time.Time field.
+func usesTime(rows map[string]*rowType) bool {
+	for _, row := range rows {
+		for _, f := range row.fields {
+			if f.typ == "time.Time" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeMethod writes the result struct and typed method of m.
+func writeMethod(buf *bytes.Buffer, m *method) {
+	base := strings.TrimPrefix(m.name, "Get")
+	result := base + "Result"
+
+	fmt.Fprintf(buf, "\n%s\n", wrapComment(fmt.Sprintf(
+		"%s holds the decoded result sets of the %s endpoint.", result, m.endpoint)))
+	fmt.Fprintf(buf, "type %s struct {\n", result)
+	fmt.Fprintf(buf, "\t// Raw is the response the result sets were decoded from.\n")
+	fmt.Fprintf(buf, "\tRaw *StatsResponse\n\n")
+	for _, rs := range m.sets {
+		fmt.Fprintf(buf, "\t%s []%s\n", typeName(rs.Name), rs.row.name)
+	}
+	fmt.Fprintf(buf, "}\n")
+
+	fmt.Fprintf(buf, "\n%s\n", wrapComment(fmt.Sprintf(
+		"%sTyped is like %s but decodes the result sets into a %s.", m.name, m.name, result)))
+	fmt.Fprintf(buf, "func (c *Client) %sTyped(ctx context.Context, params %s) (*%s, error) {\n", m.name, m.params, result)
+	fmt.Fprintf(buf, "\tresp, err := c.%s(ctx, params)\n", m.name)
+	fmt.Fprintf(buf, "\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	fmt.Fprintf(buf, "\tresult := &%s{Raw: resp}\n", result)
+	fmt.Fprintf(buf, "\tif err := resp.DecodeAll(map[string]interface{}{\n")
+	for _, rs := range m.sets {
+		fmt.Fprintf(buf, "\t\t%q: &result.%s,\n", rs.Name, typeName(rs.Name))
+	}
+	fmt.Fprintf(buf, "\t}); err != nil {\n")
+	fmt.Fprintf(buf, "\t\treturn nil, fmt.Errorf(\"failed to decode %s: %%w\", err)\n", m.endpoint)
+	fmt.Fprintf(buf, "\t}\n\treturn result, nil\n}\n")
+}
+
+// writeRow writes the declaration of row.
+func writeRow(buf *bytes.Buffer, row *rowType) {
+	doc := fmt.Sprintf("%s is a row of the %s result set", row.name, joinNames(row.usedBy))
+	if len(row.usedBy) > 1 {
+		doc += "s"
+	}
+	fmt.Fprintf(buf, "\n%s\n", wrapComment(doc+"."))
+	fmt.Fprintf(buf, "type %s struct {\n", row.name)
+	for _, f := range row.fields {
+		fmt.Fprintf(buf, "\t%s %s `nba:%q`\n", f.name, f.typ, f.column)
+	}
+	fmt.Fprintf(buf, "}\n")
+}
+
+// joinNames joins names as an English list.
+func joinNames(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+// wrapComment formats text as a line comment wrapped at 78 columns.
+func wrapComment(text string) string {
+	var lines []string
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 78 && line != "//" {
+			lines = append(lines, line)
+			line = "//"
+		}
+		line += " " + word
+	}
+	return strings.Join(append(lines, line), "\n")
+}
+
+// typeName turns a result set name into an exported identifier.
+func typeName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// fieldName turns a column name into an exported identifier.
+func fieldName(header string) string {
+	var words []string
+	if strings.Contains(header, "_") || strings.ToUpper(header) == header {
+		words = strings.Split(header, "_")
+	} else {
+		words = camelWords(header)
+	}
+
+	var b strings.Builder
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		upper := strings.ToUpper(word)
+		if initialisms[upper] {
+			b.WriteString(upper)
+		} else {
+			b.WriteString(upper[:1] + strings.ToLower(word[1:]))
+		}
+	}
+	name := b.String()
+	if name == "" || !unicode.IsLetter(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// camelWords splits a camel case identifier into words, keeping runs of
+// upper case letters such as "ID" together.
+func camelWords(s string) []string {
+	runes := []rune(s)
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		next := rune(0)
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		if unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+			(unicode.IsUpper(prev) && unicode.IsLower(next))) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(words, string(runes[start:]))
+}
@@ -0,0 +1,161 @@
+// Command vcrseed writes the replay fixtures of the integration tests from
+// the nbatest payloads, so the tests can be replayed without access to the
+// NBA API.
+//
+// The fixtures it writes are not recordings: every seeded response carries
+// an X-Nbatest-Fixture header naming the nbatest payload it was built from.
+// vcrseed replaces the seeded fixtures and keeps the ones recorded from the
+// NBA API with make test-record. Run it from the module root:
+//
+//	go run ./internal/vcrseed
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/utkonoser/nba-api-go/nbatest"
+	"github.com/utkonoser/nba-api-go/vcr"
+)
+
+const (
+	endpointsDir = "endpoints"
+	fixturesDir  = "testdata/fixtures"
+
+	// seedHeader marks the responses built from nbatest payloads.
+	seedHeader = "X-Nbatest-Fixture"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("vcrseed: ")
+
+	removed, err := removeSeeded()
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("removed %d seeded fixtures", removed)
+
+	srv := httptest.NewServer(http.HandlerFunc(serveFixture))
+	defer srv.Close()
+
+	// Fixtures that exist are replayed, so only the seeded ones removed
+	// above and those of new tests are written.
+	cmd := exec.Command("go", "test", "-tags=integration", "-count=1", "-run", "Integration", "./"+endpointsDir+"/...")
+	cmd.Env = append(os.Environ(),
+		vcr.ModeEnv+"="+vcr.ModeReplayOrRecord.String(),
+		vcr.UpstreamEnv+"="+srv.URL)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Fatalf("failed to run the integration tests: %v", err)
+	}
+}
+
+// removeSeeded removes the fixtures written by a previous run.
+func removeSeeded() (int, error) {
+	dirs, err := filepath.Glob(filepath.Join(endpointsDir, "*", fixturesDir))
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(path) != ".json" {
+				return err
+			}
+			seeded, err := isSeeded(path)
+			if err != nil {
+				return err
+			}
+			if !seeded {
+				return nil
+			}
+			removed++
+			return os.Remove(path)
+		})
+		if err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+// isSeeded reports whether the fixture at path was written by vcrseed.
+func isSeeded(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	var f struct {
+		Response struct {
+			Header http.Header `json:"header"`
+		} `json:"response"`
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return false, fmt.Errorf("failed to decode fixture %s: %w", path, err)
+	}
+	return f.Response.Header.Get(seedHeader) != "", nil
+}
+
+// serveFixture answers a request with the nbatest payload of its endpoint,
+// echoing the request parameters like stats.nba.com does.
+func serveFixture(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := strings.CutPrefix(r.URL.Path, nbatest.StatsPrefix)
+	if !ok {
+		endpoint, ok = strings.CutPrefix(r.URL.Path, nbatest.LivePrefix)
+	}
+	if !ok {
+		http.Error(w, fmt.Sprintf("vcrseed: unknown path %s", r.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	body, err := nbatest.Fixture(endpoint)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	body, err = echoParameters(body, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set(seedHeader, endpoint)
+	// Without a Date header the fixtures only change with their payloads.
+	w.Header()["Date"] = nil
+	_, _ = w.Write(body)
+}
+
+// echoParameters replaces the "parameters" object of a stats payload with
+// the query parameters of r.
+func echoParameters(body []byte, r *http.Request) ([]byte, error) {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture: %w", err)
+	}
+	if _, ok := payload["parameters"]; !ok {
+		return body, nil
+	}
+
+	params := make(map[string]string)
+	for key, values := range r.URL.Query() {
+		params[key] = values[0]
+	}
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode parameters: %w", err)
+	}
+	payload["parameters"] = encoded
+	return json.Marshal(payload)
+}
@@ -0,0 +1,239 @@
+// Package nba provides a single client for every NBA API endpoint package.
+//
+// A Client owns one http.Client (and therefore one connection pool), one
+// logger, and optionally one cache and rate limiter, all shared by the
+// endpoint package clients it exposes:
+//
+//	c := nba.NewClient(nil)
+//	career, err := c.Player.GetPlayerCareerStats(ctx, player.PlayerCareerStatsParams{PlayerId: "2544"})
+//	scoreboard, err := c.Live.GetScoreboard(ctx)
+package nba
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/utkonoser/nba-api-go/client"
+	"github.com/utkonoser/nba-api-go/endpoints/boxscore"
+	"github.com/utkonoser/nba-api-go/endpoints/draft"
+	"github.com/utkonoser/nba-api-go/endpoints/franchise"
+	"github.com/utkonoser/nba-api-go/endpoints/game"
+	"github.com/utkonoser/nba-api-go/endpoints/leaders"
+	"github.com/utkonoser/nba-api-go/endpoints/league"
+	"github.com/utkonoser/nba-api-go/endpoints/live"
+	"github.com/utkonoser/nba-api-go/endpoints/misc"
+	"github.com/utkonoser/nba-api-go/endpoints/player"
+	"github.com/utkonoser/nba-api-go/endpoints/playoff"
+	"github.com/utkonoser/nba-api-go/endpoints/schedule"
+	"github.com/utkonoser/nba-api-go/endpoints/shot"
+	"github.com/utkonoser/nba-api-go/endpoints/team"
+	"github.com/utkonoser/nba-api-go/endpoints/tracking"
+)
+
+const (
+	statsBaseURL = "https://stats.nba.com/stats/%s"
+	liveBaseURL  = "https://cdn.nba.com/static/json/liveData/%s"
+)
+
+// Config configures a Client. The zero value is valid and uses the same
+// defaults as the endpoint package constructors.
+type Config struct {
+	// Logger is used by every endpoint client. Defaults to slog.Default().
+	Logger *slog.Logger
+	// HTTPClient is shared by all requests. Defaults to an http.Client
+	// without a timeout; request timeouts are set by TimeoutPolicy.
+	HTTPClient *http.Client
+	// StatsHeaders are sent to stats.nba.com. Defaults to client.DefaultStatsHeaders().
+	StatsHeaders map[string]string
+	// LiveHeaders are sent to cdn.nba.com. Defaults to client.DefaultLiveHeaders().
+	LiveHeaders map[string]string
+	// RetryPolicy overrides client.DefaultRetryPolicy when set.
+	RetryPolicy *client.RetryPolicy
+	// RateLimiter is shared by all requests when set.
+	RateLimiter *client.RateLimiter
+	// Cache is shared by all requests when set.
+	Cache client.Cache
+	// CachePolicy overrides client.DefaultCachePolicy when set.
+	CachePolicy *client.CachePolicy
+	// Interceptors run around every request.
+	Interceptors []client.Interceptor
+	// Instrumentation receives metrics and tracing events for every request.
+	Instrumentation client.Instrumentation
+	// Deduplicate coalesces concurrent identical requests into one.
+	Deduplicate bool
+	// CircuitBreaker fails requests fast while a host is unhealthy. It is
+	// shared by every endpoint client and tracks each host separately.
+	CircuitBreaker *client.CircuitBreaker
+	// HeaderRotator chooses the browser headers of every request, replacing
+	// the User-Agent and client hints of StatsHeaders and LiveHeaders.
+	// Without it the default headers are sent unchanged.
+	HeaderRotator *client.HeaderRotator
+	// TimeoutPolicy overrides client.DefaultTimeoutPolicy when set.
+	TimeoutPolicy *client.TimeoutPolicy
+}
+
+// Client provides access to every NBA API endpoint package through a
+// single shared configuration.
+type Client struct {
+	Boxscore  *boxscore.Client
+	Draft     *draft.Client
+	Franchise *franchise.Client
+	Game      *game.Client
+	Leaders   *leaders.Client
+	League    *league.Client
+	Live      *live.Client
+	Misc      *misc.Client
+	Player    *player.Client
+	Playoff   *playoff.Client
+	Schedule  *schedule.Client
+	Shot      *shot.Client
+	Team      *team.Client
+	Tracking  *tracking.Client
+
+	stats  *client.HTTPClient
+	cdn    *client.HTTPClient
+	logger *slog.Logger
+}
+
+// NewClient creates a new NBA API client with default configuration.
+func NewClient(logger *slog.Logger) *Client {
+	return NewClientWithConfig(Config{Logger: logger})
+}
+
+// NewClientWithConfig creates a new NBA API client with the given configuration.
+func NewClientWithConfig(config Config) *Client {
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	statsHeaders := config.StatsHeaders
+	if statsHeaders == nil {
+		statsHeaders = client.DefaultStatsHeaders()
+	}
+
+	liveHeaders := config.LiveHeaders
+	if liveHeaders == nil {
+		liveHeaders = client.DefaultLiveHeaders()
+	}
+
+	stats := newHTTPClient(statsBaseURL, statsHeaders, httpClient, config, logger)
+	cdn := newHTTPClient(liveBaseURL, liveHeaders, httpClient, config, logger)
+
+	return &Client{
+		Boxscore:  boxscore.NewClientWithHTTPClient(stats, logger),
+		Draft:     draft.NewClientWithHTTPClient(stats, logger),
+		Franchise: franchise.NewClientWithHTTPClient(stats, logger),
+		Game:      game.NewClientWithHTTPClient(stats, logger),
+		Leaders:   leaders.NewClientWithHTTPClient(stats, logger),
+		League:    league.NewClientWithHTTPClient(stats, logger),
+		Live:      live.NewClientWithHTTPClient(cdn, logger),
+		Misc:      misc.NewClientWithHTTPClient(stats, logger),
+		Player:    player.NewClientWithHTTPClient(stats, logger),
+		Playoff:   playoff.NewClientWithHTTPClient(stats, logger),
+		Schedule:  schedule.NewClientWithHTTPClient(stats, logger),
+		Shot:      shot.NewClientWithHTTPClient(stats, logger),
+		Team:      team.NewClientWithHTTPClient(stats, logger),
+		Tracking:  tracking.NewClientWithHTTPClient(stats, logger),
+		stats:     stats,
+		cdn:       cdn,
+		logger:    logger,
+	}
+}
+
+// newHTTPClient creates an HTTP client for baseURL using the shared settings.
+func newHTTPClient(baseURL string, headers map[string]string, httpClient *http.Client, config Config, logger *slog.Logger) *client.HTTPClient {
+	c := client.NewHTTPClient(baseURL, headers, logger)
+	c.SetHTTPClient(httpClient)
+	if config.RetryPolicy != nil {
+		c.SetRetryPolicy(*config.RetryPolicy)
+	}
+	if config.RateLimiter != nil {
+		c.SetRateLimiter(config.RateLimiter)
+	}
+	if config.Cache != nil {
+		c.SetCache(config.Cache)
+	}
+	if config.CachePolicy != nil {
+		c.SetCachePolicy(*config.CachePolicy)
+	}
+	c.Use(config.Interceptors...)
+	if config.Instrumentation != nil {
+		c.SetInstrumentation(config.Instrumentation)
+	}
+	c.SetDeduplication(config.Deduplicate)
+	c.SetCircuitBreaker(config.CircuitBreaker)
+	c.SetHeaderRotator(config.HeaderRotator)
+	if config.TimeoutPolicy != nil {
+		c.SetTimeoutPolicy(*config.TimeoutPolicy)
+	}
+	return c
+}
+
+// SetRetryPolicy sets the retry policy for every endpoint client.
+func (c *Client) SetRetryPolicy(policy client.RetryPolicy) {
+	c.stats.SetRetryPolicy(policy)
+	c.cdn.SetRetryPolicy(policy)
+}
+
+// SetRateLimiter sets the rate limiter for every endpoint client.
+func (c *Client) SetRateLimiter(limiter *client.RateLimiter) {
+	c.stats.SetRateLimiter(limiter)
+	c.cdn.SetRateLimiter(limiter)
+}
+
+// SetCache sets the cache for every endpoint client; nil disables caching.
+func (c *Client) SetCache(cache client.Cache) {
+	c.stats.SetCache(cache)
+	c.cdn.SetCache(cache)
+}
+
+// SetCachePolicy sets the cache policy for every endpoint client.
+func (c *Client) SetCachePolicy(policy client.CachePolicy) {
+	c.stats.SetCachePolicy(policy)
+	c.cdn.SetCachePolicy(policy)
+}
+
+// Use adds interceptors that run around every request of every endpoint client.
+func (c *Client) Use(interceptors ...client.Interceptor) {
+	c.stats.Use(interceptors...)
+	c.cdn.Use(interceptors...)
+}
+
+// SetInstrumentation sets the metrics and tracing hooks for every endpoint client.
+func (c *Client) SetInstrumentation(instrumentation client.Instrumentation) {
+	c.stats.SetInstrumentation(instrumentation)
+	c.cdn.SetInstrumentation(instrumentation)
+}
+
+// SetDeduplication enables or disables coalescing of concurrent identical
+// requests for every endpoint client.
+func (c *Client) SetDeduplication(enabled bool) {
+	c.stats.SetDeduplication(enabled)
+	c.cdn.SetDeduplication(enabled)
+}
+
+// SetCircuitBreaker sets the circuit breaker for every endpoint client; nil
+// disables it.
+func (c *Client) SetCircuitBreaker(breaker *client.CircuitBreaker) {
+	c.stats.SetCircuitBreaker(breaker)
+	c.cdn.SetCircuitBreaker(breaker)
+}
+
+// SetHeaderRotator sets the header rotator for every endpoint client; nil
+// disables rotation.
+func (c *Client) SetHeaderRotator(rotator *client.HeaderRotator) {
+	c.stats.SetHeaderRotator(rotator)
+	c.cdn.SetHeaderRotator(rotator)
+}
+
+// SetTimeoutPolicy sets the timeout policy for every endpoint client.
+func (c *Client) SetTimeoutPolicy(policy client.TimeoutPolicy) {
+	c.stats.SetTimeoutPolicy(policy)
+	c.cdn.SetTimeoutPolicy(policy)
+}
@@ -0,0 +1,128 @@
+package nba
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/client"
+	"github.com/utkonoser/nba-api-go/endpoints/player"
+)
+
+// rewriteTransport sends every request to target and records the original hosts.
+type rewriteTransport struct {
+	target *url.URL
+
+	mu    sync.Mutex
+	hosts []string
+}
+
+func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.hosts = append(t.hosts, req.URL.Host)
+	t.mu.Unlock()
+
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewClient(t *testing.T) {
+	c := NewClient(nil)
+
+	require.NotNil(t, c)
+	assert.NotNil(t, c.Boxscore)
+	assert.NotNil(t, c.Draft)
+	assert.NotNil(t, c.Franchise)
+	assert.NotNil(t, c.Game)
+	assert.NotNil(t, c.Leaders)
+	assert.NotNil(t, c.League)
+	assert.NotNil(t, c.Live)
+	assert.NotNil(t, c.Misc)
+	assert.NotNil(t, c.Player)
+	assert.NotNil(t, c.Playoff)
+	assert.NotNil(t, c.Schedule)
+	assert.NotNil(t, c.Shot)
+	assert.NotNil(t, c.Team)
+	assert.NotNil(t, c.Tracking)
+	assert.NotNil(t, c.logger)
+	assert.Same(t, c.stats.HTTPClient(), c.cdn.HTTPClient())
+}
+
+func TestNewClientWithConfig(t *testing.T) {
+	limiter := client.NewDefaultRateLimiter()
+	retry := client.NoRetryPolicy()
+	httpClient := &http.Client{}
+
+	c := NewClientWithConfig(Config{
+		HTTPClient:  httpClient,
+		RetryPolicy: &retry,
+		RateLimiter: limiter,
+	})
+
+	assert.Same(t, httpClient, c.stats.HTTPClient())
+	assert.Same(t, httpClient, c.cdn.HTTPClient())
+	assert.Same(t, limiter, c.stats.RateLimiter())
+	assert.Same(t, limiter, c.cdn.RateLimiter())
+	assert.Equal(t, 1, c.stats.RetryPolicy().MaxAttempts)
+	assert.Equal(t, 1, c.cdn.RetryPolicy().MaxAttempts)
+
+	breaker := client.NewCircuitBreaker(client.DefaultBreakerConfig())
+	c.SetCircuitBreaker(breaker)
+
+	assert.Same(t, breaker, c.stats.CircuitBreaker())
+	assert.Same(t, breaker, c.cdn.CircuitBreaker())
+}
+
+func TestClient_SharedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Path == "/stats/playercareerstats" {
+			_, _ = w.Write([]byte(`{"resource": "playercareerstats", "resultSets": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"scoreboard": {"gameDate": "2024-01-01", "games": []}}`))
+	}))
+	defer server.Close()
+
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	transport := &rewriteTransport{target: target}
+
+	c := NewClientWithConfig(Config{HTTPClient: &http.Client{Transport: transport}})
+
+	career, err := c.Player.GetPlayerCareerStats(context.Background(), player.PlayerCareerStatsParams{PlayerId: "2544"})
+	require.NoError(t, err)
+	assert.Equal(t, "playercareerstats", career.Resource)
+
+	scoreboard, err := c.Live.GetScoreboard(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01", scoreboard.Scoreboard.GameDate)
+
+	assert.Equal(t, []string{client.StatsHost, client.CDNHost}, transport.hosts)
+}
+
+func TestClient_Setters(t *testing.T) {
+	c := NewClient(nil)
+	limiter := client.NewRateLimiter(client.Limit{RequestsPerSecond: 1})
+
+	c.SetRateLimiter(limiter)
+	c.SetRetryPolicy(client.NoRetryPolicy())
+
+	assert.Same(t, limiter, c.stats.RateLimiter())
+	assert.Same(t, limiter, c.cdn.RateLimiter())
+	assert.Equal(t, 1, c.stats.RetryPolicy().MaxAttempts)
+	assert.Equal(t, 1, c.cdn.RetryPolicy().MaxAttempts)
+
+	breaker := client.NewCircuitBreaker(client.DefaultBreakerConfig())
+	c.SetCircuitBreaker(breaker)
+
+	assert.Same(t, breaker, c.stats.CircuitBreaker())
+	assert.Same(t, breaker, c.cdn.CircuitBreaker())
+}
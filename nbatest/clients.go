@@ -0,0 +1,142 @@
+package nbatest
+
+import (
+	"log/slog"
+	"time"
+
+	nba "github.com/utkonoser/nba-api-go"
+	"github.com/utkonoser/nba-api-go/client"
+	"github.com/utkonoser/nba-api-go/endpoints/boxscore"
+	"github.com/utkonoser/nba-api-go/endpoints/draft"
+	"github.com/utkonoser/nba-api-go/endpoints/franchise"
+	"github.com/utkonoser/nba-api-go/endpoints/game"
+	"github.com/utkonoser/nba-api-go/endpoints/leaders"
+	"github.com/utkonoser/nba-api-go/endpoints/league"
+	"github.com/utkonoser/nba-api-go/endpoints/live"
+	"github.com/utkonoser/nba-api-go/endpoints/misc"
+	"github.com/utkonoser/nba-api-go/endpoints/player"
+	"github.com/utkonoser/nba-api-go/endpoints/playoff"
+	"github.com/utkonoser/nba-api-go/endpoints/schedule"
+	"github.com/utkonoser/nba-api-go/endpoints/shot"
+	"github.com/utkonoser/nba-api-go/endpoints/team"
+	"github.com/utkonoser/nba-api-go/endpoints/tracking"
+)
+
+// RetryPolicy returns the retry policy of the pre-wired clients: the default
+// attempts and retryable status codes with millisecond delays, so that
+// scripted 429 and 5xx responses do not slow tests down.
+func RetryPolicy() client.RetryPolicy {
+	policy := client.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 10 * time.Millisecond
+	policy.Jitter = 0
+	return policy
+}
+
+// Options returns the client options that send requests to the server.
+func (s *Server) Options() []client.Option {
+	return []client.Option{client.WithHTTPClient(s.HTTPClient())}
+}
+
+// Config returns an nba.Config that sends requests to the server. Tests may
+// adjust it before passing it to nba.NewClientWithConfig.
+func (s *Server) Config() nba.Config {
+	policy := RetryPolicy()
+	return nba.Config{
+		Logger:      s.logger,
+		HTTPClient:  s.HTTPClient(),
+		RetryPolicy: &policy,
+	}
+}
+
+// NBA returns an nba.Client whose endpoint clients all use the server.
+func (s *Server) NBA() *nba.Client {
+	return nba.NewClientWithConfig(s.Config())
+}
+
+// retrier is implemented by every endpoint client.
+type retrier interface {
+	SetRetryPolicy(policy client.RetryPolicy)
+}
+
+// wire applies the test retry policy to an endpoint client.
+func wire[C retrier](c C) C {
+	c.SetRetryPolicy(RetryPolicy())
+	return c
+}
+
+// Boxscore returns a boxscore client that uses the server.
+func (s *Server) Boxscore() *boxscore.Client {
+	return wire(boxscore.NewClient(s.logger, s.Options()...))
+}
+
+// Draft returns a draft client that uses the server.
+func (s *Server) Draft() *draft.Client {
+	return wire(draft.NewClient(s.logger, s.Options()...))
+}
+
+// Franchise returns a franchise client that uses the server.
+func (s *Server) Franchise() *franchise.Client {
+	return wire(franchise.NewClient(s.logger, s.Options()...))
+}
+
+// Game returns a game client that uses the server.
+func (s *Server) Game() *game.Client {
+	return wire(game.NewClient(s.logger, s.Options()...))
+}
+
+// Leaders returns a leaders client that uses the server.
+func (s *Server) Leaders() *leaders.Client {
+	return wire(leaders.NewClient(s.logger, s.Options()...))
+}
+
+// League returns a league client that uses the server.
+func (s *Server) League() *league.Client {
+	return wire(league.NewClient(s.logger, s.Options()...))
+}
+
+// Live returns a live data client that uses the server.
+func (s *Server) Live() *live.Client {
+	return wire(live.NewClient(s.logger, s.Options()...))
+}
+
+// Misc returns a misc client that uses the server.
+func (s *Server) Misc() *misc.Client {
+	return wire(misc.NewClient(s.logger, s.Options()...))
+}
+
+// Player returns a player client that uses the server.
+func (s *Server) Player() *player.Client {
+	return wire(player.NewClient(s.logger, s.Options()...))
+}
+
+// Playoff returns a playoff client that uses the server.
+func (s *Server) Playoff() *playoff.Client {
+	return wire(playoff.NewClient(s.logger, s.Options()...))
+}
+
+// Schedule returns a schedule client that uses the server.
+func (s *Server) Schedule() *schedule.Client {
+	return wire(schedule.NewClient(s.logger, s.Options()...))
+}
+
+// Shot returns a shot client that uses the server.
+func (s *Server) Shot() *shot.Client {
+	return wire(shot.NewClient(s.logger, s.Options()...))
+}
+
+// Team returns a team client that uses the server.
+func (s *Server) Team() *team.Client {
+	return wire(team.NewClient(s.logger, s.Options()...))
+}
+
+// Tracking returns a tracking client that uses the server.
+func (s *Server) Tracking() *tracking.Client {
+	return wire(tracking.NewClient(s.logger, s.Options()...))
+}
+
+// SetLogger sets the logger of clients created afterwards. By default they
+// discard their logs.
+func (s *Server) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
@@ -0,0 +1,401 @@
+// Package nbatest provides an in-process fake of stats.nba.com and
+// cdn.nba.com for testing code built on this module without network access.
+//
+// A Server answers every endpoint supported by the endpoint packages with a
+// fixture payload that has the shape of the real response: every result set
+// with its full column list and the value types of stats.nba.com, trimmed to
+// two rows of 2023-24 data. Responses can be scripted per endpoint to
+// simulate errors, throttling, latency or malformed JSON, and the requests
+// received can be asserted on:
+//
+//	func TestSync(t *testing.T) {
+//		srv := nbatest.NewServer(t)
+//		srv.Enqueue("playergamelog", nbatest.RateLimited(time.Second))
+//
+//		err := mypkg.Sync(context.Background(), srv.NBA())
+//
+//		require.NoError(t, err)
+//		srv.AssertCallCount(t, "playergamelog", 2)
+//		srv.AssertCalled(t, "playergamelog", map[string]string{"PlayerID": "2544"})
+//	}
+//
+// Clients returned by the server send requests to the real NBA hosts through
+// a transport that redirects them to the fake, so the code under test does
+// not need a configurable base URL.
+package nbatest
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// StatsPrefix is the URL path prefix of stats.nba.com endpoints.
+	StatsPrefix = "/stats/"
+	// LivePrefix is the URL path prefix of cdn.nba.com live data endpoints.
+	LivePrefix = "/static/json/liveData/"
+)
+
+//go:embed fixtures
+var fixtures embed.FS
+
+// Server is a fake NBA API server. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, e.g. "http://127.0.0.1:50123".
+	URL string
+
+	server *httptest.Server
+	logger *slog.Logger
+
+	mu       sync.Mutex
+	queued   map[string][]Response
+	handlers map[string]Response
+	latency  time.Duration
+	requests []Request
+}
+
+// Request is a request received by the server.
+type Request struct {
+	// Endpoint is the endpoint name as passed to client.HTTPClient, e.g.
+	// "playergamelog" or "scoreboard/todaysScoreboard_00.json".
+	Endpoint string
+	// Host is the host the request was sent to, e.g. "stats.nba.com".
+	Host   string
+	Params map[string]string
+	Header http.Header
+}
+
+// NewServer starts a server that is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		logger:   slog.New(slog.DiscardHandler),
+		queued:   make(map[string][]Response),
+		handlers: make(map[string]Response),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// StatsURL returns the base URL format of the fake stats.nba.com, for use
+// with client.WithBaseURL.
+func (s *Server) StatsURL() string {
+	return s.URL + StatsPrefix + "%s"
+}
+
+// LiveURL returns the base URL format of the fake cdn.nba.com, for use with
+// client.WithBaseURL.
+func (s *Server) LiveURL() string {
+	return s.URL + LivePrefix + "%s"
+}
+
+// Enqueue queues one-shot responses for endpoint. They are served in order
+// to the next requests for the endpoint; afterwards the endpoint answers as
+// before.
+func (s *Server) Enqueue(endpoint string, responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queued[endpoint] = append(s.queued[endpoint], responses...)
+}
+
+// Handle makes endpoint answer every request with response instead of its
+// fixture. Queued responses still take precedence.
+func (s *Server) Handle(endpoint string, response Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[endpoint] = response
+}
+
+// SetLatency delays every response by d, in addition to Response.Delay.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// Reset removes scripted responses and recorded requests.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queued = make(map[string][]Response)
+	s.handlers = make(map[string]Response)
+	s.latency = 0
+	s.requests = nil
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestsTo returns the requests received for endpoint, in order.
+func (s *Server) RequestsTo(endpoint string) []Request {
+	var requests []Request
+	for _, r := range s.Requests() {
+		if r.Endpoint == endpoint {
+			requests = append(requests, r)
+		}
+	}
+	return requests
+}
+
+// AssertCalled reports a test error unless endpoint received a request whose
+// parameters include params.
+func (s *Server) AssertCalled(t testing.TB, endpoint string, params map[string]string) bool {
+	t.Helper()
+
+	requests := s.RequestsTo(endpoint)
+	for _, r := range requests {
+		if hasParams(r.Params, params) {
+			return true
+		}
+	}
+	if len(requests) == 0 {
+		t.Errorf("nbatest: %s was not called", endpoint)
+		return false
+	}
+	received := make([]map[string]string, len(requests))
+	for i, r := range requests {
+		received[i] = r.Params
+	}
+	t.Errorf("nbatest: %s was not called with %v; received %v", endpoint, params, received)
+	return false
+}
+
+// AssertNotCalled reports a test error if endpoint received any request.
+func (s *Server) AssertNotCalled(t testing.TB, endpoint string) bool {
+	t.Helper()
+	if n := len(s.RequestsTo(endpoint)); n > 0 {
+		t.Errorf("nbatest: %s was called %d times, want 0", endpoint, n)
+		return false
+	}
+	return true
+}
+
+// AssertCallCount reports a test error unless endpoint received exactly n
+// requests.
+func (s *Server) AssertCallCount(t testing.TB, endpoint string, n int) bool {
+	t.Helper()
+	if got := len(s.RequestsTo(endpoint)); got != n {
+		t.Errorf("nbatest: %s was called %d times, want %d", endpoint, got, n)
+		return false
+	}
+	return true
+}
+
+// hasParams reports whether got contains every key and value of want.
+func hasParams(got, want map[string]string) bool {
+	for key, value := range want {
+		if got[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// serveHTTP records the request and writes its response.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := endpointOf(r.URL.Path)
+	if !ok {
+		http.Error(w, fmt.Sprintf("nbatest: unknown path %s", r.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	params := make(map[string]string, len(r.URL.Query()))
+	for key, values := range r.URL.Query() {
+		params[key] = values[0]
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Endpoint: endpoint,
+		Host:     r.Host,
+		Params:   params,
+		Header:   r.Header.Clone(),
+	})
+	response, scripted := s.next(endpoint)
+	latency := s.latency
+	s.mu.Unlock()
+
+	if delay := latency + response.Delay; delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if !scripted || response.Body == nil {
+		s.serveFixture(w, r, endpoint, params, response.Header)
+		return
+	}
+
+	for key, values := range response.Header {
+		w.Header()[key] = values
+	}
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	}
+	w.WriteHeader(response.statusCode())
+	_, _ = w.Write(response.Body)
+}
+
+// next returns the scripted response for endpoint, if any. The caller must
+// hold s.mu.
+func (s *Server) next(endpoint string) (Response, bool) {
+	if queue := s.queued[endpoint]; len(queue) > 0 {
+		s.queued[endpoint] = queue[1:]
+		return queue[0], true
+	}
+	response, ok := s.handlers[endpoint]
+	return response, ok
+}
+
+// serveFixture writes the fixture of endpoint. Stats responses echo the
+// request parameters like the real API; live responses carry an ETag and
+// honour If-None-Match like the CDN.
+func (s *Server) serveFixture(w http.ResponseWriter, r *http.Request, endpoint string, params map[string]string, header http.Header) {
+	body, err := Fixture(endpoint)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	for key, values := range header {
+		w.Header()[key] = values
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+
+	if strings.HasPrefix(r.URL.Path, LivePrefix) {
+		sum := sha256.Sum256(body)
+		etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	} else if withParams, err := echoParameters(body, params); err == nil {
+		body = withParams
+	} else {
+		s.logger.Warn("Failed to echo request parameters",
+			slog.String("endpoint", endpoint),
+			slog.String("error", err.Error()))
+	}
+
+	_, _ = w.Write(body)
+}
+
+// echoParameters replaces the "parameters" object of a stats payload.
+func echoParameters(body []byte, params map[string]string) ([]byte, error) {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture: %w", err)
+	}
+	if _, ok := payload["parameters"]; !ok {
+		return body, nil
+	}
+
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode parameters: %w", err)
+	}
+	payload["parameters"] = encoded
+	return json.Marshal(payload)
+}
+
+// endpointOf returns the endpoint name of a request path.
+func endpointOf(urlPath string) (string, bool) {
+	for _, prefix := range []string{StatsPrefix, LivePrefix} {
+		if endpoint, ok := strings.CutPrefix(urlPath, prefix); ok && endpoint != "" {
+			return endpoint, true
+		}
+	}
+	return "", false
+}
+
+// fixturePath returns the path of the fixture of endpoint.
+func fixturePath(endpoint string) string {
+	if strings.Contains(endpoint, "/") {
+		return path.Join("fixtures", "live", endpoint)
+	}
+	return path.Join("fixtures", "stats", endpoint+".json")
+}
+
+// Fixture returns the payload served for endpoint, for example to derive a
+// modified response from it.
+func Fixture(endpoint string) ([]byte, error) {
+	body, err := fixtures.ReadFile(fixturePath(path.Clean(endpoint)))
+	if err != nil {
+		return nil, fmt.Errorf("nbatest: no fixture for endpoint %q: %w", endpoint, err)
+	}
+	return body, nil
+}
+
+// Endpoints returns the names of every endpoint with a fixture, sorted.
+func Endpoints() []string {
+	var endpoints []string
+	_ = fs.WalkDir(fixtures, "fixtures", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if rel, ok := strings.CutPrefix(p, "fixtures/stats/"); ok {
+			endpoints = append(endpoints, strings.TrimSuffix(rel, ".json"))
+		} else if rel, ok := strings.CutPrefix(p, "fixtures/live/"); ok {
+			endpoints = append(endpoints, rel)
+		}
+		return nil
+	})
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// Transport returns a transport that sends requests for any host to the
+// server, keeping their path and query.
+func (s *Server) Transport() http.RoundTripper {
+	base := s.server.Client().Transport
+	target, _ := url.Parse(s.URL)
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		redirected := req.Clone(req.Context())
+		redirected.URL.Scheme = target.Scheme
+		redirected.URL.Host = target.Host
+		redirected.Host = req.URL.Host
+		return base.RoundTrip(redirected)
+	})
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// HTTPClient returns an http.Client that sends every request to the server.
+func (s *Server) HTTPClient() *http.Client {
+	return &http.Client{Transport: s.Transport()}
+}
@@ -0,0 +1,266 @@
+package nbatest_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/client"
+	"github.com/utkonoser/nba-api-go/endpoints/player"
+	"github.com/utkonoser/nba-api-go/nbatest"
+)
+
+type statsPayload struct {
+	Resource   string            `json:"resource"`
+	Parameters map[string]string `json:"parameters"`
+	ResultSets []struct {
+		Name    string          `json:"name"`
+		Headers []string        `json:"headers"`
+		RowSet  [][]interface{} `json:"rowSet"`
+	} `json:"resultSets"`
+}
+
+func TestServer_Fixtures(t *testing.T) {
+	srv := nbatest.NewServer(t)
+	stats := client.NewHTTPClient(srv.StatsURL(), nil, nil)
+	live := client.NewHTTPClient(srv.LiveURL(), nil, nil)
+
+	endpoints := nbatest.Endpoints()
+	require.NotEmpty(t, endpoints)
+
+	for _, endpoint := range endpoints {
+		t.Run(endpoint, func(t *testing.T) {
+			if strings.Contains(endpoint, "/") {
+				var payload map[string]interface{}
+				_, err := live.Get(context.Background(), endpoint, nil, &payload)
+				require.NoError(t, err)
+				assert.NotEmpty(t, payload)
+				return
+			}
+
+			var raw map[string]json.RawMessage
+			_, err := stats.Get(context.Background(), endpoint, map[string]string{"Season": "2023-24"}, &raw)
+			require.NoError(t, err)
+			if _, ok := raw["resource"]; !ok {
+				// V3 endpoints and iststandings return nested JSON rather
+				// than result sets.
+				assert.NotContains(t, raw, "resultSets")
+				assert.NotContains(t, raw, "resultSet")
+				assert.NotEmpty(t, raw)
+				return
+			}
+
+			var payload statsPayload
+			_, err = stats.Get(context.Background(), endpoint, map[string]string{"Season": "2023-24"}, &payload)
+			require.NoError(t, err)
+			assert.Equal(t, endpoint, payload.Resource)
+			assert.Equal(t, "2023-24", payload.Parameters["Season"], "parameters are echoed")
+			for _, set := range payload.ResultSets {
+				for _, row := range set.RowSet {
+					assert.Len(t, row, len(set.Headers), "row width of %s", set.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_PrewiredClients(t *testing.T) {
+	srv := nbatest.NewServer(t)
+
+	resp, err := srv.Player().GetPlayerGameLog(context.Background(), player.PlayerGameLogParams{
+		PlayerId: "2544",
+		Season:   "2023-24",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.ResultSets)
+	assert.NotZero(t, resp.ResultSets[0].RowSet)
+
+	srv.AssertCalled(t, "playergamelog", map[string]string{"PlayerID": "2544", "Season": "2023-24"})
+	requests := srv.RequestsTo("playergamelog")
+	require.Len(t, requests, 1)
+	assert.Equal(t, client.StatsHost, requests[0].Host)
+
+	nba := srv.NBA()
+	scoreboard, err := nba.Live.GetScoreboard(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, scoreboard.Scoreboard.Games, 2)
+
+	again, err := nba.Live.GetScoreboard(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, scoreboard, again, "the fixture is served with an ETag")
+
+	live := srv.RequestsTo("scoreboard/todaysScoreboard_00.json")
+	require.Len(t, live, 2)
+	assert.Equal(t, client.CDNHost, live[0].Host)
+	assert.NotEmpty(t, live[1].Header.Get("If-None-Match"))
+}
+
+func TestServer_ScriptedResponses(t *testing.T) {
+	ctx := context.Background()
+	params := player.PlayerGameLogParams{PlayerId: "201939"}
+
+	t.Run("rate limited then fixture", func(t *testing.T) {
+		srv := nbatest.NewServer(t)
+		srv.Enqueue("playergamelog", nbatest.RateLimited(time.Second), nbatest.Error(http.StatusBadGateway, "Bad Gateway"))
+
+		_, err := srv.Player().GetPlayerGameLog(ctx, params)
+
+		require.NoError(t, err)
+		srv.AssertCallCount(t, "playergamelog", 3)
+	})
+
+	t.Run("persistent error", func(t *testing.T) {
+		srv := nbatest.NewServer(t)
+		srv.Handle("playergamelog", nbatest.Error(http.StatusBadRequest, "The PlayerID property is required."))
+
+		_, err := srv.Player().GetPlayerGameLog(ctx, params)
+
+		var apiErr *client.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.ErrorIs(t, err, client.ErrBadParameter)
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		srv := nbatest.NewServer(t)
+		srv.Handle("playergamelog", nbatest.MalformedJSON())
+
+		_, err := srv.Player().GetPlayerGameLog(ctx, params)
+
+		assert.ErrorIs(t, err, client.ErrInvalidJSON)
+	})
+
+	t.Run("custom JSON", func(t *testing.T) {
+		srv := nbatest.NewServer(t)
+		srv.Enqueue("playergamelog", nbatest.JSON(map[string]interface{}{
+			"resource":   "playergamelog",
+			"resultSets": []interface{}{},
+		}))
+
+		resp, err := srv.Player().GetPlayerGameLog(ctx, params)
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.ResultSets)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		srv := nbatest.NewServer(t)
+		srv.Handle("playergamelog", nbatest.Delayed(time.Second))
+		c := srv.Player()
+		c.SetRetryPolicy(client.NoRetryPolicy())
+
+		_, err := c.GetPlayerGameLog(client.WithRequestTimeout(ctx, 20*time.Millisecond), params)
+
+		assert.ErrorIs(t, err, client.ErrTimeout)
+	})
+}
+
+// recordingT records the errors reported by assertion helpers.
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestServer_Assertions(t *testing.T) {
+	srv := nbatest.NewServer(t)
+	_, err := srv.Player().GetPlayerGameLog(context.Background(), player.PlayerGameLogParams{PlayerId: "2544"})
+	require.NoError(t, err)
+
+	rec := &recordingT{TB: t}
+	assert.True(t, srv.AssertCalled(rec, "playergamelog", map[string]string{"PlayerID": "2544"}))
+	assert.True(t, srv.AssertCallCount(rec, "playergamelog", 1))
+	assert.True(t, srv.AssertNotCalled(rec, "commonplayerinfo"))
+	assert.Empty(t, rec.errors)
+
+	assert.False(t, srv.AssertCalled(rec, "playergamelog", map[string]string{"PlayerID": "201939"}))
+	assert.False(t, srv.AssertCalled(rec, "commonplayerinfo", nil))
+	assert.False(t, srv.AssertCallCount(rec, "playergamelog", 2))
+	assert.False(t, srv.AssertNotCalled(rec, "playergamelog"))
+	assert.Len(t, rec.errors, 4)
+
+	srv.Reset()
+	assert.Empty(t, srv.Requests())
+}
+
+func TestServer_TypedResults(t *testing.T) {
+	srv := nbatest.NewServer(t)
+	clients := map[string]interface{}{
+		"boxscore":  srv.Boxscore(),
+		"draft":     srv.Draft(),
+		"franchise": srv.Franchise(),
+		"game":      srv.Game(),
+		"leaders":   srv.Leaders(),
+		"league":    srv.League(),
+		"misc":      srv.Misc(),
+		"player":    srv.Player(),
+		"playoff":   srv.Playoff(),
+		"shot":      srv.Shot(),
+		"team":      srv.Team(),
+		"tracking":  srv.Tracking(),
+	}
+
+	count := 0
+	for pkg, c := range clients {
+		v := reflect.ValueOf(c)
+		for i := 0; i < v.NumMethod(); i++ {
+			name := v.Type().Method(i).Name
+			if !strings.HasSuffix(name, "Typed") {
+				continue
+			}
+			count++
+			t.Run(pkg+"."+name, func(t *testing.T) {
+				m := v.Method(i)
+				params := reflect.New(m.Type().In(1)).Elem()
+				out := m.Call([]reflect.Value{reflect.ValueOf(context.Background()), params})
+				require.Nil(t, out[1].Interface())
+
+				result := out[0].Elem()
+				raw := result.FieldByName("Raw")
+				if !raw.IsValid() {
+					// Nested models such as the V3 box scores have no result sets.
+					assert.False(t, result.IsZero())
+					return
+				}
+				require.NotNil(t, raw.Interface())
+				sets := raw.Elem().FieldByName("ResultSets")
+				require.Equal(t, result.NumField()-1, sets.Len(), "result sets")
+				for j := 1; j < result.NumField(); j++ {
+					assert.Equal(t, 2, result.Field(j).Len(), "rows of %s", result.Type().Field(j).Name)
+					assertColumnsCovered(t, sets.Index(j-1), result.Type().Field(j).Type.Elem())
+				}
+			})
+		}
+	}
+	assert.Greater(t, count, 70)
+}
+
+// assertColumnsCovered asserts that row has a field for every column of the
+// result set, matched the way ResultSet.Decode matches them.
+func assertColumnsCovered(t *testing.T, set reflect.Value, row reflect.Type) {
+	t.Helper()
+	normalize := func(column string) string {
+		return strings.ToUpper(strings.ReplaceAll(column, "_", ""))
+	}
+
+	fields := make(map[string]bool)
+	for i := 0; i < row.NumField(); i++ {
+		fields[normalize(row.Field(i).Tag.Get("nba"))] = true
+	}
+	name := set.FieldByName("Name").String()
+	for _, header := range set.FieldByName("Headers").Interface().([]string) {
+		assert.True(t, fields[normalize(header)], "%s has no field for column %s of %s", row.Name(), header, name)
+	}
+}
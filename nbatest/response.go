@@ -0,0 +1,82 @@
+package nbatest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response is a scripted response. The zero value serves the fixture of the
+// endpoint.
+type Response struct {
+	// StatusCode defaults to 200.
+	StatusCode int
+	// Body is written as is. If nil, the fixture of the endpoint is served
+	// with StatusCode 200.
+	Body []byte
+	// Header is added to the response headers.
+	Header http.Header
+	// Delay postpones the response, e.g. to trigger client timeouts.
+	Delay time.Duration
+}
+
+// statusCode returns the status code of the response.
+func (r Response) statusCode() int {
+	if r.StatusCode == 0 {
+		return http.StatusOK
+	}
+	return r.StatusCode
+}
+
+// WithDelay returns a copy of r that is delayed by d.
+func (r Response) WithDelay(d time.Duration) Response {
+	r.Delay = d
+	return r
+}
+
+// Delayed returns a response serving the fixture of the endpoint after d.
+func Delayed(d time.Duration) Response {
+	return Response{Delay: d}
+}
+
+// JSON returns a 200 response with v encoded as JSON. It panics if v cannot
+// be encoded.
+func JSON(v any) Response {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("nbatest: failed to encode response: %v", err))
+	}
+	return Response{StatusCode: http.StatusOK, Body: body}
+}
+
+// Error returns a response with the given status code and a plain text
+// message, the way stats.nba.com reports invalid parameters.
+func Error(statusCode int, message string) Response {
+	return Response{
+		StatusCode: statusCode,
+		Body:       []byte(message),
+		Header:     http.Header{"Content-Type": {"text/plain; charset=utf-8"}},
+	}
+}
+
+// RateLimited returns a 429 response asking the client to retry after
+// retryAfter, rounded up to whole seconds. Zero omits the Retry-After header.
+func RateLimited(retryAfter time.Duration) Response {
+	r := Error(http.StatusTooManyRequests, "Too Many Requests")
+	if retryAfter > 0 {
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		r.Header.Set("Retry-After", strconv.Itoa(seconds))
+	}
+	return r
+}
+
+// MalformedJSON returns a 200 response whose body is truncated JSON.
+func MalformedJSON() Response {
+	return Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(`{"resource": "truncated", "resultSets": [{"name": `),
+	}
+}
@@ -0,0 +1,347 @@
+// Package vcr provides a record-and-replay HTTP transport for deterministic,
+// offline tests against real NBA API payloads.
+//
+// In record mode every response is captured to a fixture file; in replay
+// mode responses are served byte-for-byte from those files without touching
+// the network. Requests are matched on host, endpoint path and sorted query
+// parameters.
+package vcr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects how the recorder handles requests.
+type Mode int
+
+const (
+	// ModeOff passes every request through to the underlying transport.
+	ModeOff Mode = iota
+	// ModeRecord sends every request and overwrites its fixture.
+	ModeRecord
+	// ModeReplay serves every request from fixtures and fails on a miss.
+	ModeReplay
+	// ModeReplayOrRecord serves existing fixtures and records missing ones.
+	ModeReplayOrRecord
+)
+
+// ModeEnv is the environment variable read by ModeFromEnv and Setup.
+const ModeEnv = "NBA_VCR_MODE"
+
+// UpstreamEnv is the environment variable read by Setup for a base URL,
+// e.g. "http://127.0.0.1:8080", that recorded requests are sent to instead
+// of their own host. Fixtures are still stored under the original URL, so
+// they replay as if recorded from the NBA API.
+const UpstreamEnv = "NBA_VCR_UPSTREAM"
+
+// ErrFixtureNotFound is returned in replay mode when no fixture matches.
+var ErrFixtureNotFound = errors.New("vcr: fixture not found")
+
+// String returns the name of the mode as accepted by ParseMode.
+func (m Mode) String() string {
+	switch m {
+	case ModeRecord:
+		return "record"
+	case ModeReplay:
+		return "replay"
+	case ModeReplayOrRecord:
+		return "auto"
+	default:
+		return "off"
+	}
+}
+
+// ParseMode parses "off", "record", "replay" or "auto".
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "off":
+		return ModeOff, nil
+	case "record":
+		return ModeRecord, nil
+	case "replay":
+		return ModeReplay, nil
+	case "auto":
+		return ModeReplayOrRecord, nil
+	}
+	return ModeOff, fmt.Errorf("vcr: unknown mode %q", s)
+}
+
+// ModeFromEnv returns the mode configured in the NBA_VCR_MODE variable.
+func ModeFromEnv() (Mode, error) {
+	return ParseMode(os.Getenv(ModeEnv))
+}
+
+// Recorder is an http.RoundTripper that records and replays responses.
+type Recorder struct {
+	dir  string
+	mode Mode
+	next http.RoundTripper
+	// passthrough reports requests that bypass recording and replay.
+	passthrough func(*http.Request) bool
+
+	mu sync.Mutex
+}
+
+// New creates a recorder storing fixtures in dir. If next is nil,
+// http.DefaultTransport is used to send requests.
+func New(dir string, mode Mode, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, mode: mode, next: next}
+}
+
+// Mode returns the recorder mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Setup installs a recorder for dir as http.DefaultTransport using the mode
+// from NBA_VCR_MODE. It is meant to be called from TestMain and returns a
+// function restoring the previous transport. In ModeOff it does nothing.
+// Requests to loopback hosts, such as httptest servers used by unit tests
+// in the same package, are never recorded or replayed. If NBA_VCR_UPSTREAM
+// is set, requests are recorded from that server.
+func Setup(dir string) (func(), error) {
+	mode, err := ModeFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if mode == ModeOff {
+		return func() {}, nil
+	}
+
+	previous := http.DefaultTransport
+	next := previous
+	if upstream := os.Getenv(UpstreamEnv); upstream != "" {
+		target, err := url.Parse(upstream)
+		if err != nil || target.Scheme == "" || target.Host == "" {
+			return nil, fmt.Errorf("vcr: invalid %s %q", UpstreamEnv, upstream)
+		}
+		next = redirect(target, previous)
+	}
+
+	recorder := New(dir, mode, next)
+	recorder.passthrough = isLoopback
+	http.DefaultTransport = recorder
+	return func() { http.DefaultTransport = previous }, nil
+}
+
+// fixture is the on-disk representation of a recorded exchange.
+type fixture struct {
+	Request  fixtureRequest  `json:"request"`
+	Response fixtureResponse `json:"response"`
+}
+
+// fixtureRequest identifies the recorded request.
+type fixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// fixtureResponse holds the recorded response. Plain-text bodies are
+// stored in Body for readability; anything else, such as gzip-compressed
+// payloads, is stored base64-encoded in BodyBase64.
+type fixtureResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.passthrough != nil && r.passthrough(req) {
+		return r.next.RoundTrip(req)
+	}
+
+	switch r.mode {
+	case ModeOff:
+		return r.next.RoundTrip(req)
+	case ModeRecord:
+		return r.record(req)
+	case ModeReplay:
+		return r.replay(req)
+	}
+
+	resp, err := r.replay(req)
+	if errors.Is(err, ErrFixtureNotFound) {
+		return r.record(req)
+	}
+	return resp, err
+}
+
+// replay serves req from its fixture.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	path := r.fixturePath(req)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w for %s %s (%s)", ErrFixtureNotFound, req.Method, req.URL, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("vcr: failed to read fixture: %w", err)
+	}
+
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("vcr: failed to decode fixture %s: %w", path, err)
+	}
+
+	body := []byte(f.Response.Body)
+	if f.Response.BodyBase64 != "" {
+		body, err = base64.StdEncoding.DecodeString(f.Response.BodyBase64)
+		if err != nil {
+			return nil, fmt.Errorf("vcr: failed to decode fixture body %s: %w", path, err)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Response.StatusCode, http.StatusText(f.Response.StatusCode)),
+		StatusCode:    f.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        f.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// record sends req and stores the response as a fixture.
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	closeErr := resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("vcr: failed to read response body: %w", err)
+	}
+	if closeErr != nil {
+		return nil, fmt.Errorf("vcr: failed to close response body: %w", closeErr)
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	f := fixture{
+		Request: fixtureRequest{Method: req.Method, URL: canonicalURL(req.URL)},
+		Response: fixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+		},
+	}
+	if header.Get("Content-Encoding") == "" && utf8.Valid(body) {
+		f.Response.Body = string(body)
+	} else {
+		f.Response.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+
+	if err := r.write(r.fixturePath(req), &f); err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+// write stores f at path atomically.
+func (r *Recorder) write(path string, f *fixture) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("vcr: failed to encode fixture: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("vcr: failed to create fixture directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("vcr: failed to write fixture: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("vcr: failed to store fixture: %w", err)
+	}
+	return nil
+}
+
+// fixturePath returns the fixture file for req. The name starts with the
+// endpoint path for readability and ends with a hash of the request key.
+func (r *Recorder) fixturePath(req *http.Request) string {
+	key := req.Method + " " + canonicalURL(req.URL)
+	sum := sha256.Sum256([]byte(key))
+
+	name := strings.Trim(req.URL.Path, "/")
+	name = strings.NewReplacer("/", "_", ".", "_").Replace(name)
+	if name == "" {
+		name = "root"
+	}
+	return filepath.Join(r.dir, req.URL.Hostname(), name+"-"+hex.EncodeToString(sum[:6])+".json")
+}
+
+// redirectTransport sends requests to another server, keeping their path
+// and query.
+type redirectTransport struct {
+	target *url.URL
+	next   http.RoundTripper
+}
+
+// redirect returns a transport sending every request to target through
+// next.
+func redirect(target *url.URL, next http.RoundTripper) http.RoundTripper {
+	return &redirectTransport{target: target, next: next}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	redirected := req.Clone(req.Context())
+	redirected.URL.Scheme = t.target.Scheme
+	redirected.URL.Host = t.target.Host
+	redirected.Host = req.URL.Host
+	resp, err := t.next.RoundTrip(redirected)
+	if resp != nil {
+		resp.Request = req
+	}
+	return resp, err
+}
+
+// isLoopback reports whether req targets a loopback host.
+func isLoopback(req *http.Request) bool {
+	host := req.URL.Hostname()
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// canonicalURL returns u with its query parameters sorted by key.
+func canonicalURL(u *url.URL) string {
+	c := *u
+	c.RawQuery = c.Query().Encode()
+	c.Fragment = ""
+	return c.String()
+}
@@ -0,0 +1,250 @@
+package vcr
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/utkonoser/nba-api-go/client"
+	"github.com/utkonoser/nba-api-go/endpoints/player"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"resource": "playercareerstats", "resultSets": []}`))
+	}))
+
+	recorder := New(dir, ModeRecord, nil)
+	httpClient := client.NewHTTPClient(server.URL+"/stats/%s", nil, nil,
+		client.WithHTTPClient(&http.Client{Transport: recorder}))
+
+	params := map[string]string{"PlayerID": "2544", "PerMode": "PerGame"}
+	recorded, err := httpClient.SendRequest(context.Background(), "playercareerstats", params)
+	require.NoError(t, err)
+
+	// Replay must not need the network.
+	server.Close()
+
+	replayer := New(dir, ModeReplay, nil)
+	httpClient = client.NewHTTPClient(server.URL+"/stats/%s", nil, nil,
+		client.WithHTTPClient(&http.Client{Transport: replayer}))
+
+	replayed, err := httpClient.SendRequest(context.Background(), "playercareerstats", params)
+	require.NoError(t, err)
+	assert.Equal(t, recorded.GetRaw(), replayed.GetRaw())
+	assert.Equal(t, http.StatusOK, replayed.GetStatusCode())
+
+	files, err := filepath.Glob(filepath.Join(dir, "*", "stats_playercareerstats-*.json"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestRecorder_MatchesSortedParams(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.RawQuery))
+	}))
+	defer server.Close()
+
+	recorder := New(dir, ModeRecord, nil)
+	resp, err := (&http.Client{Transport: recorder}).Get(server.URL + "/stats/teamdetails?b=2&a=1")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	replayer := New(dir, ModeReplay, nil)
+	resp, err = (&http.Client{Transport: replayer}).Get(server.URL + "/stats/teamdetails?a=1&b=2")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, "b=2&a=1", string(body))
+}
+
+func TestRecorder_BinaryBodyByteForByte(t *testing.T) {
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err := gz.Write([]byte(`{"scoreboard": {"games": []}}`))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	dir := t.TempDir()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(compressed.Bytes())
+	}))
+	defer server.Close()
+
+	headers := map[string]string{"Accept-Encoding": "gzip"}
+	recorder := New(dir, ModeRecord, nil)
+	httpClient := client.NewHTTPClient(server.URL+"/%s", headers, nil,
+		client.WithHTTPClient(&http.Client{Transport: recorder}))
+	_, err = httpClient.SendRequest(context.Background(), "scoreboard.json", nil)
+	require.NoError(t, err)
+
+	replayer := New(dir, ModeReplay, nil)
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/scoreboard.json", nil)
+	require.NoError(t, err)
+	resp, err := replayer.RoundTrip(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, compressed.Bytes(), body)
+	assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+
+	httpClient = client.NewHTTPClient(server.URL+"/%s", headers, nil,
+		client.WithHTTPClient(&http.Client{Transport: replayer}))
+	decoded, err := httpClient.SendRequest(context.Background(), "scoreboard.json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `{"scoreboard": {"games": []}}`, decoded.GetRaw())
+}
+
+func TestRecorder_ReplayMiss(t *testing.T) {
+	replayer := New(t.TempDir(), ModeReplay, nil)
+	req, err := http.NewRequest(http.MethodGet, "https://stats.nba.com/stats/teamdetails?TeamID=1", nil)
+	require.NoError(t, err)
+
+	_, err = replayer.RoundTrip(req)
+
+	assert.ErrorIs(t, err, ErrFixtureNotFound)
+}
+
+// offlineTransport fails every request that reaches the network.
+type offlineTransport struct{ t *testing.T }
+
+func (o offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	o.t.Errorf("unexpected network request to %s", req.URL)
+	return nil, errors.New("offline")
+}
+
+func TestRecorder_ReplaysCommittedCassette(t *testing.T) {
+	replayer := New("../endpoints/player/testdata/fixtures", ModeReplay, offlineTransport{t: t})
+	c := player.NewClient(nil, client.WithHTTPClient(&http.Client{Transport: replayer}))
+
+	resp, err := c.GetPlayerCareerStatsTyped(context.Background(), player.PlayerCareerStatsParams{
+		PlayerId:  "2544",
+		PerMode36: "PerGame",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "playercareerstats", resp.Raw.Resource)
+	require.Len(t, resp.SeasonTotalsRegularSeason, 2)
+	season := resp.SeasonTotalsRegularSeason[1]
+	assert.Equal(t, 2544, season.PlayerID)
+	assert.Equal(t, "2023-24", season.SeasonID)
+	assert.Equal(t, "LAL", season.TeamAbbreviation)
+}
+
+func TestRecorder_ReplayOrRecord(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	recorder := New(t.TempDir(), ModeReplayOrRecord, nil)
+	httpClient := &http.Client{Transport: recorder}
+
+	for i := 0; i < 3; i++ {
+		resp, err := httpClient.Get(server.URL + "/stats/drafthistory?LeagueID=00")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Mode
+	}{
+		{input: "", expected: ModeOff},
+		{input: "off", expected: ModeOff},
+		{input: "record", expected: ModeRecord},
+		{input: "REPLAY", expected: ModeReplay},
+		{input: "auto", expected: ModeReplayOrRecord},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			mode, err := ParseMode(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, mode)
+		})
+	}
+
+	_, err := ParseMode("rewind")
+	assert.Error(t, err)
+}
+
+func TestSetup(t *testing.T) {
+	original := http.DefaultTransport
+
+	t.Setenv(ModeEnv, "replay")
+	restore, err := Setup(t.TempDir())
+	require.NoError(t, err)
+
+	recorder, ok := http.DefaultTransport.(*Recorder)
+	require.True(t, ok)
+	assert.Equal(t, ModeReplay, recorder.Mode())
+
+	req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:8080/stats/teamdetails", nil)
+	require.NoError(t, err)
+	assert.True(t, recorder.passthrough(req))
+
+	restore()
+	assert.Equal(t, original, http.DefaultTransport)
+
+	require.NoError(t, os.Setenv(ModeEnv, "off"))
+	restore, err = Setup(t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, original, http.DefaultTransport)
+	restore()
+}
+
+func TestSetup_Upstream(t *testing.T) {
+	var hosts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		_, _ = w.Write([]byte(`{"resource": "teamdetails", "resultSets": []}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	t.Setenv(ModeEnv, "record")
+	t.Setenv(UpstreamEnv, server.URL)
+	restore, err := Setup(dir)
+	require.NoError(t, err)
+	defer restore()
+
+	resp, err := http.Get("https://stats.nba.com/stats/teamdetails?TeamID=1610612747")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, `{"resource": "teamdetails", "resultSets": []}`, string(body))
+	assert.Equal(t, []string{"stats.nba.com"}, hosts, "the original host is sent to the upstream")
+	files, err := filepath.Glob(filepath.Join(dir, "stats.nba.com", "stats_teamdetails-*.json"))
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "stored under the original URL")
+
+	t.Setenv(UpstreamEnv, "127.0.0.1:8080")
+	_, err = Setup(dir)
+	assert.Error(t, err)
+}